@@ -21,7 +21,9 @@ func NewAnteHandler(
 	sigGasConsumer ante.SignatureVerificationGasConsumer,
 	channelKeeper *ibckeeper.Keeper,
 	paramKeeper paramkeeper.Keeper,
+	blobSharesRecorder BlobSharesRecorder,
 	msgVersioningGateKeeper *MsgVersioningGateKeeper,
+	txPriorityMode TxPriorityMode,
 ) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
 		// Wraps the panic with the string format of the transaction
@@ -45,7 +47,7 @@ func NewAnteHandler(
 		ante.NewConsumeGasForTxSizeDecorator(accountKeeper),
 		// Ensure the feepayer (fee granter or first signer) has enough funds to pay for the tx.
 		// Side effect: deducts fees from the fee payer. Sets the tx priority in context.
-		ante.NewDeductFeeDecorator(accountKeeper, bankKeeper, feegrantKeeper, ValidateTxFeeWrapper(paramKeeper)),
+		ante.NewDeductFeeDecorator(accountKeeper, bankKeeper, feegrantKeeper, ValidateTxFeeWrapper(paramKeeper, txPriorityMode)),
 		// Set public keys in the context for fee-payer and all signers.
 		// Contract: must be called before all signature verification decorators.
 		ante.NewSetPubKeyDecorator(accountKeeper),
@@ -70,6 +72,10 @@ func NewAnteHandler(
 		// available to blob data in a data square. Only applies to app version
 		// >= 2.
 		blobante.NewBlobShareDecorator(blobKeeper),
+		// Side effect: records the shares occupied by the blobs of the tx so
+		// that the network base gas price can be adjusted at the end of the
+		// block. Only applies to DeliverTx for app version >= 2.
+		NewRecordBlobSharesDecorator(blobSharesRecorder),
 		// Ensure that tx's with a MsgSubmitProposal have at least one proposal
 		// message.
 		NewGovProposalDecorator(),
@@ -0,0 +1,44 @@
+package ante
+
+import (
+	v1 "github.com/celestiaorg/celestia-app/v2/pkg/appconsts/v1"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	"github.com/celestiaorg/go-square/shares"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlobSharesRecorder counts the shares occupied by the blobs of a block.
+type BlobSharesRecorder interface {
+	AddBlobShares(ctx sdk.Context, shares uint64)
+}
+
+// RecordBlobSharesDecorator records the number of shares occupied by the blobs
+// of a MsgPayForBlobs so that the minfee EndBlocker can adjust the network base
+// gas price based on the utilization of the data square.
+type RecordBlobSharesDecorator struct {
+	recorder BlobSharesRecorder
+}
+
+func NewRecordBlobSharesDecorator(recorder BlobSharesRecorder) RecordBlobSharesDecorator {
+	return RecordBlobSharesDecorator{recorder: recorder}
+}
+
+// AnteHandle implements the AnteHandler interface. It only records the blob
+// shares in DeliverTx for app versions greater than one.
+func (d RecordBlobSharesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() || simulate || ctx.BlockHeader().Version.App == v1.Version {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, m := range tx.GetMsgs() {
+		if pfb, ok := m.(*blobtypes.MsgPayForBlobs); ok {
+			var blobShares int
+			for _, size := range pfb.BlobSizes {
+				blobShares += shares.SparseSharesNeeded(size)
+			}
+			d.recorder.AddBlobShares(ctx, uint64(blobShares))
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,71 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/app/ante"
+	"github.com/celestiaorg/celestia-app/v2/app/encoding"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	"github.com/celestiaorg/go-square/shares"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+type mockBlobSharesRecorder struct {
+	shares uint64
+}
+
+func (m *mockBlobSharesRecorder) AddBlobShares(_ sdk.Context, shares uint64) {
+	m.shares += shares
+}
+
+func TestRecordBlobSharesDecorator(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	blobSizes := []uint32{100, 10_000}
+	wantShares := uint64(shares.SparseSharesNeeded(100) + shares.SparseSharesNeeded(10_000))
+
+	testCases := []struct {
+		name       string
+		appVersion uint64
+		isCheckTx  bool
+		simulate   bool
+		wantShares uint64
+	}{
+		{
+			name:       "records the blob shares in DeliverTx",
+			appVersion: 2,
+			wantShares: wantShares,
+		},
+		{
+			name:       "doesn't record the blob shares in CheckTx",
+			appVersion: 2,
+			isCheckTx:  true,
+		},
+		{
+			name:       "doesn't record the blob shares when simulating",
+			appVersion: 2,
+			simulate:   true,
+		},
+		{
+			name:       "doesn't record the blob shares for app version 1",
+			appVersion: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := &mockBlobSharesRecorder{}
+			anteHandler := sdk.ChainAnteDecorators(ante.NewRecordBlobSharesDecorator(recorder))
+
+			builder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(&blobtypes.MsgPayForBlobs{BlobSizes: blobSizes}))
+			ctx := sdk.NewContext(nil, tmproto.Header{Version: version.Consensus{App: tc.appVersion}}, tc.isCheckTx, nil)
+
+			_, err := anteHandler(ctx, builder.GetTx(), tc.simulate)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantShares, recorder.shares)
+		})
+	}
+}
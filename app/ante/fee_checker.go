@@ -1,6 +1,8 @@
 package ante
 
 import (
+	stdmath "math"
+
 	errors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
@@ -19,22 +21,28 @@ const (
 
 // The purpose of this wrapper is to enable the passing of an additional paramKeeper parameter in
 // ante.NewDeductFeeDecorator whilst still satisfying the ante.TxFeeChecker type.
-func ValidateTxFeeWrapper(paramKeeper params.Keeper) ante.TxFeeChecker {
+func ValidateTxFeeWrapper(paramKeeper params.Keeper, priorityMode TxPriorityMode) ante.TxFeeChecker {
 	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
-		return ValidateTxFee(ctx, tx, paramKeeper)
+		return ValidateTxFee(ctx, tx, paramKeeper, priorityMode)
 	}
 }
 
 // ValidateTxFee implements default fee validation logic for transactions.
 // It ensures that the provided transaction fee meets a minimum threshold for the node
-// as well as a network minimum threshold and computes the tx priority based on the gas price.
-func ValidateTxFee(ctx sdk.Context, tx sdk.Tx, paramKeeper params.Keeper) (sdk.Coins, int64, error) {
+// as well as a network minimum threshold and computes the tx priority based on the priorityMode.
+// The network minimum threshold is the dynamic network base gas price if it is enabled.
+// For app versions greater than one, the fee can be paid in the IBC denoms
+// whitelisted by the minfee FeeDenomRates param, which are converted to utia
+// for the thresholds and the priority. The fee is deducted as-is. The network
+// minimum threshold is multiplied by the gas price multiplier of the message
+// types of the tx set by the minfee MsgGasPriceMultipliers param.
+func ValidateTxFee(ctx sdk.Context, tx sdk.Tx, paramKeeper params.Keeper, priorityMode TxPriorityMode) (sdk.Coins, int64, error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
 		return nil, 0, errors.Wrap(sdkerror.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
-	fee := feeTx.GetFee().AmountOf(appconsts.BondDenom)
+	fee, minFeeParams := feeInUtia(ctx, feeTx, paramKeeper)
 	gas := feeTx.GetGas()
 
 	// Ensure that the provided fee meets a minimum threshold for the node.
@@ -67,16 +75,45 @@ func ValidateTxFee(ctx sdk.Context, tx sdk.Tx, paramKeeper params.Keeper) (sdk.C
 		// Panics if not configured properly.
 		subspace.Get(ctx, minfee.KeyNetworkMinGasPrice, &networkMinGasPrice)
 
+		// The network base gas price replaces the network min gas price if the
+		// dynamic base gas price is enabled. It is never lower than the
+		// network min gas price. It is read without gas metering so that it
+		// doesn't change the gas used by txs, which clients estimate offline.
+		unmeteredCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		if networkBaseGasPrice, enabled := minfee.GetNetworkBaseGasPrice(unmeteredCtx, subspace); enabled {
+			networkMinGasPrice = networkBaseGasPrice
+		}
+
+		// The network minimum gas price is scaled by the gas price multiplier
+		// of the message types of the tx.
+		networkMinGasPrice = networkMinGasPrice.Mul(minfee.TxGasPriceMultiplier(tx.GetMsgs(), minFeeParams.MsgGasPriceMultipliers))
+
 		err := verifyMinFee(fee, gas, networkMinGasPrice, "insufficient gas price for the network")
 		if err != nil {
 			return nil, 0, err
 		}
 	}
 
-	priority := getTxPriority(feeTx.GetFee(), int64(gas))
+	priority := TxPriority(priorityMode, fee, gas, tx.GetMsgs())
 	return feeTx.GetFee(), priority, nil
 }
 
+// feeInUtia returns the value in utia of the fee of feeTx and the minfee params
+// used to convert it. For app version one, only the utia of the fee counts and
+// the params are empty.
+func feeInUtia(ctx sdk.Context, feeTx sdk.FeeTx, paramKeeper params.Keeper) (math.Int, minfee.Params) {
+	// The minfee params only exist for app versions greater than one. They
+	// are read without gas metering so that they don't change the gas used by
+	// txs, which clients estimate offline.
+	if ctx.BlockHeader().Version.App > v1.Version {
+		if subspace, exists := paramKeeper.GetSubspace(minfee.ModuleName); exists {
+			minFeeParams := minfee.GetParams(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), subspace)
+			return minfee.FeeInUtia(feeTx.GetFee(), minFeeParams.FeeDenomRates), minFeeParams
+		}
+	}
+	return feeTx.GetFee().AmountOf(appconsts.BondDenom), minfee.Params{}
+}
+
 // verifyMinFee validates that the provided transaction fee is sufficient given the provided minimum gas price.
 func verifyMinFee(fee math.Int, gas uint64, minGasPrice sdk.Dec, errMsg string) error {
 	// Determine the required fee by multiplying required minimum gas
@@ -88,21 +125,17 @@ func verifyMinFee(fee math.Int, gas uint64, minGasPrice sdk.Dec, errMsg string)
 	return nil
 }
 
-// getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
-// provided in a transaction.
-// NOTE: This implementation should not be used for txs with multiple coins.
-func getTxPriority(fee sdk.Coins, gas int64) int64 {
-	var priority int64
-	for _, c := range fee {
-		p := c.Amount.Mul(sdk.NewInt(priorityScalingFactor)).QuoRaw(gas)
-		if !p.IsInt64() {
-			continue
-		}
-		// take the lowest priority as the tx priority
-		if priority == 0 || p.Int64() < priority {
-			priority = p.Int64()
-		}
+// getTxPriority returns a naive tx priority based on the gas price in utia
+// provided in a transaction. The fee is the value of the tx fee in utia. A
+// priority that doesn't fit in an int64 is capped at math.MaxInt64 so that
+// the highest fees don't end up with the lowest priority.
+func getTxPriority(fee math.Int, gas int64) int64 {
+	if gas <= 0 || !fee.IsPositive() {
+		return 0
 	}
-
-	return priority
+	p := fee.Mul(sdk.NewInt(priorityScalingFactor)).QuoRaw(gas)
+	if !p.IsInt64() {
+		return stdmath.MaxInt64
+	}
+	return p.Int64()
 }
@@ -1,10 +1,15 @@
 package ante
 
 import (
+	"math"
+	"strings"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v2/x/minfee"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,12 +50,208 @@ func TestGetTxPriority(t *testing.T) {
 			gas:         1_000_000,
 			expectedPri: 1000,
 		},
+		{
+			name:        "zero gas",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1_000)),
+			gas:         0,
+			expectedPri: 0,
+		},
+		{
+			name:        "negative gas",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1_000)),
+			gas:         -1,
+			expectedPri: 0,
+		},
+		{
+			name:        "zero fee",
+			fee:         sdk.NewCoins(),
+			gas:         1_000_000,
+			expectedPri: 0,
+		},
+		{
+			name:        "fee without utia",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("ibc/"+strings.Repeat("A", 64), 1_000)),
+			gas:         1_000_000,
+			expectedPri: 0,
+		},
+		{
+			name:        "priority overflowing int64 is capped",
+			fee:         sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, sdk.NewInt(math.MaxInt64))),
+			gas:         1,
+			expectedPri: math.MaxInt64,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			pri := getTxPriority(tc.fee.AmountOf(appconsts.BondDenom), tc.gas)
+			assert.Equal(t, tc.expectedPri, pri)
+		})
+	}
+}
+
+func TestTxPriority(t *testing.T) {
+	signer := "celestia1yqv4ctf3g4qz75mcw4gpd37ejcz2jdvntxl5jf"
+	send := &banktypes.MsgSend{FromAddress: signer}
+	pfb := func(blobSizes ...uint32) *blobtypes.MsgPayForBlobs {
+		return &blobtypes.MsgPayForBlobs{Signer: signer, BlobSizes: blobSizes}
+	}
+	// estimate is the gas estimated for a tx that pays for blobs of sizes.
+	estimate := func(sizes ...uint32) int64 {
+		return int64(blobtypes.DefaultEstimateGas(sizes))
+	}
+
+	cases := []struct {
+		name        string
+		mode        TxPriorityMode
+		fee         int64
+		gas         uint64
+		msgs        []sdk.Msg
+		expectedPri int64
+	}{
+		{
+			name:        "non blob tx is prioritized by gas price",
+			mode:        TxPriorityFeePerShare,
+			fee:         1_000,
+			gas:         100_000,
+			msgs:        []sdk.Msg{send},
+			expectedPri: 10_000,
+		},
+		{
+			name:        "blob tx with the estimated gas limit has the priority of its gas price",
+			mode:        TxPriorityFeePerShare,
+			fee:         estimate(100),
+			gas:         uint64(estimate(100)),
+			msgs:        []sdk.Msg{pfb(100)},
+			expectedPri: priorityScalingFactor,
+		},
+		{
+			name:        "blob tx with a higher gas limit keeps its priority",
+			mode:        TxPriorityFeePerShare,
+			fee:         estimate(100),
+			gas:         10_000_000,
+			msgs:        []sdk.Msg{pfb(100)},
+			expectedPri: priorityScalingFactor,
+		},
+		{
+			name:        "blob tx occupying more shares has a lower priority for the same fee",
+			mode:        TxPriorityFeePerShare,
+			fee:         estimate(100),
+			gas:         10_000_000,
+			msgs:        []sdk.Msg{pfb(appconsts.ShareSize * 3)},
+			expectedPri: estimate(100) * priorityScalingFactor / estimate(appconsts.ShareSize*3),
+		},
+		{
+			name:        "shares of all blobs count",
+			mode:        TxPriorityFeePerShare,
+			fee:         estimate(100),
+			gas:         10_000_000,
+			msgs:        []sdk.Msg{pfb(1, 1)},
+			expectedPri: estimate(100) * priorityScalingFactor / estimate(1, 1),
+		},
+		{
+			name:        "blob tx with a gas limit below the estimate is prioritized by gas price",
+			mode:        TxPriorityFeePerShare,
+			fee:         1_000,
+			gas:         1_000,
+			msgs:        []sdk.Msg{pfb(100)},
+			expectedPri: priorityScalingFactor,
+		},
+		{
+			name:        "pfb without blobs is prioritized by gas price",
+			mode:        TxPriorityFeePerShare,
+			fee:         1_000,
+			gas:         100_000,
+			msgs:        []sdk.Msg{pfb()},
+			expectedPri: 10_000,
+		},
+		{
+			name:        "blob tx is prioritized by gas price in gas price mode",
+			mode:        TxPriorityGasPrice,
+			fee:         estimate(100),
+			gas:         10_000_000,
+			msgs:        []sdk.Msg{pfb(100)},
+			expectedPri: estimate(100) * priorityScalingFactor / 10_000_000,
+		},
+		{
+			name:        "gas limit overflowing int64",
+			mode:        TxPriorityGasPrice,
+			fee:         math.MaxInt64,
+			gas:         math.MaxUint64,
+			msgs:        []sdk.Msg{send},
+			expectedPri: priorityScalingFactor,
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			pri := getTxPriority(tc.fee, tc.gas)
+			pri := TxPriority(tc.mode, sdk.NewInt(tc.fee), tc.gas, tc.msgs)
 			assert.Equal(t, tc.expectedPri, pri)
 		})
 	}
 }
+
+func TestTxPriorityFeeDenoms(t *testing.T) {
+	ibcDenom := "ibc/" + strings.Repeat("A", 64)
+	rates := []minfee.FeeDenomRate{{Denom: ibcDenom, Rate: sdk.NewDecWithPrec(5, 1)}}
+	gas := int64(1_000)
+
+	cases := []struct {
+		name        string
+		fee         sdk.Coins
+		expectedPri int64
+	}{
+		{
+			name:        "utia only",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1_000)),
+			expectedPri: priorityScalingFactor,
+		},
+		{
+			name:        "whitelisted denom only",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 2_000)),
+			expectedPri: priorityScalingFactor,
+		},
+		{
+			name:        "utia and whitelisted denom add up",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1_000), sdk.NewInt64Coin(ibcDenom, 2_000)),
+			expectedPri: 2 * priorityScalingFactor,
+		},
+		{
+			name:        "denom that isn't whitelisted is ignored",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1_000), sdk.NewInt64Coin("ibc/"+strings.Repeat("B", 64), 1_000_000)),
+			expectedPri: priorityScalingFactor,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			pri := getTxPriority(minfee.FeeInUtia(tc.fee, rates), gas)
+			assert.Equal(t, tc.expectedPri, pri)
+		})
+	}
+}
+
+func TestParseTxPriorityMode(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected TxPriorityMode
+		wantErr  bool
+	}{
+		{input: "", expected: DefaultTxPriorityMode},
+		{input: "gas-price", expected: TxPriorityGasPrice},
+		{input: " fee-per-share ", expected: TxPriorityFeePerShare},
+		{input: "fee", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			mode, err := ParseTxPriorityMode(tc.input)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, mode)
+		})
+	}
+}
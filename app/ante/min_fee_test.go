@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramkeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -150,7 +151,7 @@ func TestValidateTxFee(t *testing.T) {
 			subspace = minfee.RegisterMinFeeParamTable(subspace)
 			subspace.Set(ctx, minfee.KeyNetworkMinGasPrice, networkMinGasPriceDec)
 
-			_, _, err = ante.ValidateTxFee(ctx, tx, paramsKeeper)
+			_, _, err = ante.ValidateTxFee(ctx, tx, paramsKeeper, ante.DefaultTxPriorityMode)
 			if tc.expErr {
 				require.Error(t, err)
 			} else {
@@ -160,6 +161,182 @@ func TestValidateTxFee(t *testing.T) {
 	}
 }
 
+func TestValidateTxFeeDynamicBaseGasPrice(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	builder := encCfg.TxConfig.NewTxBuilder()
+	err := builder.SetMsgs(banktypes.NewMsgSend(
+		testnode.RandomAddress().(sdk.AccAddress),
+		testnode.RandomAddress().(sdk.AccAddress),
+		sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 10))),
+	)
+	require.NoError(t, err)
+
+	gasLimit := uint64(1_000_000)
+	builder.SetGasLimit(gasLimit)
+	// the fee is enough for the network min gas price but not for twice that.
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, int64(float64(gasLimit)*v2.NetworkMinGasPrice))))
+	tx := builder.GetTx()
+
+	paramsKeeper, stateStore := setUp(t)
+	ctx := sdk.NewContext(stateStore, tmproto.Header{Version: version.Consensus{App: 2}}, false, nil)
+	subspace, _ := paramsKeeper.GetSubspace(minfee.ModuleName)
+	subspace = minfee.RegisterMinFeeParamTable(subspace)
+	params := minfee.DefaultParams()
+	subspace.SetParamSet(ctx, &params)
+	subspace.Set(ctx, minfee.KeyNetworkBaseGasPrice, minfee.DefaultNetworkMinGasPrice.MulInt64(2))
+
+	// the network base gas price is ignored while the dynamic base gas price is disabled.
+	_, _, err = ante.ValidateTxFee(ctx, tx, paramsKeeper, ante.DefaultTxPriorityMode)
+	require.NoError(t, err)
+
+	subspace.Set(ctx, minfee.KeyDynamicBaseGasPriceEnabled, true)
+	_, _, err = ante.ValidateTxFee(ctx, tx, paramsKeeper, ante.DefaultTxPriorityMode)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+}
+
+func TestValidateTxFeeFeeDenoms(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	feeDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	gasLimit := uint64(1_000_000)
+	// the network min fee is 1 utia, which is worth 4 units of feeDenom.
+	minFee := int64(float64(gasLimit) * v2.NetworkMinGasPrice)
+	rate := sdk.NewDecWithPrec(25, 2)
+
+	testCases := []struct {
+		name         string
+		fee          sdk.Coins
+		appVersion   uint64
+		wantErr      bool
+		wantPriority int64
+	}{
+		{
+			name:         "whitelisted denom",
+			fee:          sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 4*minFee)),
+			appVersion:   v2.Version,
+			wantPriority: minFee,
+		},
+		{
+			name:       "whitelisted denom below the network min fee",
+			fee:        sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 4*minFee-1)),
+			appVersion: v2.Version,
+			wantErr:    true,
+		},
+		{
+			name:         "whitelisted denom and utia",
+			fee:          sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 40*minFee), sdk.NewInt64Coin(appconsts.BondDenom, minFee)),
+			appVersion:   v2.Version,
+			wantPriority: 11 * minFee,
+		},
+		{
+			name:       "denom that isn't whitelisted",
+			fee:        sdk.NewCoins(sdk.NewInt64Coin("ibc/0000000000000000000000000000000000000000000000000000000000000000", 4*minFee)),
+			appVersion: v2.Version,
+			wantErr:    true,
+		},
+		{
+			// there is no network min fee in v1
+			name:         "whitelisted denom isn't counted in v1",
+			fee:          sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 4*minFee)),
+			appVersion:   1,
+			wantPriority: 0,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(
+				testnode.RandomAddress().(sdk.AccAddress),
+				testnode.RandomAddress().(sdk.AccAddress),
+				sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 10))),
+			))
+			builder.SetGasLimit(gasLimit)
+			builder.SetFeeAmount(tc.fee)
+
+			paramsKeeper, stateStore := setUp(t)
+			ctx := sdk.NewContext(stateStore, tmproto.Header{Version: version.Consensus{App: tc.appVersion}}, false, nil)
+			subspace, _ := paramsKeeper.GetSubspace(minfee.ModuleName)
+			subspace = minfee.RegisterMinFeeParamTable(subspace)
+			params := minfee.DefaultParams()
+			params.FeeDenomRates = []minfee.FeeDenomRate{{Denom: feeDenom, Rate: rate}}
+			subspace.SetParamSet(ctx, &params)
+
+			fee, priority, err := ante.ValidateTxFee(ctx, builder.GetTx(), paramsKeeper, ante.DefaultTxPriorityMode)
+			if tc.wantErr {
+				require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+				return
+			}
+			require.NoError(t, err)
+			// the fee is deducted as-is
+			require.Equal(t, tc.fee, fee)
+			require.Equal(t, tc.wantPriority, priority)
+		})
+	}
+}
+
+func TestValidateTxFeeMsgGasPriceMultipliers(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	msg := banktypes.NewMsgSend(
+		testnode.RandomAddress().(sdk.AccAddress),
+		testnode.RandomAddress().(sdk.AccAddress),
+		sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 10)),
+	)
+	gasLimit := uint64(10_000_000)
+	minFee := int64(float64(gasLimit) * v2.NetworkMinGasPrice)
+
+	testCases := []struct {
+		name       string
+		multiplier sdk.Dec
+		fee        int64
+		wantErr    bool
+	}{
+		{
+			name:       "surcharge",
+			multiplier: sdk.NewDec(2),
+			fee:        2 * minFee,
+		},
+		{
+			name:       "fee below the surcharge",
+			multiplier: sdk.NewDec(2),
+			fee:        2*minFee - 1,
+			wantErr:    true,
+		},
+		{
+			name:       "discount",
+			multiplier: sdk.NewDecWithPrec(5, 1),
+			fee:        minFee / 2,
+		},
+		{
+			name:       "fee below the discount",
+			multiplier: sdk.NewDecWithPrec(5, 1),
+			fee:        minFee/2 - 1,
+			wantErr:    true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(msg))
+			builder.SetGasLimit(gasLimit)
+			builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, tc.fee)))
+
+			paramsKeeper, stateStore := setUp(t)
+			ctx := sdk.NewContext(stateStore, tmproto.Header{Version: version.Consensus{App: v2.Version}}, false, nil)
+			subspace, _ := paramsKeeper.GetSubspace(minfee.ModuleName)
+			subspace = minfee.RegisterMinFeeParamTable(subspace)
+			params := minfee.DefaultParams()
+			params.MsgGasPriceMultipliers = []minfee.MsgGasPriceMultiplier{{MsgTypeUrl: sdk.MsgTypeURL(msg), Multiplier: tc.multiplier}}
+			subspace.SetParamSet(ctx, &params)
+
+			_, _, err := ante.ValidateTxFee(ctx, builder.GetTx(), paramsKeeper, ante.DefaultTxPriorityMode)
+			if tc.wantErr {
+				require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func setUp(t *testing.T) (paramkeeper.Keeper, storetypes.CommitMultiStore) {
 	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
 	tStoreKey := storetypes.NewTransientStoreKey(paramtypes.TStoreKey)
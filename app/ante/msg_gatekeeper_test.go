@@ -6,7 +6,11 @@ import (
 	"github.com/celestiaorg/celestia-app/v2/app"
 	"github.com/celestiaorg/celestia-app/v2/app/ante"
 	"github.com/celestiaorg/celestia-app/v2/app/encoding"
+	v1 "github.com/celestiaorg/celestia-app/v2/pkg/appconsts/v1"
+	"github.com/celestiaorg/celestia-app/v2/test/util"
+	blobstreamtypes "github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
@@ -103,3 +107,27 @@ func TestMsgGateKeeperAnteHandler(t *testing.T) {
 		})
 	}
 }
+
+// TestMsgGateKeeperBlobstreamV1 verifies that the gatekeeper of the app rejects
+// the blobstream messages that the v1.x binaries don't support at app version
+// 1.
+func TestMsgGateKeeperBlobstreamV1(t *testing.T) {
+	testApp := util.NewTestApp()
+	ctx := sdk.NewContext(nil, tmproto.Header{Version: version.Consensus{App: v1.Version}}, false, nil)
+	cdc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	anteHandler := sdk.ChainAnteDecorators(testApp.MsgGateKeeper)
+
+	allowed, err := testApp.MsgGateKeeper.IsAllowed(ctx, sdk.MsgTypeURL(&blobstreamtypes.MsgRegisterEVMAddress{}))
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	msg := &blobstreamtypes.MsgSubmitAttestationConfirm{}
+	allowed, err = testApp.MsgGateKeeper.IsAllowed(ctx, sdk.MsgTypeURL(msg))
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	txBuilder := cdc.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	_, err = anteHandler(ctx, txBuilder.GetTx(), false)
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+}
@@ -0,0 +1,92 @@
+package ante
+
+import (
+	"fmt"
+	stdmath "math"
+	"strings"
+
+	"cosmossdk.io/math"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/keeper"
+)
+
+// FlagTxPriority is the app option that selects the TxPriorityMode of a node.
+const FlagTxPriority = "tx-priority"
+
+// TxPriorityMode determines how a node prioritizes txs, both in its mempool and
+// when it prepares a proposal. It is local to a node and doesn't affect
+// consensus.
+type TxPriorityMode string
+
+const (
+	// TxPriorityGasPrice prioritizes all txs by the gas price of their fee.
+	TxPriorityGasPrice TxPriorityMode = "gas-price"
+	// TxPriorityFeePerShare prioritizes txs that pay for blobs by their fee
+	// per share occupied by their blobs, and all other txs by the gas price of
+	// their fee.
+	TxPriorityFeePerShare TxPriorityMode = "fee-per-share"
+
+	// DefaultTxPriorityMode is the TxPriorityMode used if a node doesn't
+	// configure one.
+	DefaultTxPriorityMode = TxPriorityGasPrice
+)
+
+// ParseTxPriorityMode returns the TxPriorityMode of s. It returns the
+// DefaultTxPriorityMode if s is empty.
+func ParseTxPriorityMode(s string) (TxPriorityMode, error) {
+	switch mode := TxPriorityMode(strings.TrimSpace(s)); mode {
+	case "":
+		return DefaultTxPriorityMode, nil
+	case TxPriorityGasPrice, TxPriorityFeePerShare:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid tx priority mode %q: must be %q or %q", s, TxPriorityGasPrice, TxPriorityFeePerShare)
+	}
+}
+
+// GetTxPriority returns the priority of tx under mode. It is the priority that
+// the fee checker sets for tx, so it can be used to order txs the same way as
+// the mempool. It returns zero if tx is not a FeeTx.
+func GetTxPriority(ctx sdk.Context, tx sdk.Tx, paramKeeper params.Keeper, mode TxPriorityMode) int64 {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return 0
+	}
+	fee, _ := feeInUtia(ctx, feeTx, paramKeeper)
+	return TxPriority(mode, fee, feeTx.GetGas(), tx.GetMsgs())
+}
+
+// TxPriority returns the priority of a tx with the given fee in utia, gas limit
+// and msgs under mode.
+//
+// Under TxPriorityFeePerShare, the priority of a tx that pays for blobs is the
+// gas price its fee would amount to if its gas limit were the gas estimated
+// for its blobs at the default params, which is proportional to the number of
+// shares they occupy. This keeps it on the same scale as the gas price
+// priority of other txs, while ranking blob txs by what they pay for the space
+// they use in the data square rather than by how much they pad their gas
+// limit. It is never lower than the gas price of the tx.
+func TxPriority(mode TxPriorityMode, fee math.Int, gas uint64, msgs []sdk.Msg) int64 {
+	if mode == TxPriorityFeePerShare {
+		if blobGas := estimateBlobTxGas(msgs); blobGas > 0 {
+			gas = min(gas, blobGas)
+		}
+	}
+	if gas > stdmath.MaxInt64 {
+		gas = stdmath.MaxInt64
+	}
+	return getTxPriority(fee, int64(gas))
+}
+
+// estimateBlobTxGas returns the gas estimated for the blobs paid for by msgs
+// at the default params. It is zero if msgs don't pay for blobs.
+func estimateBlobTxGas(msgs []sdk.Msg) uint64 {
+	var gas uint64
+	for _, msg := range msgs {
+		if pfb, ok := msg.(*blobtypes.MsgPayForBlobs); ok && len(pfb.BlobSizes) > 0 {
+			gas += blobtypes.DefaultEstimateGas(pfb.BlobSizes)
+		}
+	}
+	return gas
+}
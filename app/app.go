@@ -15,6 +15,7 @@ import (
 	blobkeeper "github.com/celestiaorg/celestia-app/v2/x/blob/keeper"
 	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
 	blobstreamkeeper "github.com/celestiaorg/celestia-app/v2/x/blobstream/keeper"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/proofservice"
 	blobstreamtypes "github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
 	"github.com/celestiaorg/celestia-app/v2/x/minfee"
 	mintkeeper "github.com/celestiaorg/celestia-app/v2/x/mint/keeper"
@@ -102,6 +103,7 @@ var maccPerms = map[string][]string{
 	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 	ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 	icatypes.ModuleName:            nil,
+	blobtypes.ModuleName:           {authtypes.Burner},
 }
 
 const (
@@ -156,6 +158,7 @@ type App struct {
 	PacketForwardKeeper *packetforwardkeeper.Keeper
 	BlobKeeper          blobkeeper.Keeper
 	BlobstreamKeeper    blobstreamkeeper.Keeper
+	MinFeeKeeper        minfee.Keeper
 
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper // This keeper is public for test purposes
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper // This keeper is public for test purposes
@@ -169,6 +172,9 @@ type App struct {
 	// MsgGateKeeper is used to define which messages are accepted for a given
 	// app version.
 	MsgGateKeeper *ante.MsgVersioningGateKeeper
+	// txPriorityMode determines how txs are prioritized in the mempool and in
+	// PrepareProposal. It is configured per node.
+	txPriorityMode ante.TxPriorityMode
 }
 
 // New returns a reference to an uninitialized app. Callers must subsequently
@@ -196,7 +202,7 @@ func New(
 	baseApp.SetInterfaceRegistry(interfaceRegistry)
 
 	keys := sdk.NewKVStoreKeys(allStoreKeys()...)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, minfee.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &App{
@@ -237,6 +243,7 @@ func New(
 	app.MintKeeper = mintkeeper.NewKeeper(
 		appCodec,
 		keys[minttypes.StoreKey],
+		app.GetSubspace(minttypes.ModuleName),
 		&stakingKeeper,
 		app.AccountKeeper,
 		app.BankKeeper,
@@ -265,6 +272,9 @@ func New(
 		app.GetSubspace(blobstreamtypes.ModuleName),
 		&stakingKeeper,
 	)
+	app.BlobstreamKeeper.SetQueryContextFn(app.CreateQueryContext)
+
+	app.SignalKeeper = signal.NewKeeper(appCodec, keys[signaltypes.StoreKey], app.GetSubspace(signaltypes.ModuleName), &stakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	// Register the staking hooks. NOTE: stakingKeeper is passed by reference
 	// above so that it will contain these hooks.
@@ -273,11 +283,10 @@ func New(
 			app.DistrKeeper.Hooks(),
 			app.SlashingKeeper.Hooks(),
 			app.BlobstreamKeeper.Hooks(),
+			app.SignalKeeper.Hooks(),
 		),
 	)
 
-	app.SignalKeeper = signal.NewKeeper(appCodec, keys[signaltypes.StoreKey], app.StakingKeeper)
-
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec,
 		keys[ibchost.StoreKey],
@@ -299,7 +308,9 @@ func New(
 		app.MsgServiceRouter(),
 	)
 
-	paramBlockList := paramfilter.NewParamBlockList(app.BlockedParams()...)
+	paramBlockList := paramfilter.NewParamBlockList(app.BlockedParams()...).
+		WithBounds(app.BoundedParams()...).
+		WithMinAppVersions(app.VersionedParams()...)
 
 	// Register the proposal types.
 	govRouter := oldgovtypes.NewRouter()
@@ -366,9 +377,13 @@ func New(
 
 	app.BlobKeeper = *blobkeeper.NewKeeper(
 		appCodec,
+		keys[blobtypes.StoreKey],
 		app.GetSubspace(blobtypes.ModuleName),
+		app.BankKeeper,
 	)
 
+	app.MinFeeKeeper = minfee.NewKeeper(app.ParamsKeeper, tkeys[minfee.TStoreKey], app.BlobKeeper)
+
 	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
 	ibcRouter := ibcporttypes.NewRouter()                                                   // Create static IBC router
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)                          // Add transfer route
@@ -400,7 +415,12 @@ func New(
 	// extract the accepted message list from the configurator and create a gatekeeper
 	// which will be used both as the antehandler and as part of the circuit breaker in
 	// the msg service router
-	app.MsgGateKeeper = ante.NewMsgVersioningGateKeeper(app.configurator.GetAcceptedMessages())
+	acceptedMsgs := app.configurator.GetAcceptedMessages()
+	// MsgSubmitAttestationConfirm was added to x/blobstream after the v1.x
+	// binaries, which reject it. x/blobstream only runs at app version 1, so
+	// accepting the message there would fork a network with v1.x validators.
+	delete(acceptedMsgs[v1], sdk.MsgTypeURL(&blobstreamtypes.MsgSubmitAttestationConfirm{}))
+	app.MsgGateKeeper = ante.NewMsgVersioningGateKeeper(acceptedMsgs)
 	app.MsgServiceRouter().SetCircuit(app.MsgGateKeeper)
 
 	// Initialize the KV stores for the base modules (e.g. params). The base modules will be included in every app version.
@@ -411,6 +431,11 @@ func New(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
+	txPriorityMode, err := ante.ParseTxPriorityMode(cast.ToString(appOpts.Get(ante.FlagTxPriority)))
+	if err != nil {
+		panic(err)
+	}
+	app.txPriorityMode = txPriorityMode
 	app.SetAnteHandler(ante.NewAnteHandler(
 		app.AccountKeeper,
 		app.BankKeeper,
@@ -420,9 +445,11 @@ func New(
 		ante.DefaultSigVerificationGasConsumer,
 		app.IBCKeeper,
 		app.ParamsKeeper,
+		app.MinFeeKeeper,
 		app.MsgGateKeeper,
+		app.txPriorityMode,
 	))
-	app.SetPostHandler(posthandler.New())
+	app.SetPostHandler(posthandler.New(app.BlobKeeper, app.BlobKeeper))
 
 	app.SetMigrateStoreFn(app.migrateCommitStore)
 	app.SetMigrateModuleFn(app.migrateModules)
@@ -450,6 +477,15 @@ func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.R
 	return app.manager.BeginBlock(ctx, req)
 }
 
+// Commit commits the block and then publishes the Blobstream attestations it
+// created to the attestation request streams, so that the streams only send
+// attestations that can be read from the committed state.
+func (app *App) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	app.BlobstreamKeeper.PublishQueuedAttestations()
+	return res
+}
+
 // EndBlocker executes application updates at the end of every block.
 func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	res := app.manager.EndBlock(ctx, req)
@@ -657,6 +693,11 @@ func (app *App) GetTxConfig() client.TxConfig {
 	return app.txConfig
 }
 
+// TxPriorityMode returns how the app prioritizes txs.
+func (app *App) TxPriorityMode() ante.TxPriorityMode {
+	return app.txPriorityMode
+}
+
 // LegacyAmino returns SimApp's amino codec.
 //
 // NOTE: This is solely to be used for testing purposes as it may be desirable
@@ -717,6 +758,8 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register the Blobstream data root tuple inclusion proof routes.
+	proofservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 }
 
@@ -728,6 +771,11 @@ func (app *App) RegisterTxService(clientCtx client.Context) {
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
 func (app *App) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(clientCtx, app.BaseApp.GRPCQueryRouter(), app.interfaceRegistry, app.Query)
+	// the Blobstream data root tuple inclusion proofs read the data roots of
+	// past blocks from the node.
+	if clientCtx.Client != nil {
+		proofservice.RegisterProofService(clientCtx, app.BaseApp.GRPCQueryRouter())
+	}
 }
 
 func (app *App) RegisterNodeService(clientCtx client.Context) {
@@ -746,6 +794,57 @@ func (app *App) BlockedParams() [][2]string {
 		{stakingtypes.ModuleName, string(stakingtypes.KeyBondDenom)},
 		// consensus.validator.PubKeyTypes
 		{baseapp.Paramspace, string(baseapp.ParamStoreKeyValidatorParams)},
+		// mint.InitialInflationRate
+		{minttypes.ModuleName, string(minttypes.KeyInitialInflationRate)},
+		// minfee.NetworkBaseGasPrice is updated by the minfee EndBlocker.
+		{minfee.ModuleName, string(minfee.KeyNetworkBaseGasPrice)},
+	}
+}
+
+// BoundedParams returns the params that can only be changed via governance
+// within hard-coded safety bounds.
+func (app *App) BoundedParams() []paramfilter.ParamBound {
+	return []paramfilter.ParamBound{
+		// mint.DisinflationRate
+		{
+			Subspace:  minttypes.ModuleName,
+			Key:       string(minttypes.KeyDisinflationRate),
+			Min:       sdk.NewDecWithPrec(5, 2),  // 0.05
+			Max:       sdk.NewDecWithPrec(2, 1),  // 0.2
+			MaxChange: sdk.NewDecWithPrec(25, 3), // 0.025
+			Default:   minttypes.DefaultDisinflationRate,
+		},
+		// mint.TargetInflationRate
+		{
+			Subspace:  minttypes.ModuleName,
+			Key:       string(minttypes.KeyTargetInflationRate),
+			Min:       sdk.NewDecWithPrec(1, 2),  // 0.01
+			Max:       sdk.NewDecWithPrec(3, 2),  // 0.03
+			MaxChange: sdk.NewDecWithPrec(25, 4), // 0.0025
+			Default:   minttypes.DefaultTargetInflationRate,
+		},
+	}
+}
+
+// VersionedParams returns the params that can only be changed via governance
+// from an app version on. They were added in that app version, so the binaries
+// that only support earlier app versions would reject the changes.
+func (app *App) VersionedParams() []paramfilter.VersionedParam {
+	return []paramfilter.VersionedParam{
+		// mint.DisinflationRate
+		{Subspace: minttypes.ModuleName, Key: string(minttypes.KeyDisinflationRate), MinAppVersion: v2},
+		// mint.TargetInflationRate
+		{Subspace: minttypes.ModuleName, Key: string(minttypes.KeyTargetInflationRate), MinAppVersion: v2},
+		// blob.BlobFeeBurnFraction
+		{Subspace: blobtypes.ModuleName, Key: string(blobtypes.KeyBlobFeeBurnFraction), MinAppVersion: v2},
+		// blob.GasRefundFraction
+		{Subspace: blobtypes.ModuleName, Key: string(blobtypes.KeyGasRefundFraction), MinAppVersion: v2},
+		// blob.MaxGasRefund
+		{Subspace: blobtypes.ModuleName, Key: string(blobtypes.KeyMaxGasRefund), MinAppVersion: v2},
+		// blob.NamespaceGasMultipliers
+		{Subspace: blobtypes.ModuleName, Key: string(blobtypes.KeyNamespaceGasMultipliers), MinAppVersion: v2},
+		// blob.BlobSizeGasTiers
+		{Subspace: blobtypes.ModuleName, Key: string(blobtypes.KeyBlobSizeGasTiers), MinAppVersion: v2},
 	}
 }
 
@@ -767,6 +866,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(blobtypes.ModuleName)
 	paramsKeeper.Subspace(blobstreamtypes.ModuleName)
 	paramsKeeper.Subspace(minfee.ModuleName)
+	paramsKeeper.Subspace(signaltypes.ModuleName)
 	paramsKeeper.Subspace(packetforwardtypes.ModuleName)
 
 	return paramsKeeper
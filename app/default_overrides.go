@@ -3,8 +3,10 @@ package app
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/celestiaorg/celestia-app/v2/app/ante"
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v2/x/mint"
 	minttypes "github.com/celestiaorg/celestia-app/v2/x/mint/types"
@@ -298,3 +300,36 @@ func DefaultAppConfig() *serverconfig.Config {
 	cfg.MinGasPrices = fmt.Sprintf("%v%s", appconsts.DefaultMinGasPrice, BondDenom)
 	return cfg
 }
+
+// CustomAppConfig is the config written to app.toml. It adds the options that
+// are specific to celestia-app to the config of the SDK.
+type CustomAppConfig struct {
+	serverconfig.Config `mapstructure:",squash"`
+
+	// TxPriority is the ante.TxPriorityMode of the node.
+	TxPriority string `mapstructure:"tx-priority"`
+}
+
+// DefaultCustomAppConfig returns the default config written to app.toml.
+func DefaultCustomAppConfig() *CustomAppConfig {
+	return &CustomAppConfig{
+		Config:     *DefaultAppConfig(),
+		TxPriority: string(ante.DefaultTxPriorityMode),
+	}
+}
+
+// minGasPricesTemplate is the line of the SDK's app.toml template after which
+// the options that are specific to celestia-app are added.
+const minGasPricesTemplate = "minimum-gas-prices = \"{{ .BaseConfig.MinGasPrices }}\"\n"
+
+// CustomAppConfigTemplate is the template of app.toml for a CustomAppConfig.
+// The options that are specific to celestia-app are part of the base
+// configuration so that they are read under the same keys as their flags.
+var CustomAppConfigTemplate = strings.Replace(serverconfig.DefaultConfigTemplate, minGasPricesTemplate, minGasPricesTemplate+`
+# How txs are prioritized in the mempool and in proposals. It is local to the
+# node and doesn't affect consensus.
+# gas-price: all txs are prioritized by the gas price of their fee.
+# fee-per-share: txs that pay for blobs are prioritized by their fee per share
+# occupied by their blobs, all other txs by the gas price of their fee.
+tx-priority = "{{ .TxPriority }}"
+`, 1)
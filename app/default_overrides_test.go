@@ -1,15 +1,20 @@
 package app
 
 import (
+	"bytes"
 	"testing"
+	"text/template"
 	"time"
 
+	"github.com/celestiaorg/celestia-app/v2/app/ante"
 	"github.com/celestiaorg/celestia-app/v2/app/encoding"
 	"github.com/cosmos/cosmos-sdk/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	icagenesistypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/genesis/types"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	tmcfg "github.com/tendermint/tendermint/config"
 )
 
@@ -66,6 +71,19 @@ func TestDefaultAppConfig(t *testing.T) {
 	assert.Equal(t, "0.002utia", cfg.MinGasPrices)
 }
 
+func TestCustomAppConfigTemplate(t *testing.T) {
+	var buf bytes.Buffer
+	tmpl, err := template.New("app.toml").Parse(CustomAppConfigTemplate)
+	require.NoError(t, err)
+	require.NoError(t, tmpl.Execute(&buf, DefaultCustomAppConfig()))
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buf))
+	assert.Equal(t, string(ante.DefaultTxPriorityMode), v.GetString(ante.FlagTxPriority))
+	assert.Equal(t, "0.002utia", v.GetString("minimum-gas-prices"))
+}
+
 func TestDefaultConsensusConfig(t *testing.T) {
 	got := DefaultConsensusConfig()
 
@@ -3,7 +3,9 @@ package app
 import (
 	"encoding/json"
 	"log"
+	"slices"
 
+	signaltypes "github.com/celestiaorg/celestia-app/v2/x/signal/types"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -211,4 +213,11 @@ func (app *App) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []str
 			return false
 		},
 	)
+
+	/* Handle signal state. */
+
+	// rebase the height of the pending upgrade on the new chain
+	if slices.Contains(app.manager.ModuleNames(app.AppVersion()), signaltypes.ModuleName) {
+		app.SignalKeeper.PrepForZeroHeightGenesis(ctx, height)
+	}
 }
@@ -12,6 +12,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -37,7 +38,8 @@ func TestConfigurator(t *testing.T) {
 		stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 		require.NoError(t, stateStore.LoadLatestVersion())
 
-		keeper := signal.NewKeeper(config.Codec, storeKey, nil)
+		paramsSubspace := paramstypes.NewSubspace(config.Codec, config.Amino, sdk.NewKVStoreKey(paramstypes.StoreKey), sdk.NewTransientStoreKey(paramstypes.TStoreKey), signaltypes.ModuleName)
+		keeper := signal.NewKeeper(config.Codec, storeKey, paramsSubspace, nil, "")
 		require.NotNil(t, keeper)
 		upgradeModule := signal.NewAppModule(keeper)
 		manager, err := module.NewManager([]module.VersionedModule{
@@ -50,8 +52,10 @@ func TestConfigurator(t *testing.T) {
 		acceptedMessages := configurator.GetAcceptedMessages()
 		assert.Equal(t, map[uint64]map[string]struct{}{
 			2: {
-				"/celestia.signal.v1.MsgSignalVersion": {},
-				"/celestia.signal.v1.MsgTryUpgrade":    {},
+				"/celestia.signal.v1.MsgSignalVersion":     {},
+				"/celestia.signal.v1.MsgTryUpgrade":        {},
+				"/celestia.signal.v1.MsgCancelUpgrade":     {},
+				"/celestia.signal.v1.MsgRescheduleUpgrade": {},
 			},
 		}, acceptedMessages)
 	})
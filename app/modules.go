@@ -126,9 +126,13 @@ func (app *App) setupModuleManager(skipGenesisInvariants bool) error {
 			Module:      gov.NewAppModule(app.appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
 			FromVersion: v1, ToVersion: v2,
 		},
+		{
+			Module:      mint.NewAppModuleV1(app.appCodec, app.MintKeeper, app.AccountKeeper),
+			FromVersion: v1, ToVersion: v1,
+		},
 		{
 			Module:      mint.NewAppModule(app.appCodec, app.MintKeeper, app.AccountKeeper),
-			FromVersion: v1, ToVersion: v2,
+			FromVersion: v2, ToVersion: v2,
 		},
 		{
 			Module:      slashing.NewAppModule(app.appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
@@ -175,7 +179,7 @@ func (app *App) setupModuleManager(skipGenesisInvariants bool) error {
 			FromVersion: v2, ToVersion: v2,
 		},
 		{
-			Module:      minfee.NewAppModule(app.ParamsKeeper),
+			Module:      minfee.NewAppModule(app.MinFeeKeeper),
 			FromVersion: v2, ToVersion: v2,
 		},
 		{
@@ -0,0 +1,93 @@
+package posthandler
+
+import (
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	v1 "github.com/celestiaorg/celestia-app/v2/pkg/appconsts/v1"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlobFeeBurner burns the fees paid for blobs.
+type BlobFeeBurner interface {
+	GasPerBlobByte(ctx sdk.Context) uint32
+	BlobFeeBurnFraction(ctx sdk.Context) sdk.Dec
+	BlobGasPricing(ctx sdk.Context) blobtypes.BlobGasPricing
+	BurnBlobFee(ctx sdk.Context, payer sdk.AccAddress, amount sdk.Coins) error
+}
+
+// BurnBlobFeeDecorator burns the BlobFeeBurnFraction of the fee paid for the
+// blob bytes of the MsgPayForBlobs in a tx. The fee paid for the blob bytes is
+// the share of the tx fee that corresponds to the gas consumed for the blobs.
+type BurnBlobFeeDecorator struct {
+	burner BlobFeeBurner
+}
+
+func NewBurnBlobFeeDecorator(burner BlobFeeBurner) BurnBlobFeeDecorator {
+	return BurnBlobFeeDecorator{burner: burner}
+}
+
+// AnteHandle implements the AnteHandler interface. It only burns blob fees in
+// DeliverTx for app versions greater than one. The burn is not metered so
+// that it doesn't change the gas used by txs, which clients estimate offline.
+func (d BurnBlobFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() || simulate || ctx.BlockHeader().Version.App == v1.Version {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	unmeteredCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	fraction := d.burner.BlobFeeBurnFraction(unmeteredCtx)
+	if !fraction.IsPositive() {
+		return next(ctx, tx, simulate)
+	}
+
+	burn := BlobFeeToBurn(tx, feeTx.GetFee(), feeTx.GetGas(), d.burner.GasPerBlobByte(unmeteredCtx), d.burner.BlobGasPricing(unmeteredCtx), fraction)
+	if burn.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := d.burner.BurnBlobFee(unmeteredCtx, feePayer(feeTx), burn); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// feePayer returns the address that paid the fee of feeTx, which is the fee
+// granter if there is one.
+func feePayer(feeTx sdk.FeeTx) sdk.AccAddress {
+	if granter := feeTx.FeeGranter(); granter != nil {
+		return granter
+	}
+	return feeTx.FeePayer()
+}
+
+// BlobFeeToBurn returns the amount of fee to burn for the blobs of the
+// MsgPayForBlobs in tx. It is the fraction of the fee paid for the gas consumed
+// by the blobs with the blob gas pricing, which is capped at the gas limit of
+// the tx.
+func BlobFeeToBurn(tx sdk.Tx, fee sdk.Coins, gasLimit uint64, gasPerBlobByte uint32, pricing blobtypes.BlobGasPricing, fraction sdk.Dec) sdk.Coins {
+	var blobGas uint64
+	for _, m := range tx.GetMsgs() {
+		if pfb, ok := m.(*blobtypes.MsgPayForBlobs); ok {
+			blobGas += pfb.Gas(gasPerBlobByte, pricing)
+		}
+	}
+	if blobGas == 0 || gasLimit == 0 {
+		return sdk.NewCoins()
+	}
+	if blobGas > gasLimit {
+		blobGas = gasLimit
+	}
+
+	amount := sdk.NewDecFromInt(fee.AmountOf(appconsts.BondDenom)).
+		Mul(fraction).
+		MulInt(sdk.NewIntFromUint64(blobGas)).
+		QuoInt(sdk.NewIntFromUint64(gasLimit)).
+		TruncateInt()
+	return sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, amount))
+}
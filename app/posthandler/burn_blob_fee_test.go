@@ -0,0 +1,167 @@
+package posthandler_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/app/encoding"
+	"github.com/celestiaorg/celestia-app/v2/app/posthandler"
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+type mockBlobFeeBurner struct {
+	fraction sdk.Dec
+	payer    sdk.AccAddress
+	burned   sdk.Coins
+}
+
+func (m *mockBlobFeeBurner) GasPerBlobByte(_ sdk.Context) uint32 {
+	return appconsts.DefaultGasPerBlobByte
+}
+
+func (m *mockBlobFeeBurner) BlobFeeBurnFraction(_ sdk.Context) sdk.Dec {
+	return m.fraction
+}
+
+func (m *mockBlobFeeBurner) BlobGasPricing(_ sdk.Context) blobtypes.BlobGasPricing {
+	return blobtypes.BlobGasPricing{}
+}
+
+func (m *mockBlobFeeBurner) BurnBlobFee(_ sdk.Context, payer sdk.AccAddress, amount sdk.Coins) error {
+	m.payer = payer
+	m.burned = m.burned.Add(amount...)
+	return nil
+}
+
+func TestBurnBlobFeeDecorator(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer := sdk.AccAddress("signer")
+	granter := sdk.AccAddress("granter")
+	blobSizes := []uint32{100, 10_000}
+	blobGas := blobtypes.GasToConsume(blobSizes, appconsts.DefaultGasPerBlobByte)
+	half := sdk.NewDecWithPrec(5, 1)
+
+	testCases := []struct {
+		name       string
+		msg        sdk.Msg
+		appVersion uint64
+		isCheckTx  bool
+		simulate   bool
+		fraction   sdk.Dec
+		gasLimit   uint64
+		fee        int64
+		granter    sdk.AccAddress
+		wantBurned sdk.Coins
+		wantPayer  sdk.AccAddress
+	}{
+		{
+			name:       "burns the fraction of the blob fee in DeliverTx",
+			msg:        &blobtypes.MsgPayForBlobs{Signer: signer.String(), BlobSizes: blobSizes},
+			appVersion: 2,
+			fraction:   half,
+			gasLimit:   blobGas * 2,
+			fee:        1000,
+			wantBurned: sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 250)),
+			wantPayer:  signer,
+		},
+		{
+			name:       "burns the blob fee of the fee granter",
+			msg:        &blobtypes.MsgPayForBlobs{Signer: signer.String(), BlobSizes: blobSizes},
+			appVersion: 2,
+			fraction:   sdk.OneDec(),
+			gasLimit:   blobGas * 4,
+			fee:        1000,
+			granter:    granter,
+			wantBurned: sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 250)),
+			wantPayer:  granter,
+		},
+		{
+			name:       "caps the blob gas at the gas limit",
+			msg:        &blobtypes.MsgPayForBlobs{Signer: signer.String(), BlobSizes: blobSizes},
+			appVersion: 2,
+			fraction:   half,
+			gasLimit:   blobGas / 2,
+			fee:        1000,
+			wantBurned: sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 500)),
+			wantPayer:  signer,
+		},
+		{
+			name:       "truncates the burned amount",
+			msg:        &blobtypes.MsgPayForBlobs{Signer: signer.String(), BlobSizes: blobSizes},
+			appVersion: 2,
+			fraction:   half,
+			gasLimit:   blobGas * 2,
+			fee:        3,
+			wantBurned: nil,
+		},
+		{
+			name:       "doesn't burn if the fraction is zero",
+			msg:        &blobtypes.MsgPayForBlobs{Signer: signer.String(), BlobSizes: blobSizes},
+			appVersion: 2,
+			fraction:   sdk.ZeroDec(),
+			gasLimit:   blobGas * 2,
+			fee:        1000,
+		},
+		{
+			name:       "doesn't burn for txs without blobs",
+			msg:        banktypes.NewMsgSend(signer, granter, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1))),
+			appVersion: 2,
+			fraction:   half,
+			gasLimit:   blobGas * 2,
+			fee:        1000,
+		},
+		{
+			name:       "doesn't burn in CheckTx",
+			msg:        &blobtypes.MsgPayForBlobs{Signer: signer.String(), BlobSizes: blobSizes},
+			appVersion: 2,
+			isCheckTx:  true,
+			fraction:   half,
+			gasLimit:   blobGas * 2,
+			fee:        1000,
+		},
+		{
+			name:       "doesn't burn when simulating",
+			msg:        &blobtypes.MsgPayForBlobs{Signer: signer.String(), BlobSizes: blobSizes},
+			appVersion: 2,
+			simulate:   true,
+			fraction:   half,
+			gasLimit:   blobGas * 2,
+			fee:        1000,
+		},
+		{
+			name:       "doesn't burn for app version 1",
+			msg:        &blobtypes.MsgPayForBlobs{Signer: signer.String(), BlobSizes: blobSizes},
+			appVersion: 1,
+			fraction:   half,
+			gasLimit:   blobGas * 2,
+			fee:        1000,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			burner := &mockBlobFeeBurner{fraction: tc.fraction}
+			postHandler := sdk.ChainAnteDecorators(posthandler.NewBurnBlobFeeDecorator(burner))
+
+			builder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(tc.msg))
+			builder.SetGasLimit(tc.gasLimit)
+			builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, tc.fee)))
+			builder.SetFeeGranter(tc.granter)
+			ctx := sdk.NewContext(nil, tmproto.Header{Version: version.Consensus{App: tc.appVersion}}, tc.isCheckTx, nil).
+				WithGasMeter(sdk.NewGasMeter(tc.gasLimit))
+
+			_, err := postHandler(ctx, builder.GetTx(), tc.simulate)
+			require.NoError(t, err)
+			assert.True(t, tc.wantBurned.IsEqual(burner.burned), "want %v, got %v", tc.wantBurned, burner.burned)
+			assert.Equal(t, tc.wantPayer, burner.payer)
+			assert.Zero(t, ctx.GasMeter().GasConsumed())
+		})
+	}
+}
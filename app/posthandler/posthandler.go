@@ -6,7 +6,12 @@ import (
 
 // New returns a new posthandler chain. Note: the Cosmos SDK does not export a
 // type for PostHandler so the AnteHandler type is used.
-func New() sdk.AnteHandler {
-	postDecorators := []sdk.AnteDecorator{}
+func New(blobFeeBurner BlobFeeBurner, gasRefunder GasRefunder) sdk.AnteHandler {
+	postDecorators := []sdk.AnteDecorator{
+		// Burn a fraction of the fees paid for blobs.
+		NewBurnBlobFeeDecorator(blobFeeBurner),
+		// Refund a fraction of the fees paid for unused gas of PFBs.
+		NewRefundGasDecorator(gasRefunder),
+	}
 	return sdk.ChainAnteDecorators(postDecorators...)
 }
@@ -0,0 +1,86 @@
+package posthandler
+
+import (
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	v1 "github.com/celestiaorg/celestia-app/v2/pkg/appconsts/v1"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GasRefunder refunds the fees paid for unused gas.
+type GasRefunder interface {
+	GasRefundParams(ctx sdk.Context) (fraction sdk.Dec, maxRefund uint64)
+	RefundGas(ctx sdk.Context, payer sdk.AccAddress, gasUnused uint64, amount sdk.Coins) error
+}
+
+// RefundGasDecorator refunds the GasRefundFraction of the fee paid for the
+// unused gas of a tx that contains a MsgPayForBlobs to the fee payer or fee
+// granter. The refund is capped at MaxGasRefund so that padding the gas limit
+// of a tx can't be used to reserve block space cheaply.
+type RefundGasDecorator struct {
+	refunder GasRefunder
+}
+
+func NewRefundGasDecorator(refunder GasRefunder) RefundGasDecorator {
+	return RefundGasDecorator{refunder: refunder}
+}
+
+// AnteHandle implements the AnteHandler interface. It only refunds gas in
+// DeliverTx for app versions greater than one. The unused gas is read from the
+// gas meter of the tx, which is the same on every validator. The refund is not
+// metered so that it doesn't change the gas used by txs.
+func (d RefundGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() || simulate || ctx.BlockHeader().Version.App == v1.Version {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 || !hasPayForBlobs(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	unmeteredCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	fraction, maxRefund := d.refunder.GasRefundParams(unmeteredCtx)
+	if !fraction.IsPositive() || maxRefund == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	gasLimit := feeTx.GetGas()
+	gasUsed := ctx.GasMeter().GasConsumedToLimit()
+	refund := GasRefund(feeTx.GetFee(), gasLimit, gasUsed, fraction, maxRefund)
+	if refund.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := d.refunder.RefundGas(unmeteredCtx, feePayer(feeTx), gasLimit-gasUsed, refund); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// GasRefund returns the amount of fee to refund for a tx with gasLimit that
+// used gasUsed. It is the fraction of the fee paid for the unused gas, capped
+// at maxRefund utia.
+func GasRefund(fee sdk.Coins, gasLimit, gasUsed uint64, fraction sdk.Dec, maxRefund uint64) sdk.Coins {
+	if gasLimit == 0 || gasUsed >= gasLimit {
+		return sdk.NewCoins()
+	}
+
+	amount := sdk.NewDecFromInt(fee.AmountOf(appconsts.BondDenom)).
+		Mul(fraction).
+		MulInt(sdk.NewIntFromUint64(gasLimit - gasUsed)).
+		QuoInt(sdk.NewIntFromUint64(gasLimit)).
+		TruncateInt()
+	amount = sdk.MinInt(amount, sdk.NewIntFromUint64(maxRefund))
+	return sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, amount))
+}
+
+func hasPayForBlobs(tx sdk.Tx) bool {
+	for _, m := range tx.GetMsgs() {
+		if _, ok := m.(*blobtypes.MsgPayForBlobs); ok {
+			return true
+		}
+	}
+	return false
+}
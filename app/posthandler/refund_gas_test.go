@@ -0,0 +1,188 @@
+package posthandler_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/app/encoding"
+	"github.com/celestiaorg/celestia-app/v2/app/posthandler"
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+type mockGasRefunder struct {
+	fraction  sdk.Dec
+	maxRefund uint64
+	payer     sdk.AccAddress
+	gasUnused uint64
+	refunded  sdk.Coins
+}
+
+func (m *mockGasRefunder) GasRefundParams(_ sdk.Context) (sdk.Dec, uint64) {
+	return m.fraction, m.maxRefund
+}
+
+func (m *mockGasRefunder) RefundGas(_ sdk.Context, payer sdk.AccAddress, gasUnused uint64, amount sdk.Coins) error {
+	m.payer = payer
+	m.gasUnused = gasUnused
+	m.refunded = m.refunded.Add(amount...)
+	return nil
+}
+
+func TestRefundGasDecorator(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer := sdk.AccAddress("signer")
+	granter := sdk.AccAddress("granter")
+	pfb := &blobtypes.MsgPayForBlobs{Signer: signer.String(), BlobSizes: []uint32{100}}
+	half := sdk.NewDecWithPrec(5, 1)
+
+	testCases := []struct {
+		name          string
+		msg           sdk.Msg
+		appVersion    uint64
+		isCheckTx     bool
+		simulate      bool
+		fraction      sdk.Dec
+		maxRefund     uint64
+		gasUsed       uint64
+		granter       sdk.AccAddress
+		wantRefunded  sdk.Coins
+		wantPayer     sdk.AccAddress
+		wantGasUnused uint64
+	}{
+		{
+			name:          "refunds the fraction of the unused gas fee in DeliverTx",
+			msg:           pfb,
+			appVersion:    2,
+			fraction:      half,
+			maxRefund:     1000,
+			gasUsed:       60_000,
+			wantRefunded:  sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 200)),
+			wantPayer:     signer,
+			wantGasUnused: 40_000,
+		},
+		{
+			name:          "refunds the fee granter",
+			msg:           pfb,
+			appVersion:    2,
+			fraction:      sdk.OneDec(),
+			maxRefund:     1000,
+			gasUsed:       90_000,
+			granter:       granter,
+			wantRefunded:  sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 100)),
+			wantPayer:     granter,
+			wantGasUnused: 10_000,
+		},
+		{
+			name:          "caps the refund at the max gas refund",
+			msg:           pfb,
+			appVersion:    2,
+			fraction:      sdk.OneDec(),
+			maxRefund:     42,
+			gasUsed:       10_000,
+			wantRefunded:  sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 42)),
+			wantPayer:     signer,
+			wantGasUnused: 90_000,
+		},
+		{
+			name:       "doesn't refund if all the gas was used",
+			msg:        pfb,
+			appVersion: 2,
+			fraction:   half,
+			maxRefund:  1000,
+			gasUsed:    100_000,
+		},
+		{
+			name:       "doesn't refund if the fraction is zero",
+			msg:        pfb,
+			appVersion: 2,
+			fraction:   sdk.ZeroDec(),
+			maxRefund:  1000,
+			gasUsed:    10_000,
+		},
+		{
+			name:       "doesn't refund if the max gas refund is zero",
+			msg:        pfb,
+			appVersion: 2,
+			fraction:   half,
+			gasUsed:    10_000,
+		},
+		{
+			name:       "doesn't refund txs without blobs",
+			msg:        banktypes.NewMsgSend(signer, granter, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1))),
+			appVersion: 2,
+			fraction:   half,
+			maxRefund:  1000,
+			gasUsed:    10_000,
+		},
+		{
+			name:       "doesn't refund in CheckTx",
+			msg:        pfb,
+			appVersion: 2,
+			isCheckTx:  true,
+			fraction:   half,
+			maxRefund:  1000,
+			gasUsed:    10_000,
+		},
+		{
+			name:       "doesn't refund when simulating",
+			msg:        pfb,
+			appVersion: 2,
+			simulate:   true,
+			fraction:   half,
+			maxRefund:  1000,
+			gasUsed:    10_000,
+		},
+		{
+			name:       "doesn't refund for app version 1",
+			msg:        pfb,
+			appVersion: 1,
+			fraction:   half,
+			maxRefund:  1000,
+			gasUsed:    10_000,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gasLimit := uint64(100_000)
+			refunder := &mockGasRefunder{fraction: tc.fraction, maxRefund: tc.maxRefund}
+			postHandler := sdk.ChainAnteDecorators(posthandler.NewRefundGasDecorator(refunder))
+
+			builder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(tc.msg))
+			builder.SetGasLimit(gasLimit)
+			builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1000)))
+			builder.SetFeeGranter(tc.granter)
+			gasMeter := sdk.NewGasMeter(gasLimit)
+			gasMeter.ConsumeGas(tc.gasUsed, "test")
+			ctx := sdk.NewContext(nil, tmproto.Header{Version: version.Consensus{App: tc.appVersion}}, tc.isCheckTx, nil).
+				WithGasMeter(gasMeter)
+
+			_, err := postHandler(ctx, builder.GetTx(), tc.simulate)
+			require.NoError(t, err)
+			assert.True(t, tc.wantRefunded.IsEqual(refunder.refunded), "want %v, got %v", tc.wantRefunded, refunder.refunded)
+			assert.Equal(t, tc.wantPayer, refunder.payer)
+			assert.Equal(t, tc.wantGasUnused, refunder.gasUnused)
+			assert.Equal(t, tc.gasUsed, ctx.GasMeter().GasConsumed())
+		})
+	}
+}
+
+func TestGasRefund(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1001))
+	half := sdk.NewDecWithPrec(5, 1)
+
+	// the refund is truncated
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 250)), posthandler.GasRefund(fee, 1000, 500, half, 1000))
+	// other denoms aren't refunded
+	assert.True(t, posthandler.GasRefund(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), 1000, 500, half, 1000).IsZero())
+	// gas used above the gas limit doesn't refund anything
+	assert.True(t, posthandler.GasRefund(fee, 1000, 1001, half, 1000).IsZero())
+	assert.True(t, posthandler.GasRefund(fee, 0, 0, half, 1000).IsZero())
+}
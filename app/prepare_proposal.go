@@ -9,6 +9,7 @@ import (
 	"github.com/celestiaorg/go-square/shares"
 	"github.com/celestiaorg/go-square/square"
 	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	core "github.com/tendermint/tendermint/proto/tendermint/types"
 	version "github.com/tendermint/tendermint/proto/tendermint/version"
@@ -39,11 +40,20 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 		ante.DefaultSigVerificationGasConsumer,
 		app.IBCKeeper,
 		app.ParamsKeeper,
+		app.MinFeeKeeper,
 		app.MsgGateKeeper,
+		app.txPriorityMode,
 	)
 
+	// Order the transactions by the same priority as the mempool. This is done
+	// before filtering so that the antehandler checks them in the order in
+	// which they are included in the block.
+	txs := PrioritizeTxs(app.txConfig.TxDecoder(), func(tx sdk.Tx) int64 {
+		return ante.GetTxPriority(sdkCtx, tx, app.ParamsKeeper, app.txPriorityMode)
+	}, req.BlockData.Txs)
+
 	// Filter out invalid transactions.
-	txs := FilterTxs(app.Logger(), sdkCtx, handler, app.txConfig, req.BlockData.Txs)
+	txs = FilterTxs(app.Logger(), sdkCtx, handler, app.txConfig, txs)
 
 	// Build the square from the set of valid and prioritised transactions.
 	// The txs returned are the ones used in the square and block.
@@ -55,20 +65,11 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 		panic(err)
 	}
 
-	// Erasure encode the data square to create the extended data square (eds).
-	// Note: uses the nmt wrapper to construct the tree. See
-	// pkg/wrapper/nmt_wrapper.go for more information.
-	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
-	if err != nil {
-		app.Logger().Error(
-			"failure to erasure the data square while creating a proposal block",
-			"error",
-			err.Error(),
-		)
-		panic(err)
-	}
-
-	dah, err := da.NewDataAvailabilityHeader(eds)
+	// Erasure encode the data square and compute the data availability header
+	// without materializing the extended data square (eds). Note: uses the nmt
+	// wrapper to construct the tree. See pkg/wrapper/nmt_wrapper.go for more
+	// information.
+	dah, err := da.NewDataAvailabilityHeaderFromShares(shares.ToBytes(dataSquare))
 	if err != nil {
 		app.Logger().Error(
 			"failure to create new data availability header",
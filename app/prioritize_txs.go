@@ -0,0 +1,146 @@
+package app
+
+import (
+	"container/heap"
+
+	"github.com/celestiaorg/go-square/blob"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// prioritizedTx is a raw tx with the index it was proposed at and its priority.
+type prioritizedTx struct {
+	rawTx    []byte
+	index    int
+	priority int64
+}
+
+// PrioritizeTxs orders txs by descending priority, as computed by priorityFn,
+// so that block building follows the same priority as the mempool. Txs with
+// equal priority keep their relative order.
+//
+// Txs that share a signer keep their relative order, even if a later one has a
+// higher priority, so that their sequences remain valid. Txs that can't be
+// decoded are assigned a priority of zero.
+func PrioritizeTxs(dec sdk.TxDecoder, priorityFn func(sdk.Tx) int64, txs [][]byte) [][]byte {
+	// Group the txs that share a signer, directly or through other txs, into
+	// queues that preserve their relative order.
+	signerGroups := newDisjointSet()
+	groupOfTx := make([]int, len(txs))
+	prioritized := make([]prioritizedTx, len(txs))
+	for i, rawTx := range txs {
+		prioritized[i] = prioritizedTx{rawTx: rawTx, index: i}
+		groupOfTx[i] = signerGroups.add()
+
+		sdkTx, err := decodeTx(dec, rawTx)
+		if err != nil {
+			continue
+		}
+		prioritized[i].priority = priorityFn(sdkTx)
+		for _, msg := range sdkTx.GetMsgs() {
+			for _, signer := range msg.GetSigners() {
+				signerGroups.unionKey(signer.String(), groupOfTx[i])
+			}
+		}
+	}
+
+	queues := make(map[int][]prioritizedTx)
+	for i, tx := range prioritized {
+		group := signerGroups.find(groupOfTx[i])
+		queues[group] = append(queues[group], tx)
+	}
+
+	// Repeatedly take the highest priority tx at the head of a queue.
+	heads := make(txHeap, 0, len(queues))
+	for group, queue := range queues {
+		heads = append(heads, txQueue{group: group, txs: queue})
+	}
+	heap.Init(&heads)
+	ordered := make([][]byte, 0, len(txs))
+	for heads.Len() > 0 {
+		queue := &heads[0]
+		ordered = append(ordered, queue.txs[0].rawTx)
+		queue.txs = queue.txs[1:]
+		if len(queue.txs) == 0 {
+			heap.Pop(&heads)
+		} else {
+			heap.Fix(&heads, 0)
+		}
+	}
+	return ordered
+}
+
+// decodeTx decodes a raw tx, unwrapping it first if it is a blob tx.
+func decodeTx(dec sdk.TxDecoder, rawTx []byte) (sdk.Tx, error) {
+	if bTx, isBlob := blob.UnmarshalBlobTx(rawTx); isBlob {
+		rawTx = bTx.Tx
+	}
+	return dec(rawTx)
+}
+
+// txQueue is the ordered txs of a signer group that are yet to be taken.
+type txQueue struct {
+	group int
+	txs   []prioritizedTx
+}
+
+// txHeap is a max heap of txQueues ordered by the priority of their head tx,
+// breaking ties by the index of their head tx.
+type txHeap []txQueue
+
+func (h txHeap) Len() int { return len(h) }
+
+func (h txHeap) Less(i, j int) bool {
+	a, b := h[i].txs[0], h[j].txs[0]
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+	return a.index < b.index
+}
+
+func (h txHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *txHeap) Push(x any) { *h = append(*h, x.(txQueue)) }
+
+func (h *txHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// disjointSet is a union-find of tx groups, where the groups of txs that share
+// a key are merged.
+type disjointSet struct {
+	parents []int
+	keys    map[string]int
+}
+
+func newDisjointSet() *disjointSet {
+	return &disjointSet{keys: make(map[string]int)}
+}
+
+// add adds a new group and returns it.
+func (s *disjointSet) add() int {
+	s.parents = append(s.parents, len(s.parents))
+	return len(s.parents) - 1
+}
+
+// find returns the root group of group.
+func (s *disjointSet) find(group int) int {
+	for s.parents[group] != group {
+		s.parents[group] = s.parents[s.parents[group]]
+		group = s.parents[group]
+	}
+	return group
+}
+
+// unionKey merges group with the group of the txs that share key.
+func (s *disjointSet) unionKey(key string, group int) {
+	other, ok := s.keys[key]
+	if !ok {
+		s.keys[key] = group
+		return
+	}
+	s.parents[s.find(group)] = s.find(other)
+}
@@ -0,0 +1,150 @@
+package app_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/go-square/blob"
+	appns "github.com/celestiaorg/go-square/namespace"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrioritizeTxs(t *testing.T) {
+	alice := sdk.AccAddress("alice").String()
+	bob := sdk.AccAddress("bob").String()
+	carol := sdk.AccAddress("carol").String()
+
+	type mockTx struct {
+		signers  []string
+		priority int64
+	}
+
+	cases := []struct {
+		name     string
+		txs      map[string]mockTx
+		input    []string
+		expected []string
+	}{
+		{
+			name:     "empty",
+			expected: []string{},
+		},
+		{
+			name: "orders by descending priority",
+			txs: map[string]mockTx{
+				"a": {signers: []string{alice}, priority: 1},
+				"b": {signers: []string{bob}, priority: 3},
+				"c": {signers: []string{carol}, priority: 2},
+			},
+			input:    []string{"a", "b", "c"},
+			expected: []string{"b", "c", "a"},
+		},
+		{
+			name: "equal priorities keep their order",
+			txs: map[string]mockTx{
+				"a": {signers: []string{alice}, priority: 1},
+				"b": {signers: []string{bob}, priority: 1},
+				"c": {signers: []string{carol}, priority: 1},
+			},
+			input:    []string{"c", "a", "b"},
+			expected: []string{"c", "a", "b"},
+		},
+		{
+			name: "txs of the same signer keep their order",
+			txs: map[string]mockTx{
+				"a1": {signers: []string{alice}, priority: 1},
+				"a2": {signers: []string{alice}, priority: 5},
+				"b":  {signers: []string{bob}, priority: 3},
+			},
+			input:    []string{"a1", "a2", "b"},
+			expected: []string{"b", "a1", "a2"},
+		},
+		{
+			name: "txs linked by a multi signer tx keep their order",
+			txs: map[string]mockTx{
+				"a":  {signers: []string{alice}, priority: 1},
+				"ab": {signers: []string{alice, bob}, priority: 2},
+				"b":  {signers: []string{bob}, priority: 5},
+				"c":  {signers: []string{carol}, priority: 3},
+			},
+			input:    []string{"a", "ab", "b", "c"},
+			expected: []string{"c", "a", "ab", "b"},
+		},
+		{
+			name: "undecodable txs have zero priority",
+			txs: map[string]mockTx{
+				"a": {signers: []string{alice}, priority: 1},
+			},
+			input:    []string{"invalid", "a"},
+			expected: []string{"a", "invalid"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dec := func(txBytes []byte) (sdk.Tx, error) {
+				tx, ok := tc.txs[string(txBytes)]
+				if !ok {
+					return nil, errors.New("invalid tx")
+				}
+				msgs := make([]sdk.Msg, len(tx.signers))
+				for i, signer := range tx.signers {
+					msgs[i] = &banktypes.MsgSend{FromAddress: signer}
+				}
+				return prioritizedMockTx{msgs: msgs, priority: tx.priority}, nil
+			}
+			priorityFn := func(tx sdk.Tx) int64 {
+				return tx.(prioritizedMockTx).priority
+			}
+
+			got := app.PrioritizeTxs(dec, priorityFn, toBytes(tc.input))
+			assert.Equal(t, toBytes(tc.expected), got)
+		})
+	}
+
+	t.Run("blob txs are decoded", func(t *testing.T) {
+		dec := func(txBytes []byte) (sdk.Tx, error) {
+			switch string(txBytes) {
+			case "low":
+				return prioritizedMockTx{priority: 1}, nil
+			case "high":
+				return prioritizedMockTx{priority: 2}, nil
+			}
+			return nil, errors.New("invalid tx")
+		}
+		priorityFn := func(tx sdk.Tx) int64 {
+			return tx.(prioritizedMockTx).priority
+		}
+		b := blob.New(appns.RandomBlobNamespace(), []byte{1}, appconsts.DefaultShareVersion)
+		low, err := blob.MarshalBlobTx([]byte("low"), b)
+		require.NoError(t, err)
+		high, err := blob.MarshalBlobTx([]byte("high"), b)
+		require.NoError(t, err)
+
+		got := app.PrioritizeTxs(dec, priorityFn, [][]byte{low, high})
+		assert.Equal(t, [][]byte{high, low}, got)
+	})
+}
+
+// prioritizedMockTx is an sdk.Tx with a predefined priority.
+type prioritizedMockTx struct {
+	msgs     []sdk.Msg
+	priority int64
+}
+
+func (tx prioritizedMockTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx prioritizedMockTx) ValidateBasic() error { return nil }
+
+func toBytes(txs []string) [][]byte {
+	out := make([][]byte, len(txs))
+	for i, tx := range txs {
+		out[i] = []byte(tx)
+	}
+	return out
+}
@@ -47,7 +47,9 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 		ante.DefaultSigVerificationGasConsumer,
 		app.IBCKeeper,
 		app.ParamsKeeper,
+		app.MinFeeKeeper,
 		app.MsgGateKeeper,
+		app.txPriorityMode,
 	)
 	sdkCtx := app.NewProposalContext(req.Header)
 	subtreeRootThreshold := appconsts.SubtreeRootThreshold(app.GetBaseApp().AppVersion())
@@ -135,13 +137,7 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 		return reject()
 	}
 
-	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
-	if err != nil {
-		logInvalidPropBlockError(app.Logger(), req.Header, "failure to erasure the data square", err)
-		return reject()
-	}
-
-	dah, err := da.NewDataAvailabilityHeader(eds)
+	dah, err := da.NewDataAvailabilityHeaderFromShares(shares.ToBytes(dataSquare))
 	if err != nil {
 		logInvalidPropBlockError(app.Logger(), req.Header, "failure to create new data availability header", err)
 		return reject()
@@ -0,0 +1,69 @@
+package app_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/app/encoding"
+	"github.com/celestiaorg/celestia-app/v2/app/posthandler"
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
+	"github.com/celestiaorg/celestia-app/v2/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	"github.com/celestiaorg/go-square/blob"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// TestBurnBlobFee verifies that delivering a PFB burns the BlobFeeBurnFraction
+// of the fee paid for its blobs and decreases the supply accordingly.
+func TestBurnBlobFee(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := testfactory.GenerateAccounts(1)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	signer, err := user.NewSigner(kr, encCfg.TxConfig, testutil.ChainID, appconsts.LatestVersion, user.NewAccount(accounts[0], 1, 0))
+	require.NoError(t, err)
+
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	fraction := sdk.NewDecWithPrec(5, 1)
+	params := testApp.BlobKeeper.GetParams(ctx)
+	params.BlobFeeBurnFraction = fraction
+	testApp.BlobKeeper.SetParams(ctx, params)
+	supplyBefore := testApp.BankKeeper.GetSupply(ctx, appconsts.BondDenom)
+
+	blobs := blobfactory.ManyRandBlobs(tmrand.NewRand(), 1020, 2099, 96, 4087, 500)
+	gas := blobtypes.DefaultEstimateGas([]uint32{1020, 2099, 96, 4087, 500})
+	fee := sdk.NewCoins(sdk.NewCoin(app.BondDenom, math.NewInt(int64(gas))))
+	tx, _, err := signer.CreatePayForBlobs(accounts[0], blobs, user.SetGasLimit(gas), user.SetFeeAmount(fee))
+	require.NoError(t, err)
+	blobTx, ok := blob.UnmarshalBlobTx(tx)
+	require.True(t, ok)
+
+	resp := testApp.DeliverTx(abci.RequestDeliverTx{Tx: blobTx.Tx})
+	require.EqualValues(t, 0, resp.Code, resp.Log)
+	require.Less(t, resp.GasUsed, int64(gas))
+
+	sdkTx, err := encCfg.TxConfig.TxDecoder()(blobTx.Tx)
+	require.NoError(t, err)
+	wantBurned := posthandler.BlobFeeToBurn(sdkTx, fee, gas, params.GasPerBlobByte, params.BlobGasPricing(), fraction)
+	require.True(t, wantBurned.IsAllPositive())
+
+	supplyAfter := testApp.BankKeeper.GetSupply(ctx, appconsts.BondDenom)
+	assert.Equal(t, supplyBefore.Sub(wantBurned[0]), supplyAfter)
+	assert.Equal(t, wantBurned, testApp.BlobKeeper.GetBurnedBlobFees(ctx))
+
+	var burnEvents int
+	for _, event := range resp.Events {
+		if event.Type == blobtypes.EventTypeBurnBlobFee {
+			burnEvents++
+		}
+	}
+	assert.Equal(t, 1, burnEvents)
+}
@@ -1,9 +1,14 @@
 package app_test
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v2/app"
+	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
+	signaltypes "github.com/celestiaorg/celestia-app/v2/x/signal/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -35,6 +40,89 @@ func TestExportAppStateAndValidators(t *testing.T) {
 		// TODO: the following assertion is commented out because the exported app does not populate consensus params.version
 		// assert.Equal(t, uint64(2), exported.ConsensusParams.Version.AppVersion)
 	})
+	t.Run("should round trip the signal state for version 2", func(t *testing.T) {
+		testApp, valAddr, upgradeHeight := setupPendingUpgrade(t)
+
+		exported, err := testApp.ExportAppStateAndValidators(false, []string{})
+		require.NoError(t, err)
+		genState := exportedSignalGenesis(t, testApp, exported.AppState)
+		assert.Equal(t, []signaltypes.ValidatorSignal{{ValidatorAddress: valAddr.String(), Version: 3}}, genState.ValidatorSignals)
+		assert.Equal(t, &signaltypes.Upgrade{AppVersion: 3, UpgradeHeight: upgradeHeight}, genState.Upgrade)
+
+		// import the exported state in a new chain and export it again.
+		newApp := initChainFromExport(t, exported.AppState, exported.Height)
+		newCtx := newApp.NewContext(true, tmproto.Header{})
+		assert.Equal(t, &genState, newApp.SignalKeeper.ExportGenesis(newCtx))
+		assert.True(t, newApp.SignalKeeper.IsUpgradePending(newCtx))
+	})
+	t.Run("should rebase the pending upgrade height for a zero height export", func(t *testing.T) {
+		testApp, valAddr, upgradeHeight := setupPendingUpgrade(t)
+		lastHeight := testApp.LastBlockHeight()
+
+		exported, err := testApp.ExportAppStateAndValidators(true, []string{})
+		require.NoError(t, err)
+		genState := exportedSignalGenesis(t, testApp, exported.AppState)
+		assert.Equal(t, []signaltypes.ValidatorSignal{{ValidatorAddress: valAddr.String(), Version: 3}}, genState.ValidatorSignals)
+		// the upgrade happens after the same number of blocks on the new chain.
+		assert.Equal(t, &signaltypes.Upgrade{AppVersion: 3, UpgradeHeight: upgradeHeight - lastHeight}, genState.Upgrade)
+
+		newApp := initChainFromExport(t, exported.AppState, exported.Height)
+		newCtx := newApp.NewContext(true, tmproto.Header{})
+		assert.Equal(t, &genState, newApp.SignalKeeper.ExportGenesis(newCtx))
+	})
+}
+
+// setupPendingUpgrade returns an app at version 2 in which a validator
+// signalled for version 3 and the upgrade to version 3 is pending. It also
+// returns the address of the validator and the height of the upgrade.
+func setupPendingUpgrade(t *testing.T) (*app.App, sdk.ValAddress, int64) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+
+	header := tmproto.Header{Height: testApp.LastBlockHeight() + 1, Version: tmversion.Consensus{App: 2}}
+	ctx := testApp.NewContext(false, header)
+	valAddr, err := sdk.ValAddressFromBech32(testApp.StakingKeeper.GetAllValidators(ctx)[0].OperatorAddress)
+	require.NoError(t, err)
+	_, err = testApp.SignalKeeper.SignalVersion(sdk.WrapSDKContext(ctx), signaltypes.NewMsgSignalVersion(valAddr, 3))
+	require.NoError(t, err)
+	_, err = testApp.SignalKeeper.TryUpgrade(sdk.WrapSDKContext(ctx), &signaltypes.MsgTryUpgrade{})
+	require.NoError(t, err)
+	testApp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	testApp.Commit()
+	return testApp, valAddr, header.Height + signaltypes.DefaultUpgradeHeightDelay
+}
+
+// exportedSignalGenesis returns the signal genesis state of appState.
+func exportedSignalGenesis(t *testing.T, testApp *app.App, appState json.RawMessage) signaltypes.GenesisState {
+	var genState app.GenesisState
+	require.NoError(t, json.Unmarshal(appState, &genState))
+	var signalGenState signaltypes.GenesisState
+	require.NoError(t, testApp.AppCodec().UnmarshalJSON(genState[signaltypes.ModuleName], &signalGenState))
+	require.NoError(t, signalGenState.Validate())
+	return signalGenState
+}
+
+// initChainFromExport starts a new chain at app version 2 from the exported
+// appState and commits its genesis.
+func initChainFromExport(t *testing.T, appState json.RawMessage, height int64) *app.App {
+	newApp := testutil.NewTestApp()
+	newApp.Info(abci.RequestInfo{})
+	cparams := app.DefaultConsensusParams()
+	newApp.InitChain(abci.RequestInitChain{
+		Time:          time.Now(),
+		Validators:    []abci.ValidatorUpdate{},
+		AppStateBytes: appState,
+		ChainId:       testutil.ChainID,
+		ConsensusParams: &abci.ConsensusParams{
+			Block:     &abci.BlockParams{MaxBytes: cparams.Block.MaxBytes, MaxGas: cparams.Block.MaxGas},
+			Evidence:  &cparams.Evidence,
+			Validator: &cparams.Validator,
+			Version:   &cparams.Version,
+		},
+		InitialHeight: height,
+	})
+	newApp.Commit()
+	require.EqualValues(t, 2, newApp.AppVersion())
+	return newApp
 }
 
 func upgradeToV2(t *testing.T, testApp *app.App) {
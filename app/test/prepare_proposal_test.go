@@ -14,11 +14,13 @@ import (
 	coretypes "github.com/tendermint/tendermint/types"
 
 	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/app/ante"
 	"github.com/celestiaorg/celestia-app/v2/app/encoding"
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
 	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
 	"github.com/celestiaorg/celestia-app/v2/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testnode"
 	"github.com/celestiaorg/go-square/blob"
 	appns "github.com/celestiaorg/go-square/namespace"
 )
@@ -205,6 +207,43 @@ func TestPrepareProposalFiltering(t *testing.T) {
 	}
 }
 
+// TestPrepareProposalPrioritizesBlobTxsByFeePerShare verifies that blob txs
+// paying the same fee are ordered by the number of shares their blobs occupy,
+// as they are in the mempool, on a node that prioritizes txs by fee per share.
+func TestPrepareProposalPrioritizesBlobTxsByFeePerShare(t *testing.T) {
+	encConf := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := testfactory.GenerateAccounts(3)
+	appOpts := testnode.DefaultAppOptions()
+	appOpts.Set(ante.FlagTxPriority, string(ante.TxPriorityFeePerShare))
+	testApp, kr := testutil.SetupTestAppWithGenesisValSetAndOptions(appOpts, app.DefaultConsensusParams(), accounts...)
+	infos := queryAccountInfo(testApp, accounts, kr)
+
+	// the blob txs pay the same fee for an increasing number of shares
+	blobTxs := blobfactory.ManyMultiBlobTx(
+		t,
+		encConf.TxConfig,
+		kr,
+		testutil.ChainID,
+		accounts,
+		infos,
+		blobfactory.NestedBlobs(
+			t,
+			testfactory.RandomBlobNamespaces(tmrand.NewRand(), 3),
+			[][]int{{100}, {2000}, {5000}},
+		),
+	)
+
+	resp := testApp.PrepareProposal(abci.RequestPrepareProposal{
+		BlockData: &tmproto.Data{
+			Txs: [][]byte{blobTxs[2], blobTxs[0], blobTxs[1]},
+		},
+		ChainId: testutil.ChainID,
+		Height:  testApp.LastBlockHeight() + 1,
+		Time:    time.Now(),
+	})
+	require.Equal(t, blobTxs, resp.BlockData.Txs)
+}
+
 func queryAccountInfo(capp *app.App, accs []string, kr keyring.Keyring) []blobfactory.AccountInfo {
 	infos := make([]blobfactory.AccountInfo, len(accs))
 	for i, acc := range accs {
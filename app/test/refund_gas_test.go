@@ -0,0 +1,104 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/app/encoding"
+	"github.com/celestiaorg/celestia-app/v2/app/posthandler"
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	"github.com/celestiaorg/go-square/blob"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// TestRefundGasIsDeterministic verifies that delivering the same PFB with an
+// over-estimated gas limit on two validators refunds the same amount of fee
+// and results in the same app hash.
+func TestRefundGasIsDeterministic(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr, pubKeys := deterministicKeyRing(enc.Codec)
+	author := "account-0"
+	fraction := sdk.NewDecWithPrec(5, 1)
+	maxRefund := uint64(1_000_000)
+
+	blobSizes := []uint32{100, 100, 100}
+	blobs := make([]*blob.Blob, len(blobSizes))
+	for i, size := range blobSizes {
+		blobs[i] = blob.New(fixedNamespace(), make([]byte, size), appconsts.DefaultShareVersion)
+	}
+	gasLimit := blobtypes.DefaultEstimateGas(blobSizes) * 2
+	fee := sdk.NewCoins(sdk.NewCoin(app.BondDenom, sdk.NewIntFromUint64(gasLimit)))
+
+	var (
+		rawTx     []byte
+		gasUsed   []int64
+		appHashes [][]byte
+		events    [][]abci.Event
+		balances  []sdk.Coin
+	)
+	for i := 0; i < 2; i++ {
+		testApp := testutil.NewTestApp()
+		_, _, err := testutil.SetupDeterministicGenesisState(testApp, pubKeys, 1_000_000_000, app.DefaultConsensusParams())
+		require.NoError(t, err)
+
+		ctx := testApp.NewContext(false, tmproto.Header{})
+		params := testApp.BlobKeeper.GetParams(ctx)
+		params.GasRefundFraction = fraction
+		params.MaxGasRefund = maxRefund
+		testApp.BlobKeeper.SetParams(ctx, params)
+		balanceBefore := testApp.BankKeeper.GetBalance(ctx, testfactory.GetAddress(kr, author), app.BondDenom)
+
+		// sign the tx once so that both validators deliver the same bytes
+		if rawTx == nil {
+			accountInfo := queryAccountInfo(testApp, []string{author}, kr)[0]
+			signer, err := user.NewSigner(kr, enc.TxConfig, testutil.ChainID, appconsts.LatestVersion, user.NewAccount(author, accountInfo.AccountNum, accountInfo.Sequence))
+			require.NoError(t, err)
+			rawBlobTx, _, err := signer.CreatePayForBlobs(author, blobs, user.SetGasLimit(gasLimit), user.SetFeeAmount(fee))
+			require.NoError(t, err)
+			blobTx, ok := blob.UnmarshalBlobTx(rawBlobTx)
+			require.True(t, ok)
+			rawTx = blobTx.Tx
+		}
+
+		resp := testApp.DeliverTx(abci.RequestDeliverTx{Tx: rawTx})
+		require.EqualValues(t, 0, resp.Code, resp.Log)
+		testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+		testApp.Commit()
+
+		refund := posthandler.GasRefund(fee, gasLimit, uint64(resp.GasUsed), fraction, maxRefund)
+		require.True(t, refund.IsAllPositive())
+
+		queryCtx := testApp.NewContext(true, tmproto.Header{})
+		balanceAfter := testApp.BankKeeper.GetBalance(queryCtx, testfactory.GetAddress(kr, author), app.BondDenom)
+		assert.Equal(t, balanceBefore.Sub(fee[0]).Add(refund[0]), balanceAfter)
+
+		gasUsed = append(gasUsed, resp.GasUsed)
+		appHashes = append(appHashes, testApp.LastCommitID().Hash)
+		events = append(events, refundGasEvents(resp.Events))
+		balances = append(balances, balanceAfter)
+	}
+
+	assert.Equal(t, gasUsed[0], gasUsed[1])
+	assert.Equal(t, appHashes[0], appHashes[1])
+	assert.Equal(t, balances[0], balances[1])
+	require.Len(t, events[0], 1)
+	assert.Equal(t, events[0], events[1])
+}
+
+func refundGasEvents(events []abci.Event) []abci.Event {
+	var refundEvents []abci.Event
+	for _, event := range events {
+		if event.Type == blobtypes.EventTypeRefundGas {
+			refundEvents = append(refundEvents, event)
+		}
+	}
+	return refundEvents
+}
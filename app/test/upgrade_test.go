@@ -14,6 +14,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v2/test/util"
 	blobstreamtypes "github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
 	"github.com/celestiaorg/celestia-app/v2/x/minfee"
+	minttypes "github.com/celestiaorg/celestia-app/v2/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
@@ -45,6 +46,12 @@ func TestAppUpgrades(t *testing.T) {
 			key:           string(minfee.KeyNetworkMinGasPrice),
 			expectedValue: NetworkMinGasPriceDec.String(),
 		},
+		{
+			module:        "Mint",
+			subspace:      minttypes.ModuleName,
+			key:           string(minttypes.KeyDisinflationRate),
+			expectedValue: minttypes.DefaultDisinflationRate.String(),
+		},
 		{
 			module:        "ICA",
 			subspace:      icahosttypes.SubModuleName,
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -8,6 +9,7 @@ import (
 	bscmd "github.com/celestiaorg/celestia-app/v2/x/blobstream/client"
 
 	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/app/ante"
 	"github.com/celestiaorg/celestia-app/v2/app/encoding"
 	"github.com/cosmos/cosmos-sdk/simapp/simd/cmd"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
@@ -22,7 +24,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/snapshot"
 	"github.com/cosmos/cosmos-sdk/server"
-	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
@@ -84,8 +85,8 @@ func NewRootCmd() *cobra.Command {
 			// Override the default tendermint config and app config for celestia-app
 			var (
 				tmCfg       = app.DefaultConsensusConfig()
-				appConfig   = app.DefaultAppConfig()
-				appTemplate = serverconfig.DefaultConfigTemplate
+				appConfig   = app.DefaultCustomAppConfig()
+				appTemplate = app.CustomAppConfigTemplate
 			)
 
 			err = server.InterceptConfigsPreRunHandler(cmd, appTemplate, appConfig, tmCfg)
@@ -152,6 +153,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig encoding.Config) {
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 	startCmd.Flags().Int64(UpgradeHeightFlag, 0, "Upgrade height to switch from v1 to v2. Must be coordinated amongst all validators")
+	startCmd.Flags().String(ante.FlagTxPriority, string(ante.DefaultTxPriorityMode), fmt.Sprintf("How txs are prioritized in the mempool and in proposals: %q or %q", ante.TxPriorityGasPrice, ante.TxPriorityFeePerShare))
 }
 
 func queryCommand() *cobra.Command {
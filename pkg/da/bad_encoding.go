@@ -0,0 +1,236 @@
+package da
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/wrapper"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// ErrNoBadEncoding is returned by NewBadEncodingProof if every row and column
+// of the extended data square is correctly erasure coded.
+var ErrNoBadEncoding = errors.New("extended data square is correctly erasure coded")
+
+// BadEncodingProof proves that the axis (row or column) at Index of an extended
+// data square committed to by a DataAvailabilityHeader was erasure coded
+// incorrectly. It contains every share of that axis along with an NMT
+// inclusion proof of each share against the orthogonal axis root. For example,
+// the share at position j of row i is proven against ColumnRoots[j].
+type BadEncodingProof struct {
+	// Axis is the type of the axis that was incorrectly erasure coded.
+	Axis rsmt2d.Axis
+	// Index is the index of the axis that was incorrectly erasure coded.
+	Index uint
+	// Shares are the shares of the incorrectly erasure coded axis.
+	Shares [][]byte
+	// Proofs are the NMT inclusion proofs of Shares against the orthogonal
+	// axis roots. Proofs[j] proves Shares[j].
+	Proofs []nmt.Proof
+}
+
+// NewBadEncodingProof searches the provided extended data square for a row or
+// column whose parity shares do not match the Reed-Solomon encoding of its
+// original shares and returns a proof for the first one it finds. Rows are
+// searched before columns. It returns ErrNoBadEncoding if the square is
+// correctly erasure coded. The roots in dah must have been computed over eds.
+func NewBadEncodingProof(eds *rsmt2d.ExtendedDataSquare, dah *DataAvailabilityHeader) (*BadEncodingProof, error) {
+	if eds == nil {
+		return nil, errors.New("nil extended data square")
+	}
+	if err := dah.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	width := eds.Width()
+	if int(width) != len(dah.RowRoots) {
+		return nil, fmt.Errorf("extended data square width %d does not match the number of row roots %d", width, len(dah.RowRoots))
+	}
+	if err := verifyRoots(eds, dah); err != nil {
+		return nil, err
+	}
+
+	codec := appconsts.DefaultCodec()
+	for _, axis := range []rsmt2d.Axis{rsmt2d.Row, rsmt2d.Col} {
+		for i := uint(0); i < width; i++ {
+			shares := getAxis(eds, axis, i)
+			ok, err := isCorrectlyEncoded(codec, shares)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				continue
+			}
+			return newBadEncodingProof(eds, axis, i)
+		}
+	}
+	return nil, ErrNoBadEncoding
+}
+
+// Validate returns nil if the proof shows that an axis committed to by dah was
+// incorrectly erasure coded. Otherwise, it returns an error describing why the
+// proof is invalid.
+func (p *BadEncodingProof) Validate(dah *DataAvailabilityHeader) error {
+	if p == nil {
+		return errors.New("nil bad encoding proof")
+	}
+	if err := dah.ValidateBasic(); err != nil {
+		return err
+	}
+	width := uint(len(dah.RowRoots))
+	if p.Index >= width {
+		return fmt.Errorf("axis index %d is out of range for extended square width %d", p.Index, width)
+	}
+	if uint(len(p.Shares)) != width {
+		return fmt.Errorf("expected %d shares, got %d", width, len(p.Shares))
+	}
+	if len(p.Proofs) != len(p.Shares) {
+		return fmt.Errorf("the number of proofs %d must equal the number of shares %d", len(p.Proofs), len(p.Shares))
+	}
+
+	var axisRoots, orthogonalRoots [][]byte
+	switch p.Axis {
+	case rsmt2d.Row:
+		axisRoots, orthogonalRoots = dah.RowRoots, dah.ColumnRoots
+	case rsmt2d.Col:
+		axisRoots, orthogonalRoots = dah.ColumnRoots, dah.RowRoots
+	default:
+		return fmt.Errorf("invalid axis %d", p.Axis)
+	}
+
+	squareSize := width / 2
+	for j, share := range p.Shares {
+		if len(share) != appconsts.ShareSize {
+			return fmt.Errorf("share %d has size %d, expected %d", j, len(share), appconsts.ShareSize)
+		}
+		proof := p.Proofs[j]
+		if proof.Start() != int(p.Index) || proof.End() != int(p.Index)+1 {
+			return fmt.Errorf("proof %d covers range [%d, %d) instead of leaf %d", j, proof.Start(), proof.End(), p.Index)
+		}
+		namespace := shareNamespace(share, p.Index, uint(j), squareSize)
+		if !proof.VerifyInclusion(appconsts.NewBaseHashFunc(), namespace, [][]byte{share}, orthogonalRoots[j]) {
+			return fmt.Errorf("share %d failed to verify against its orthogonal root", j)
+		}
+	}
+
+	// Rebuild the axis from its original shares and check whether the result
+	// is committed to by the data availability header.
+	original := make([][]byte, width)
+	copy(original, p.Shares[:squareSize])
+	rebuilt, err := appconsts.DefaultCodec().Decode(original)
+	if err != nil {
+		return err
+	}
+	root, err := computeAxisRoot(rebuilt, uint64(squareSize), p.Index)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(root, axisRoots[p.Index]) {
+		return fmt.Errorf("%s %d is correctly erasure coded", p.Axis, p.Index)
+	}
+	return nil
+}
+
+// newBadEncodingProof returns a proof for the axis at index i of eds.
+func newBadEncodingProof(eds *rsmt2d.ExtendedDataSquare, axis rsmt2d.Axis, i uint) (*BadEncodingProof, error) {
+	width := eds.Width()
+	squareSize := uint64(width / 2)
+	orthogonal := rsmt2d.Col
+	if axis == rsmt2d.Col {
+		orthogonal = rsmt2d.Row
+	}
+
+	shares := getAxis(eds, axis, i)
+	proofs := make([]nmt.Proof, width)
+	for j := uint(0); j < width; j++ {
+		// we have to re-create the tree as the eds one is not accessible.
+		tree := wrapper.NewErasuredNamespacedMerkleTree(squareSize, j)
+		for _, share := range getAxis(eds, orthogonal, j) {
+			if err := tree.Push(share); err != nil {
+				return nil, err
+			}
+		}
+		proof, err := tree.ProveRange(int(i), int(i)+1)
+		if err != nil {
+			return nil, err
+		}
+		proofs[j] = proof
+	}
+
+	return &BadEncodingProof{
+		Axis:   axis,
+		Index:  i,
+		Shares: shares,
+		Proofs: proofs,
+	}, nil
+}
+
+// verifyRoots returns an error if the roots of eds differ from those in dah.
+func verifyRoots(eds *rsmt2d.ExtendedDataSquare, dah *DataAvailabilityHeader) error {
+	rowRoots, err := eds.RowRoots()
+	if err != nil {
+		return err
+	}
+	colRoots, err := eds.ColRoots()
+	if err != nil {
+		return err
+	}
+	for i := range rowRoots {
+		if !bytes.Equal(rowRoots[i], dah.RowRoots[i]) {
+			return fmt.Errorf("row root %d of the extended data square does not match the data availability header", i)
+		}
+		if !bytes.Equal(colRoots[i], dah.ColumnRoots[i]) {
+			return fmt.Errorf("column root %d of the extended data square does not match the data availability header", i)
+		}
+	}
+	return nil
+}
+
+// isCorrectlyEncoded returns true if the second half of shares is the
+// Reed-Solomon encoding of the first half.
+func isCorrectlyEncoded(codec rsmt2d.Codec, shares [][]byte) (bool, error) {
+	half := len(shares) / 2
+	parity, err := codec.Encode(shares[:half])
+	if err != nil {
+		return false, err
+	}
+	for i, share := range parity {
+		if !bytes.Equal(share, shares[half+i]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// computeAxisRoot returns the NMT root of shares when they are pushed to an
+// ErasuredNamespacedMerkleTree for the axis at axisIndex.
+func computeAxisRoot(shares [][]byte, squareSize uint64, axisIndex uint) ([]byte, error) {
+	tree := wrapper.NewStreamingErasuredNamespacedMerkleTree(squareSize, axisIndex)
+	for _, share := range shares {
+		if err := tree.Push(share); err != nil {
+			return nil, err
+		}
+	}
+	return tree.Root()
+}
+
+// shareNamespace returns the namespace that the ErasuredNamespacedMerkleTree
+// assigns to the share at (axisIndex, shareIndex). Shares outside of the
+// original data square use the parity shares namespace.
+func shareNamespace(share []byte, axisIndex, shareIndex, squareSize uint) []byte {
+	if axisIndex < squareSize && shareIndex < squareSize {
+		return share[:appconsts.NamespaceSize]
+	}
+	return appns.ParitySharesNamespace.Bytes()
+}
+
+// getAxis returns the shares of the row or column at index i of eds.
+func getAxis(eds *rsmt2d.ExtendedDataSquare, axis rsmt2d.Axis, i uint) [][]byte {
+	if axis == rsmt2d.Row {
+		return eds.Row(i)
+	}
+	return eds.Col(i)
+}
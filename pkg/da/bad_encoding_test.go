@@ -0,0 +1,180 @@
+package da
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/wrapper"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBadEncodingProof(t *testing.T) {
+	squareSize := 4
+
+	t.Run("returns ErrNoBadEncoding for a correctly encoded square", func(t *testing.T) {
+		eds, err := ExtendShares(generateShares(squareSize * squareSize))
+		require.NoError(t, err)
+		dah, err := NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+
+		_, err = NewBadEncodingProof(eds, &dah)
+		assert.ErrorIs(t, err, ErrNoBadEncoding)
+	})
+
+	t.Run("returns an error if the roots do not match the square", func(t *testing.T) {
+		eds, dah := badlyEncodedSquare(t, squareSize, 1, 5)
+		other, err := ExtendShares(generateShares(squareSize * squareSize))
+		require.NoError(t, err)
+		_, err = NewBadEncodingProof(other, &dah)
+		assert.Error(t, err)
+		_, err = NewBadEncodingProof(eds, &dah)
+		assert.NoError(t, err)
+	})
+
+	type testCase struct {
+		name      string
+		row       uint
+		col       uint
+		wantAxis  rsmt2d.Axis
+		wantIndex uint
+	}
+	testCases := []testCase{
+		{
+			name:      "corrupt share in the first quadrant",
+			row:       0,
+			col:       0,
+			wantAxis:  rsmt2d.Row,
+			wantIndex: 0,
+		},
+		{
+			name:      "corrupt share in the second quadrant",
+			row:       1,
+			col:       5,
+			wantAxis:  rsmt2d.Row,
+			wantIndex: 1,
+		},
+		{
+			name:      "corrupt share in the fourth quadrant",
+			row:       6,
+			col:       7,
+			wantAxis:  rsmt2d.Row,
+			wantIndex: 6,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			eds, dah := badlyEncodedSquare(t, squareSize, tc.row, tc.col)
+
+			proof, err := NewBadEncodingProof(eds, &dah)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantAxis, proof.Axis)
+			assert.Equal(t, tc.wantIndex, proof.Index)
+			assert.NoError(t, proof.Validate(&dah))
+		})
+	}
+}
+
+func TestBadEncodingProofValidate(t *testing.T) {
+	squareSize := 4
+	eds, dah := badlyEncodedSquare(t, squareSize, 2, 6)
+
+	honestEds, err := ExtendShares(generateShares(squareSize * squareSize))
+	require.NoError(t, err)
+	honestDah, err := NewDataAvailabilityHeader(honestEds)
+	require.NoError(t, err)
+
+	type testCase struct {
+		name    string
+		mutate  func(p *BadEncodingProof)
+		dah     DataAvailabilityHeader
+		wantErr bool
+	}
+	testCases := []testCase{
+		{
+			name:   "valid proof",
+			mutate: func(_ *BadEncodingProof) {},
+			dah:    dah,
+		},
+		{
+			name:    "proof against a different data availability header",
+			mutate:  func(_ *BadEncodingProof) {},
+			dah:     honestDah,
+			wantErr: true,
+		},
+		{
+			name: "tampered share",
+			mutate: func(p *BadEncodingProof) {
+				share := make([]byte, appconsts.ShareSize)
+				copy(share, p.Shares[1])
+				share[appconsts.ShareSize-1] ^= 0xFF
+				p.Shares[1] = share
+			},
+			dah:     dah,
+			wantErr: true,
+		},
+		{
+			name: "missing share",
+			mutate: func(p *BadEncodingProof) {
+				p.Shares = p.Shares[1:]
+			},
+			dah:     dah,
+			wantErr: true,
+		},
+		{
+			name: "index out of range",
+			mutate: func(p *BadEncodingProof) {
+				p.Index = uint(len(dah.RowRoots))
+			},
+			dah:     dah,
+			wantErr: true,
+		},
+		{
+			name: "correctly encoded axis",
+			mutate: func(p *BadEncodingProof) {
+				honest, err := newBadEncodingProof(eds, rsmt2d.Row, 0)
+				require.NoError(t, err)
+				*p = *honest
+			},
+			dah:     dah,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			proof, err := NewBadEncodingProof(eds, &dah)
+			require.NoError(t, err)
+			tc.mutate(proof)
+			err = proof.Validate(&tc.dah)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// badlyEncodedSquare returns an extended data square where the share at (row,
+// col) has been corrupted after erasure coding along with the data
+// availability header that commits to the corrupted square.
+func badlyEncodedSquare(t *testing.T, squareSize int, row, col uint) (*rsmt2d.ExtendedDataSquare, DataAvailabilityHeader) {
+	eds, err := ExtendShares(generateShares(squareSize * squareSize))
+	require.NoError(t, err)
+
+	flattened := eds.Flattened()
+	index := row*eds.Width() + col
+	corrupted := make([]byte, appconsts.ShareSize)
+	copy(corrupted, flattened[index])
+	// leave the namespace untouched so that shares in the original data square
+	// remain ordered by namespace.
+	corrupted[appconsts.ShareSize-1] ^= 0xFF
+	flattened[index] = corrupted
+
+	badEds, err := rsmt2d.ImportExtendedDataSquare(flattened, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(squareSize)))
+	require.NoError(t, err)
+	dah, err := NewDataAvailabilityHeader(badEds)
+	require.NoError(t, err)
+	return badEds, dah
+}
@@ -0,0 +1,274 @@
+package da
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/wrapper"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// EDSFileVersion is the version of the on-disk format written by WriteEDS.
+const EDSFileVersion uint8 = 1
+
+// edsFileMagic identifies a file written by WriteEDS.
+var edsFileMagic = [4]byte{'C', 'E', 'D', 'S'}
+
+// edsFileHeaderSize is the size of the fixed part of the header: magic,
+// version, square size, share size and root size.
+const edsFileHeaderSize = 4 + 1 + 4 + 4 + 4
+
+// WriteEDS writes eds and the data availability header that commits to it to
+// w in the following format. All integers are big endian.
+//
+//	| magic "CEDS" (4 bytes) | version (1 byte) | square size (4 bytes) |
+//	| share size (4 bytes) | root size (4 bytes) |
+//	| row roots (2 * square size * root size) |
+//	| column roots (2 * square size * root size) |
+//	| Q0 shares | Q1 shares | Q2 shares | Q3 shares |
+//
+// Square size is the width of the original data square. The shares of each
+// quadrant are stored in row-major order. Since shares and roots have a fixed
+// size, the offset of any share can be computed from its coordinates so that
+// an EDSFileReader can look it up in O(1).
+func WriteEDS(w io.Writer, eds *rsmt2d.ExtendedDataSquare, dah *DataAvailabilityHeader) error {
+	if eds == nil {
+		return errors.New("nil extended data square")
+	}
+	if err := dah.ValidateBasic(); err != nil {
+		return err
+	}
+	width := eds.Width()
+	if int(width) != len(dah.RowRoots) {
+		return fmt.Errorf("extended data square width %d does not match the number of row roots %d", width, len(dah.RowRoots))
+	}
+	rootSize := len(dah.RowRoots[0])
+	for _, roots := range [][][]byte{dah.RowRoots, dah.ColumnRoots} {
+		for _, root := range roots {
+			if len(root) != rootSize {
+				return fmt.Errorf("roots must have the same size: got %d and %d", rootSize, len(root))
+			}
+		}
+	}
+
+	header := make([]byte, edsFileHeaderSize)
+	copy(header, edsFileMagic[:])
+	header[4] = EDSFileVersion
+	binary.BigEndian.PutUint32(header[5:], uint32(width/2))
+	binary.BigEndian.PutUint32(header[9:], appconsts.ShareSize)
+	binary.BigEndian.PutUint32(header[13:], uint32(rootSize))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	for _, roots := range [][][]byte{dah.RowRoots, dah.ColumnRoots} {
+		for _, root := range roots {
+			if _, err := w.Write(root); err != nil {
+				return err
+			}
+		}
+	}
+
+	squareSize := width / 2
+	for _, q := range [4][2]uint{{0, 0}, {0, squareSize}, {squareSize, 0}, {squareSize, squareSize}} {
+		for row := q[0]; row < q[0]+squareSize; row++ {
+			for col := q[1]; col < q[1]+squareSize; col++ {
+				share := eds.GetCell(row, col)
+				if len(share) != appconsts.ShareSize {
+					return fmt.Errorf("share (%d, %d) has size %d, expected %d", row, col, len(share), appconsts.ShareSize)
+				}
+				if _, err := w.Write(share); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// EDSFileReader reads shares from an extended data square written by
+// WriteEDS.
+type EDSFileReader struct {
+	r          io.ReaderAt
+	squareSize uint
+	shareSize  int
+	dah        DataAvailabilityHeader
+	// sharesOffset is the offset of the first share of Q0.
+	sharesOffset int64
+}
+
+// NewEDSFileReader reads the header of a file written by WriteEDS from r and
+// returns a reader for its shares.
+func NewEDSFileReader(r io.ReaderAt) (*EDSFileReader, error) {
+	header := make([]byte, edsFileHeaderSize)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+	if !bytes.Equal(header[:4], edsFileMagic[:]) {
+		return nil, errors.New("not an extended data square file")
+	}
+	if header[4] != EDSFileVersion {
+		return nil, fmt.Errorf("unsupported extended data square file version %d", header[4])
+	}
+	squareSize := binary.BigEndian.Uint32(header[5:])
+	shareSize := binary.BigEndian.Uint32(header[9:])
+	rootSize := binary.BigEndian.Uint32(header[13:])
+	if shareSize != appconsts.ShareSize {
+		return nil, fmt.Errorf("share size %d is not supported, expected %d", shareSize, appconsts.ShareSize)
+	}
+	if squareSize == 0 || squareSize > uint32(maxExtendedSquareWidth/2) {
+		return nil, fmt.Errorf("invalid square size %d", squareSize)
+	}
+	if rootSize != uint32(appconsts.NamespaceSize*2+appconsts.HashLength()) {
+		return nil, fmt.Errorf("invalid root size %d", rootSize)
+	}
+
+	width := 2 * int(squareSize)
+	roots := make([]byte, 2*width*int(rootSize))
+	if _, err := r.ReadAt(roots, edsFileHeaderSize); err != nil {
+		return nil, fmt.Errorf("reading roots: %w", err)
+	}
+	dah := DataAvailabilityHeader{
+		RowRoots:    make([][]byte, width),
+		ColumnRoots: make([][]byte, width),
+	}
+	for i := 0; i < width; i++ {
+		dah.RowRoots[i] = roots[i*int(rootSize) : (i+1)*int(rootSize)]
+		dah.ColumnRoots[i] = roots[(width+i)*int(rootSize) : (width+i+1)*int(rootSize)]
+	}
+	if err := dah.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &EDSFileReader{
+		r:            r,
+		squareSize:   uint(squareSize),
+		shareSize:    int(shareSize),
+		dah:          dah,
+		sharesOffset: int64(edsFileHeaderSize + len(roots)),
+	}, nil
+}
+
+// DataAvailabilityHeader returns the data availability header stored in the
+// file.
+func (f *EDSFileReader) DataAvailabilityHeader() DataAvailabilityHeader {
+	return f.dah
+}
+
+// SquareSize returns the width of the original data square.
+func (f *EDSFileReader) SquareSize() int {
+	return int(f.squareSize)
+}
+
+// Share returns the share at (row, col) of the extended data square.
+func (f *EDSFileReader) Share(row, col uint) ([]byte, error) {
+	offset, err := f.shareOffset(row, col)
+	if err != nil {
+		return nil, err
+	}
+	share := make([]byte, f.shareSize)
+	if _, err := f.r.ReadAt(share, offset); err != nil {
+		return nil, fmt.Errorf("reading share (%d, %d): %w", row, col, err)
+	}
+	return share, nil
+}
+
+// Row returns the shares of the row at index row of the extended data square.
+func (f *EDSFileReader) Row(row uint) ([][]byte, error) {
+	return f.axis(func(i uint) ([]byte, error) { return f.Share(row, i) })
+}
+
+// Col returns the shares of the column at index col of the extended data
+// square.
+func (f *EDSFileReader) Col(col uint) ([][]byte, error) {
+	return f.axis(func(i uint) ([]byte, error) { return f.Share(i, col) })
+}
+
+// GetShare returns the share at (row, col) of the extended data square and an
+// NMT proof of the share against its row root.
+func (f *EDSFileReader) GetShare(ctx context.Context, row, col uint) ([]byte, nmt.Proof, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nmt.Proof{}, err
+	}
+	shares, err := f.Row(row)
+	if err != nil {
+		return nil, nmt.Proof{}, err
+	}
+	if col >= uint(len(shares)) {
+		return nil, nmt.Proof{}, fmt.Errorf("column %d is out of range for extended square width %d", col, len(shares))
+	}
+	// we have to re-create the tree as the file only contains the shares.
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(f.squareSize), row)
+	for _, share := range shares {
+		if err := tree.Push(share); err != nil {
+			return nil, nmt.Proof{}, err
+		}
+	}
+	proof, err := tree.ProveRange(int(col), int(col)+1)
+	if err != nil {
+		return nil, nmt.Proof{}, err
+	}
+	return shares[col], proof, nil
+}
+
+// Verify recomputes the row and column roots from the shares in the file and
+// returns an error if they differ from the data availability header stored in
+// the file. Only one axis is held in memory at a time.
+func (f *EDSFileReader) Verify() error {
+	width := 2 * f.squareSize
+	for i := uint(0); i < width; i++ {
+		row, err := f.Row(i)
+		if err != nil {
+			return err
+		}
+		root, err := computeAxisRoot(row, uint64(f.squareSize), i)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(root, f.dah.RowRoots[i]) {
+			return fmt.Errorf("row root %d does not match the data availability header", i)
+		}
+
+		col, err := f.Col(i)
+		if err != nil {
+			return err
+		}
+		root, err = computeAxisRoot(col, uint64(f.squareSize), i)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(root, f.dah.ColumnRoots[i]) {
+			return fmt.Errorf("column root %d does not match the data availability header", i)
+		}
+	}
+	return nil
+}
+
+// shareOffset returns the offset of the share at (row, col) in the file.
+func (f *EDSFileReader) shareOffset(row, col uint) (int64, error) {
+	width := 2 * f.squareSize
+	if row >= width || col >= width {
+		return 0, fmt.Errorf("coordinate (%d, %d) is out of range for extended square width %d", row, col, width)
+	}
+	quadrant := (row/f.squareSize)*2 + col/f.squareSize
+	index := quadrant*f.squareSize*f.squareSize + (row%f.squareSize)*f.squareSize + col%f.squareSize
+	return f.sharesOffset + int64(index)*int64(f.shareSize), nil
+}
+
+// axis returns the 2*squareSize shares returned by get.
+func (f *EDSFileReader) axis(get func(i uint) ([]byte, error)) ([][]byte, error) {
+	shares := make([][]byte, 2*f.squareSize)
+	for i := range shares {
+		share, err := get(uint(i))
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = share
+	}
+	return shares, nil
+}
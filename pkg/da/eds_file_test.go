@@ -0,0 +1,140 @@
+package da
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEDSFileRoundTrip(t *testing.T) {
+	squareSize := 8
+	eds, dah := randomEDS(t, squareSize)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteEDS(&buf, eds, &dah))
+
+	reader, err := NewEDSFileReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, squareSize, reader.SquareSize())
+	got := reader.DataAvailabilityHeader()
+	assert.Equal(t, dah.Hash(), got.Hash())
+	assert.NoError(t, reader.Verify())
+
+	width := eds.Width()
+	for row := uint(0); row < width; row++ {
+		for col := uint(0); col < width; col++ {
+			share, err := reader.Share(row, col)
+			require.NoError(t, err)
+			assert.Equal(t, eds.GetCell(row, col), share)
+		}
+		shares, err := reader.Row(row)
+		require.NoError(t, err)
+		assert.Equal(t, eds.Row(row), shares)
+		shares, err = reader.Col(row)
+		require.NoError(t, err)
+		assert.Equal(t, eds.Col(row), shares)
+	}
+
+	_, err = reader.Share(width, 0)
+	assert.Error(t, err)
+}
+
+func TestWriteEDSDoesNotModifyRoots(t *testing.T) {
+	eds, dah := randomEDS(t, 4)
+
+	// give the row roots enough spare capacity to hold the column roots
+	n := len(dah.RowRoots)
+	rowRoots := make([][]byte, n, n+len(dah.ColumnRoots))
+	copy(rowRoots, dah.RowRoots)
+	spare := rowRoots[n:cap(rowRoots)]
+	dah.RowRoots = rowRoots
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteEDS(&buf, eds, &dah))
+	for _, root := range spare {
+		assert.Nil(t, root)
+	}
+}
+
+func TestEDSFileReaderGetShare(t *testing.T) {
+	squareSize := 4
+	eds, dah := randomEDS(t, squareSize)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteEDS(&buf, eds, &dah))
+	reader, err := NewEDSFileReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	width := eds.Width()
+	for row := uint(0); row < width; row++ {
+		for col := uint(0); col < width; col++ {
+			share, proof, err := reader.GetShare(context.Background(), row, col)
+			require.NoError(t, err)
+			namespace := shareNamespace(share, row, col, uint(squareSize))
+			assert.True(t, proof.VerifyInclusion(appconsts.NewBaseHashFunc(), namespace, [][]byte{share}, dah.RowRoots[row]))
+		}
+	}
+}
+
+func TestEDSFileReaderErrors(t *testing.T) {
+	squareSize := 4
+	eds, dah := randomEDS(t, squareSize)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteEDS(&buf, eds, &dah))
+	file := buf.Bytes()
+
+	t.Run("bad magic", func(t *testing.T) {
+		corrupted := bytes.Clone(file)
+		corrupted[0] = 'X'
+		_, err := NewEDSFileReader(bytes.NewReader(corrupted))
+		assert.Error(t, err)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		corrupted := bytes.Clone(file)
+		corrupted[4] = EDSFileVersion + 1
+		_, err := NewEDSFileReader(bytes.NewReader(corrupted))
+		assert.Error(t, err)
+	})
+
+	t.Run("truncated header", func(t *testing.T) {
+		_, err := NewEDSFileReader(bytes.NewReader(file[:edsFileHeaderSize+10]))
+		assert.Error(t, err)
+	})
+
+	t.Run("truncated shares", func(t *testing.T) {
+		reader, err := NewEDSFileReader(bytes.NewReader(file[:len(file)-1]))
+		require.NoError(t, err)
+		assert.Error(t, reader.Verify())
+	})
+
+	t.Run("corrupt share", func(t *testing.T) {
+		corrupted := bytes.Clone(file)
+		corrupted[len(corrupted)-1] ^= 0xFF
+		reader, err := NewEDSFileReader(bytes.NewReader(corrupted))
+		require.NoError(t, err)
+		assert.Error(t, reader.Verify())
+	})
+
+	t.Run("mismatched data availability header", func(t *testing.T) {
+		_, otherDah := randomEDS(t, 2*squareSize)
+		assert.Error(t, WriteEDS(&bytes.Buffer{}, eds, &otherDah))
+	})
+}
+
+// randomEDS returns the extended data square of squareSize*squareSize random
+// shares along with its data availability header.
+func randomEDS(t *testing.T, squareSize int) (*rsmt2d.ExtendedDataSquare, DataAvailabilityHeader) {
+	eds, err := ExtendShares(testfactory.GenerateRandNamespacedRawData(squareSize * squareSize))
+	require.NoError(t, err)
+	dah, err := NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	return eds, dah
+}
@@ -0,0 +1,122 @@
+package sampling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	"github.com/celestiaorg/celestia-app/v2/pkg/wrapper"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// ErrShareUnavailable is returned by a ShareGetter when it is unable or
+// unwilling to serve the requested share.
+var ErrShareUnavailable = errors.New("share unavailable")
+
+// ShareGetter serves shares of an extended data square along with an NMT
+// inclusion proof of the share against its row root.
+type ShareGetter interface {
+	// GetShare returns the share at (row, col) of the extended data square and
+	// a proof of the share against the row root at row.
+	GetShare(ctx context.Context, row, col uint) (share []byte, proof nmt.Proof, err error)
+}
+
+var (
+	_ ShareGetter = &EDSGetter{}
+	_ ShareGetter = &WithholdingGetter{}
+	_ ShareGetter = &da.EDSFileReader{}
+)
+
+// EDSGetter is a ShareGetter that serves shares from an extended data square
+// held in memory.
+type EDSGetter struct {
+	eds *rsmt2d.ExtendedDataSquare
+}
+
+// NewEDSGetter returns a ShareGetter backed by eds.
+func NewEDSGetter(eds *rsmt2d.ExtendedDataSquare) *EDSGetter {
+	return &EDSGetter{eds: eds}
+}
+
+// GetShare implements ShareGetter.
+func (g *EDSGetter) GetShare(ctx context.Context, row, col uint) ([]byte, nmt.Proof, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nmt.Proof{}, err
+	}
+	width := g.eds.Width()
+	if row >= width || col >= width {
+		return nil, nmt.Proof{}, fmt.Errorf("coordinate (%d, %d) is out of range for extended square width %d", row, col, width)
+	}
+
+	// we have to re-create the tree as the eds one is not accessible.
+	tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(width/2), row)
+	for _, share := range g.eds.Row(row) {
+		if err := tree.Push(share); err != nil {
+			return nil, nmt.Proof{}, err
+		}
+	}
+	proof, err := tree.ProveRange(int(col), int(col)+1)
+	if err != nil {
+		return nil, nmt.Proof{}, err
+	}
+	return g.eds.GetCell(row, col), proof, nil
+}
+
+// Quadrant identifies one of the four quadrants of an extended data square.
+// Q0 is the original data square, Q1 is to its right, Q2 is below it and Q3 is
+// diagonal to it.
+type Quadrant int
+
+const (
+	Q0 Quadrant = iota
+	Q1
+	Q2
+	Q3
+)
+
+// QuadrantOf returns the quadrant that contains (row, col) in an extended data
+// square of width 2*squareSize.
+func QuadrantOf(row, col, squareSize uint) Quadrant {
+	q := Q0
+	if col >= squareSize {
+		q++
+	}
+	if row >= squareSize {
+		q += 2
+	}
+	return q
+}
+
+// WithholdingGetter is an adversarial ShareGetter that wraps another
+// ShareGetter and refuses to serve any share that belongs to one of the
+// withheld quadrants.
+type WithholdingGetter struct {
+	getter     ShareGetter
+	squareSize uint
+	withheld   map[Quadrant]bool
+}
+
+// NewWithholdingGetter returns a ShareGetter that serves shares from getter
+// unless they belong to one of the withheld quadrants. squareSize is the width
+// of the original data square.
+func NewWithholdingGetter(getter ShareGetter, squareSize uint, withheld ...Quadrant) *WithholdingGetter {
+	w := &WithholdingGetter{
+		getter:     getter,
+		squareSize: squareSize,
+		withheld:   make(map[Quadrant]bool, len(withheld)),
+	}
+	for _, q := range withheld {
+		w.withheld[q] = true
+	}
+	return w
+}
+
+// GetShare implements ShareGetter.
+func (w *WithholdingGetter) GetShare(ctx context.Context, row, col uint) ([]byte, nmt.Proof, error) {
+	if w.withheld[QuadrantOf(row, col, w.squareSize)] {
+		return nil, nmt.Proof{}, ErrShareUnavailable
+	}
+	return w.getter.GetShare(ctx, row, col)
+}
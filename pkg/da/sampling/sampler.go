@@ -0,0 +1,143 @@
+package sampling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	appns "github.com/celestiaorg/go-square/namespace"
+)
+
+// Coordinate is the position of a share in an extended data square.
+type Coordinate struct {
+	Row uint
+	Col uint
+}
+
+// Result summarizes a round of data availability sampling.
+type Result struct {
+	// Samples is the number of coordinates that were requested.
+	Samples int
+	// Verified is the number of shares that were received and verified
+	// against the row roots of the data availability header.
+	Verified int
+	// Unavailable contains the coordinates of the shares that the getter did
+	// not serve.
+	Unavailable []Coordinate
+	// Invalid contains the coordinates of the shares that were served but
+	// failed verification against the row roots.
+	Invalid []Coordinate
+	// Confidence is the probability that the data committed to by the data
+	// availability header can be reconstructed given the samples taken. It is
+	// zero if any sample was unavailable or invalid.
+	Confidence float64
+}
+
+// Available returns true if every sample was served and verified.
+func (r Result) Available() bool {
+	return len(r.Unavailable) == 0 && len(r.Invalid) == 0
+}
+
+// Sampler performs data availability sampling against a ShareGetter.
+type Sampler struct {
+	getter ShareGetter
+	rand   *rand.Rand
+}
+
+// NewSampler returns a Sampler that requests shares from getter and uses rnd
+// to choose which coordinates to sample.
+func NewSampler(getter ShareGetter, rnd *rand.Rand) *Sampler {
+	return &Sampler{getter: getter, rand: rnd}
+}
+
+// Sample requests numSamples distinct random coordinates of the extended data
+// square committed to by dah and verifies each share against the row roots.
+// numSamples is capped at the number of shares in the extended data square.
+// An error is only returned if sampling could not be performed; shares that
+// are withheld or fail verification are reported in the Result.
+func (s *Sampler) Sample(ctx context.Context, dah *da.DataAvailabilityHeader, numSamples int) (Result, error) {
+	if err := dah.ValidateBasic(); err != nil {
+		return Result{}, err
+	}
+	if numSamples <= 0 {
+		return Result{}, fmt.Errorf("number of samples must be positive: got %d", numSamples)
+	}
+
+	width := uint(len(dah.RowRoots))
+	result := Result{}
+	for _, coord := range s.coordinates(width, numSamples) {
+		result.Samples++
+		err := s.sampleShare(ctx, dah, coord)
+		switch {
+		case err == nil:
+			result.Verified++
+		case errors.Is(err, ErrShareUnavailable):
+			result.Unavailable = append(result.Unavailable, coord)
+		case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+			return Result{}, err
+		default:
+			result.Invalid = append(result.Invalid, coord)
+		}
+	}
+
+	if result.Available() {
+		result.Confidence = Confidence(dah.SquareSize(), result.Verified)
+	}
+	return result, nil
+}
+
+// sampleShare requests the share at coord and verifies it against the row
+// root of dah.
+func (s *Sampler) sampleShare(ctx context.Context, dah *da.DataAvailabilityHeader, coord Coordinate) error {
+	share, proof, err := s.getter.GetShare(ctx, coord.Row, coord.Col)
+	if err != nil {
+		return err
+	}
+	if len(share) != appconsts.ShareSize {
+		return fmt.Errorf("share has size %d, expected %d", len(share), appconsts.ShareSize)
+	}
+	if proof.Start() != int(coord.Col) || proof.End() != int(coord.Col)+1 {
+		return fmt.Errorf("proof covers range [%d, %d) instead of leaf %d", proof.Start(), proof.End(), coord.Col)
+	}
+	namespace := appns.ParitySharesNamespace.Bytes()
+	if QuadrantOf(coord.Row, coord.Col, uint(dah.SquareSize())) == Q0 {
+		namespace = share[:appconsts.NamespaceSize]
+	}
+	if !proof.VerifyInclusion(appconsts.NewBaseHashFunc(), namespace, [][]byte{share}, dah.RowRoots[coord.Row]) {
+		return errors.New("share failed to verify against its row root")
+	}
+	return nil
+}
+
+// coordinates returns n distinct random coordinates of an extended data
+// square of the provided width.
+func (s *Sampler) coordinates(width uint, n int) []Coordinate {
+	total := int(width * width)
+	if n > total {
+		n = total
+	}
+	coords := make([]Coordinate, 0, n)
+	for _, i := range s.rand.Perm(total)[:n] {
+		coords = append(coords, Coordinate{Row: uint(i) / width, Col: uint(i) % width})
+	}
+	return coords
+}
+
+// Confidence returns the probability that an original data square of width
+// squareSize is recoverable given that numSamples uniformly random shares of
+// its extended data square were available. An adversary has to withhold at
+// least (squareSize+1)^2 of the (2*squareSize)^2 shares to prevent
+// reconstruction so each available sample rules out withholding with at least
+// that probability.
+func Confidence(squareSize int, numSamples int) float64 {
+	if squareSize <= 0 || numSamples <= 0 {
+		return 0
+	}
+	k := float64(squareSize)
+	minWithheld := (k + 1) * (k + 1) / (4 * k * k)
+	return 1 - math.Pow(1-minWithheld, float64(numSamples))
+}
@@ -0,0 +1,133 @@
+package sampling_test
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da/sampling"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/celestiaorg/nmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const squareSize = 8
+
+func TestSample(t *testing.T) {
+	eds, err := da.ExtendShares(testfactory.GenerateRandNamespacedRawData(squareSize * squareSize))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	edsGetter := sampling.NewEDSGetter(eds)
+
+	var buf bytes.Buffer
+	require.NoError(t, da.WriteEDS(&buf, eds, &dah))
+	fileGetter, err := da.NewEDSFileReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	type testCase struct {
+		name            string
+		getter          sampling.ShareGetter
+		numSamples      int
+		wantAvailable   bool
+		wantUnavailable bool
+		wantInvalid     bool
+	}
+	testCases := []testCase{
+		{
+			name:          "all shares available",
+			getter:        edsGetter,
+			numSamples:    16,
+			wantAvailable: true,
+		},
+		{
+			name:          "shares served from an eds file",
+			getter:        fileGetter,
+			numSamples:    16,
+			wantAvailable: true,
+		},
+		{
+			name:          "more samples than shares",
+			getter:        edsGetter,
+			numSamples:    4 * squareSize * squareSize * 2,
+			wantAvailable: true,
+		},
+		{
+			name:            "three quadrants withheld",
+			getter:          sampling.NewWithholdingGetter(edsGetter, squareSize, sampling.Q1, sampling.Q2, sampling.Q3),
+			numSamples:      4 * squareSize * squareSize,
+			wantUnavailable: true,
+		},
+		{
+			name:        "corrupt shares",
+			getter:      corruptGetter{edsGetter},
+			numSamples:  16,
+			wantInvalid: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sampler := sampling.NewSampler(tc.getter, rand.New(rand.NewSource(1)))
+			result, err := sampler.Sample(context.Background(), &dah, tc.numSamples)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantAvailable, result.Available())
+			assert.Equal(t, tc.wantUnavailable, len(result.Unavailable) > 0)
+			assert.Equal(t, tc.wantInvalid, len(result.Invalid) > 0)
+			assert.Equal(t, result.Samples, result.Verified+len(result.Unavailable)+len(result.Invalid))
+			if tc.wantAvailable {
+				assert.Equal(t, sampling.Confidence(squareSize, result.Verified), result.Confidence)
+				assert.Greater(t, result.Confidence, 0.0)
+			} else {
+				assert.Zero(t, result.Confidence)
+			}
+		})
+	}
+}
+
+func TestSampleWithheldQuadrant(t *testing.T) {
+	eds, err := da.ExtendShares(testfactory.GenerateRandNamespacedRawData(squareSize * squareSize))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	getter := sampling.NewWithholdingGetter(sampling.NewEDSGetter(eds), squareSize, sampling.Q3)
+	sampler := sampling.NewSampler(getter, rand.New(rand.NewSource(1)))
+	result, err := sampler.Sample(context.Background(), &dah, 4*squareSize*squareSize)
+	require.NoError(t, err)
+
+	assert.Len(t, result.Unavailable, squareSize*squareSize)
+	for _, coord := range result.Unavailable {
+		assert.Equal(t, sampling.Q3, sampling.QuadrantOf(coord.Row, coord.Col, squareSize))
+	}
+}
+
+func TestConfidence(t *testing.T) {
+	assert.Zero(t, sampling.Confidence(squareSize, 0))
+	assert.Zero(t, sampling.Confidence(0, 10))
+	// more samples should always increase the confidence.
+	assert.Less(t, sampling.Confidence(squareSize, 10), sampling.Confidence(squareSize, 20))
+	// ~16 samples are enough to reach 99% confidence.
+	assert.Greater(t, sampling.Confidence(appconsts.DefaultSquareSizeUpperBound, 16), 0.99)
+}
+
+// corruptGetter flips the last byte of every share served by the wrapped
+// getter.
+type corruptGetter struct {
+	sampling.ShareGetter
+}
+
+func (c corruptGetter) GetShare(ctx context.Context, row, col uint) ([]byte, nmt.Proof, error) {
+	share, proof, err := c.ShareGetter.GetShare(ctx, row, col)
+	if err != nil {
+		return nil, proof, err
+	}
+	corrupted := make([]byte, len(share))
+	copy(corrupted, share)
+	corrupted[len(corrupted)-1] ^= 0xFF
+	return corrupted, proof, nil
+}
@@ -0,0 +1,145 @@
+package da
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/rsmt2d"
+	"golang.org/x/sync/errgroup"
+)
+
+// NewDataAvailabilityHeaderFromShares computes the DataAvailabilityHeader of
+// the extended data square of s without materializing the extended data
+// square. It returns a header that is byte-identical to the one produced by
+// ExtendShares followed by NewDataAvailabilityHeader.
+//
+// Only the parity shares of the second (Q1) and third (Q2) quadrants are kept
+// in memory. The fourth quadrant (Q3) is re-encoded from Q1 or Q2 one axis at
+// a time while its roots are computed. Axis roots are computed by a bounded
+// number of workers so at most runtime.NumCPU() trees are alive at once.
+func NewDataAvailabilityHeaderFromShares(s [][]byte) (DataAvailabilityHeader, error) {
+	squareSize, err := originalSquareSize(s)
+	if err != nil {
+		return DataAvailabilityHeader{}, err
+	}
+	codec := appconsts.DefaultCodec()
+	if len(s) > codec.MaxChunks() {
+		return DataAvailabilityHeader{}, fmt.Errorf("number of shares %d exceeds the maximum %d", len(s), codec.MaxChunks())
+	}
+
+	// q1[i] contains the parity shares of row i and q2[j] contains the parity
+	// shares of column j of the original data square.
+	q1 := make([][][]byte, squareSize)
+	q2 := make([][][]byte, squareSize)
+	err = forEachAxis(squareSize, func(i int) (err error) {
+		q1[i], err = codec.Encode(originalRow(s, squareSize, i))
+		if err != nil {
+			return err
+		}
+		q2[i], err = codec.Encode(originalCol(s, squareSize, i))
+		return err
+	})
+	if err != nil {
+		return DataAvailabilityHeader{}, err
+	}
+
+	width := 2 * squareSize
+	rowRoots := make([][]byte, width)
+	colRoots := make([][]byte, width)
+	err = forEachAxis(width, func(i int) (err error) {
+		var row, col [][]byte
+		if i < squareSize {
+			row = append(originalRow(s, squareSize, i), q1[i]...)
+			col = append(originalCol(s, squareSize, i), q2[i]...)
+		} else {
+			// Note that Q3 is identical if it is horizontally extended from Q2
+			// or vertically extended from Q1.
+			row, err = extendAxis(codec, parityRow(q2, i-squareSize))
+			if err != nil {
+				return err
+			}
+			col, err = extendAxis(codec, parityRow(q1, i-squareSize))
+			if err != nil {
+				return err
+			}
+		}
+		rowRoots[i], err = computeAxisRoot(row, uint64(squareSize), uint(i))
+		if err != nil {
+			return err
+		}
+		colRoots[i], err = computeAxisRoot(col, uint64(squareSize), uint(i))
+		return err
+	})
+	if err != nil {
+		return DataAvailabilityHeader{}, err
+	}
+
+	dah := DataAvailabilityHeader{
+		RowRoots:    rowRoots,
+		ColumnRoots: colRoots,
+	}
+	// Generate the hash of the data using the new roots
+	dah.Hash()
+	return dah, nil
+}
+
+// originalSquareSize returns the width of the original data square formed by
+// s. It returns an error if s can not be arranged into a square whose width is
+// a power of two.
+func originalSquareSize(s [][]byte) (int, error) {
+	squareSize := SquareSize(len(s))
+	if len(s) == 0 || squareSize*squareSize != len(s) {
+		return 0, fmt.Errorf("number of shares is not a square of a power of 2: got %d", len(s))
+	}
+	return squareSize, nil
+}
+
+// forEachAxis calls fn for every index in [0, n) using at most
+// runtime.NumCPU() goroutines.
+func forEachAxis(n int, fn func(i int) error) error {
+	var g errgroup.Group
+	g.SetLimit(runtime.NumCPU())
+	for i := 0; i < n; i++ {
+		i := i // https://go.dev/doc/faq#closures_and_goroutines
+		g.Go(func() error {
+			return fn(i)
+		})
+	}
+	return g.Wait()
+}
+
+// extendAxis returns the shares followed by their parity shares.
+func extendAxis(codec rsmt2d.Codec, shares [][]byte) ([][]byte, error) {
+	parity, err := codec.Encode(shares)
+	if err != nil {
+		return nil, err
+	}
+	return append(shares, parity...), nil
+}
+
+// originalRow returns row i of the original data square s. The returned slice
+// has its own backing array so it can be appended to.
+func originalRow(s [][]byte, squareSize int, i int) [][]byte {
+	row := make([][]byte, squareSize, 2*squareSize)
+	copy(row, s[i*squareSize:(i+1)*squareSize])
+	return row
+}
+
+// originalCol returns column j of the original data square s.
+func originalCol(s [][]byte, squareSize int, j int) [][]byte {
+	col := make([][]byte, squareSize, 2*squareSize)
+	for i := range col {
+		col[i] = s[i*squareSize+j]
+	}
+	return col
+}
+
+// parityRow returns the i-th share of each axis in parity.
+func parityRow(parity [][][]byte, i int) [][]byte {
+	row := make([][]byte, len(parity), 2*len(parity))
+	for j := range parity {
+		row[j] = parity[j][i]
+	}
+	return row
+}
@@ -0,0 +1,88 @@
+package da
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDataAvailabilityHeaderFromShares(t *testing.T) {
+	squareSizes := []int{1, 2, 4, 16, 64, appconsts.DefaultSquareSizeUpperBound}
+	for _, squareSize := range squareSizes {
+		t.Run(fmt.Sprintf("square size %d", squareSize), func(t *testing.T) {
+			shares := testfactory.GenerateRandNamespacedRawData(squareSize * squareSize)
+
+			eds, err := ExtendShares(shares)
+			require.NoError(t, err)
+			want, err := NewDataAvailabilityHeader(eds)
+			require.NoError(t, err)
+
+			got, err := NewDataAvailabilityHeaderFromShares(shares)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+
+	t.Run("min data availability header", func(t *testing.T) {
+		got, err := NewDataAvailabilityHeaderFromShares(MinShares())
+		require.NoError(t, err)
+		assert.Equal(t, MinDataAvailabilityHeader(), got)
+	})
+}
+
+func TestNewDataAvailabilityHeaderFromSharesErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		shares [][]byte
+	}{
+		{
+			name:   "no shares",
+			shares: nil,
+		},
+		{
+			name:   "number of shares is not a power of 2",
+			shares: generateShares(5),
+		},
+		{
+			name:   "number of shares is not a square",
+			shares: generateShares(8),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewDataAvailabilityHeaderFromShares(tc.shares)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// BenchmarkDataAvailabilityHeader compares computing a data availability
+// header by materializing the extended data square to computing it from the
+// original shares.
+func BenchmarkDataAvailabilityHeader(b *testing.B) {
+	for _, squareSize := range []int{32, 64, appconsts.DefaultSquareSizeUpperBound} {
+		shares := testfactory.GenerateRandNamespacedRawData(squareSize * squareSize)
+
+		b.Run(fmt.Sprintf("ExtendShares square size %d", squareSize), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				eds, err := ExtendShares(shares)
+				require.NoError(b, err)
+				_, err = NewDataAvailabilityHeader(eds)
+				require.NoError(b, err)
+			}
+		})
+
+		b.Run(fmt.Sprintf("FromShares square size %d", squareSize), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, err := NewDataAvailabilityHeaderFromShares(shares)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	"github.com/celestiaorg/go-square/blob"
+	"github.com/celestiaorg/go-square/inclusion"
+	appns "github.com/celestiaorg/go-square/namespace"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/celestiaorg/go-square/square"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// PFBProof proves that a PayForBlobs transaction is included in a square and
+// links each blob declared by the PayForBlobs to the blob shares that its
+// IndexWrapper points to in the same square.
+type PFBProof struct {
+	// IndexWrapper is the IndexWrapper of the PayForBlobs transaction as it
+	// was written to the square.
+	IndexWrapper []byte
+	// TxProof proves the shares of the PayForBlobs namespace that contain
+	// IndexWrapper.
+	TxProof ShareProof
+	// BlobProofs contains one proof per blob in the PayForBlobs. BlobProofs[i]
+	// proves the shares of the blob that starts at ShareIndexes[i] of the
+	// IndexWrapper.
+	BlobProofs []ShareProof
+}
+
+// NewPFBProof returns a PFBProof for the PayForBlobs transaction at txIndex.
+// It returns an error if the transaction at txIndex is not a BlobTx.
+func NewPFBProof(txs [][]byte, txIndex, appVersion uint64) (PFBProof, error) {
+	if txIndex >= uint64(len(txs)) {
+		return PFBProof{}, fmt.Errorf("txIndex %d out of bounds", txIndex)
+	}
+	if _, isBlobTx := blob.UnmarshalBlobTx(txs[txIndex]); !isBlobTx {
+		return PFBProof{}, fmt.Errorf("tx at index %d is not a blob tx", txIndex)
+	}
+
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appVersion), appconsts.SubtreeRootThreshold(appVersion), txs...)
+	if err != nil {
+		return PFBProof{}, err
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return PFBProof{}, err
+	}
+
+	txIndexInt, err := safeConvertUint64ToInt(txIndex)
+	if err != nil {
+		return PFBProof{}, err
+	}
+	wrapper, err := builder.GetWrappedPFB(txIndexInt)
+	if err != nil {
+		return PFBProof{}, err
+	}
+	rawWrapper, err := blob.MarshalIndexWrapper(wrapper.Tx, wrapper.ShareIndexes...)
+	if err != nil {
+		return PFBProof{}, err
+	}
+	txShareRange, err := builder.FindTxShareRange(txIndexInt)
+	if err != nil {
+		return PFBProof{}, err
+	}
+
+	// extend the square once and reuse it for all the proofs.
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	if err != nil {
+		return PFBProof{}, err
+	}
+	txProof, err := NewShareInclusionProofFromEDS(eds, appns.PayForBlobNamespace, txShareRange)
+	if err != nil {
+		return PFBProof{}, err
+	}
+
+	blobProofs := make([]ShareProof, len(wrapper.ShareIndexes))
+	for i, start := range wrapper.ShareIndexes {
+		length, err := builder.BlobShareLength(txIndexInt, i)
+		if err != nil {
+			return PFBProof{}, err
+		}
+		namespace, err := dataSquare[start].Namespace()
+		if err != nil {
+			return PFBProof{}, err
+		}
+		blobProofs[i], err = NewShareInclusionProofFromEDS(eds, namespace, shares.NewRange(int(start), int(start)+length))
+		if err != nil {
+			return PFBProof{}, err
+		}
+	}
+
+	return PFBProof{
+		IndexWrapper: rawWrapper,
+		TxProof:      txProof,
+		BlobProofs:   blobProofs,
+	}, nil
+}
+
+// Verify checks the proof against the data root and returns the verified
+// PayForBlobs message along with the blobs that it pays for. It checks that:
+//   - the IndexWrapper is a complete transaction in the proven PayForBlobs
+//     namespace shares.
+//   - every blob proof starts at the share index declared in the IndexWrapper
+//     and is in the namespace declared by the PayForBlobs.
+//   - every proven blob has the size and share commitment declared by the
+//     PayForBlobs.
+func (p PFBProof) Verify(txcfg client.TxEncodingConfig, root []byte, appVersion uint64) (*blobtypes.MsgPayForBlobs, []*blob.Blob, error) {
+	if err := p.TxProof.Validate(root); err != nil {
+		return nil, nil, fmt.Errorf("invalid pfb tx proof: %w", err)
+	}
+	if !bytes.Equal(proofNamespace(p.TxProof), appns.PayForBlobNamespace.Bytes()) {
+		return nil, nil, errors.New("pfb tx proof is not in the pay for blob namespace")
+	}
+	if err := containsUnit(p.TxProof.Data, p.IndexWrapper); err != nil {
+		return nil, nil, err
+	}
+
+	wrapper, isIndexWrapper := blob.UnmarshalIndexWrapper(p.IndexWrapper)
+	if !isIndexWrapper {
+		return nil, nil, errors.New("failed to unmarshal index wrapper")
+	}
+	sdkTx, err := txcfg.TxDecoder()(wrapper.Tx)
+	if err != nil {
+		return nil, nil, err
+	}
+	msgs := sdkTx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, nil, blobtypes.ErrMultipleMsgsInBlobTx
+	}
+	msgPFB, ok := msgs[0].(*blobtypes.MsgPayForBlobs)
+	if !ok {
+		return nil, nil, blobtypes.ErrNoPFB
+	}
+
+	if len(wrapper.ShareIndexes) != len(msgPFB.Namespaces) {
+		return nil, nil, fmt.Errorf("index wrapper has %d share indexes but the pfb has %d blobs", len(wrapper.ShareIndexes), len(msgPFB.Namespaces))
+	}
+	if len(p.BlobProofs) != len(wrapper.ShareIndexes) {
+		return nil, nil, fmt.Errorf("expected %d blob proofs, got %d", len(wrapper.ShareIndexes), len(p.BlobProofs))
+	}
+
+	blobs := make([]*blob.Blob, len(p.BlobProofs))
+	for i, blobProof := range p.BlobProofs {
+		if err := blobProof.Validate(root); err != nil {
+			return nil, nil, fmt.Errorf("invalid proof for blob %d: %w", i, err)
+		}
+		if !bytes.Equal(proofNamespace(blobProof), msgPFB.Namespaces[i]) {
+			return nil, nil, fmt.Errorf("blob %d is not in the namespace declared by the pfb", i)
+		}
+		if start := proofStartIndex(blobProof); start != uint64(wrapper.ShareIndexes[i]) {
+			return nil, nil, fmt.Errorf("blob %d starts at share %d instead of share %d", i, start, wrapper.ShareIndexes[i])
+		}
+
+		rawShares, err := shares.FromBytes(blobProof.Data)
+		if err != nil {
+			return nil, nil, err
+		}
+		parsed, err := shares.ParseBlobs(rawShares)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(parsed) != 1 {
+			return nil, nil, fmt.Errorf("expected the proof for blob %d to contain exactly one blob, got %d", i, len(parsed))
+		}
+		b := parsed[0]
+		if uint32(len(b.Data)) != msgPFB.BlobSizes[i] {
+			return nil, nil, blobtypes.ErrBlobSizeMismatch.Wrapf("blob %d: actual %d declared %d", i, len(b.Data), msgPFB.BlobSizes[i])
+		}
+		if shares.SparseSharesNeeded(msgPFB.BlobSizes[i]) != len(blobProof.Data) {
+			return nil, nil, fmt.Errorf("proof for blob %d contains %d shares, expected %d", i, len(blobProof.Data), shares.SparseSharesNeeded(msgPFB.BlobSizes[i]))
+		}
+		commitment, err := inclusion.CreateCommitment(b, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold(appVersion))
+		if err != nil {
+			return nil, nil, blobtypes.ErrCalculateCommitment
+		}
+		if !bytes.Equal(commitment, msgPFB.ShareCommitments[i]) {
+			return nil, nil, blobtypes.ErrInvalidShareCommitment
+		}
+		blobs[i] = b
+	}
+
+	return msgPFB, blobs, nil
+}
+
+// proofNamespace returns the namespace of the shares proven by sp.
+func proofNamespace(sp ShareProof) []byte {
+	return append([]byte{uint8(sp.NamespaceVersion)}, sp.NamespaceId...)
+}
+
+// proofStartIndex returns the index in the original data square of the first
+// share proven by sp.
+func proofStartIndex(sp ShareProof) uint64 {
+	if sp.RowProof == nil || len(sp.RowProof.Proofs) == 0 || len(sp.ShareProofs) == 0 {
+		return 0
+	}
+	// the data root commits to the row roots followed by the column roots of
+	// the extended data square so it has 4 * squareSize leaves.
+	squareSize := uint64(sp.RowProof.Proofs[0].Total) / 4
+	return uint64(sp.RowProof.StartRow)*squareSize + uint64(sp.ShareProofs[0].Start)
+}
+
+// containsUnit returns an error if unit is not one of the length delimited
+// units that start in the provided compact shares and end before the last
+// share does.
+func containsUnit(rawShares [][]byte, unit []byte) error {
+	compactShares, err := shares.FromBytes(rawShares)
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	for i, share := range compactShares {
+		var rawData []byte
+		if i == 0 {
+			// the reserved bytes of the first share point to the first unit
+			// that starts in it.
+			rawData, err = share.RawDataUsingReserved()
+		} else {
+			rawData, err = share.RawData()
+		}
+		if err != nil {
+			return err
+		}
+		if i == 0 && len(rawData) == 0 {
+			return errors.New("no unit starts in the first proven share")
+		}
+		data = append(data, rawData...)
+	}
+
+	for len(data) > 0 {
+		rest, unitLen, err := shares.ParseDelimiter(data)
+		if err != nil {
+			return err
+		}
+		// a unit length of zero marks the start of padding.
+		if unitLen == 0 || unitLen > uint64(len(rest)) {
+			break
+		}
+		if bytes.Equal(rest[:unitLen], unit) {
+			return nil
+		}
+		data = rest[unitLen:]
+	}
+	return errors.New("index wrapper is not included in the proven shares")
+}
@@ -0,0 +1,114 @@
+package proof_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/app/encoding"
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/da"
+	"github.com/celestiaorg/celestia-app/v2/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v2/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testnode"
+	"github.com/celestiaorg/go-square/shares"
+	"github.com/celestiaorg/go-square/square"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestNewPFBProof(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+
+	blockTxs := testfactory.GenerateRandomTxs(20, 500).ToSliceOfBytes()
+	blobTxs := blobfactory.RandMultiBlobTxsSameSigner(t, tmrand.NewRand(), signer, 10)
+	for _, tx := range blobTxs {
+		blockTxs = append(blockTxs, tx)
+	}
+
+	dataSquare, err := square.Construct(blockTxs, appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeaderFromShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	root := dah.Hash()
+	minDah := da.MinDataAvailabilityHeader()
+
+	t.Run("every pfb in the block", func(t *testing.T) {
+		for txIndex := 20; txIndex < len(blockTxs); txIndex++ {
+			pfbProof, err := proof.NewPFBProof(blockTxs, uint64(txIndex), appconsts.LatestVersion)
+			require.NoError(t, err)
+
+			msg, blobs, err := pfbProof.Verify(encCfg.TxConfig, root, appconsts.LatestVersion)
+			require.NoError(t, err)
+			assert.Len(t, blobs, len(msg.BlobSizes))
+			assert.Equal(t, signer.Account(testfactory.TestAccName).Address().String(), msg.Signer)
+		}
+	})
+
+	t.Run("not a blob tx", func(t *testing.T) {
+		_, err := proof.NewPFBProof(blockTxs, 0, appconsts.LatestVersion)
+		assert.Error(t, err)
+	})
+
+	t.Run("tx index out of bounds", func(t *testing.T) {
+		_, err := proof.NewPFBProof(blockTxs, uint64(len(blockTxs)), appconsts.LatestVersion)
+		assert.Error(t, err)
+	})
+
+	type testCase struct {
+		name   string
+		mutate func(p *proof.PFBProof)
+		root   []byte
+	}
+	testCases := []testCase{
+		{
+			name:   "wrong data root",
+			mutate: func(_ *proof.PFBProof) {},
+			root:   minDah.Hash(),
+		},
+		{
+			name: "index wrapper of another pfb",
+			mutate: func(p *proof.PFBProof) {
+				other, err := proof.NewPFBProof(blockTxs, uint64(len(blockTxs)-1), appconsts.LatestVersion)
+				require.NoError(t, err)
+				p.IndexWrapper = other.IndexWrapper
+			},
+			root: root,
+		},
+		{
+			name: "blob proofs of another pfb",
+			mutate: func(p *proof.PFBProof) {
+				other, err := proof.NewPFBProof(blockTxs, uint64(len(blockTxs)-1), appconsts.LatestVersion)
+				require.NoError(t, err)
+				p.BlobProofs = other.BlobProofs
+			},
+			root: root,
+		},
+		{
+			name: "missing blob proof",
+			mutate: func(p *proof.PFBProof) {
+				p.BlobProofs = p.BlobProofs[:len(p.BlobProofs)-1]
+			},
+			root: root,
+		},
+		{
+			name: "tx proof used as blob proof",
+			mutate: func(p *proof.PFBProof) {
+				p.BlobProofs[0] = p.TxProof
+			},
+			root: root,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pfbProof, err := proof.NewPFBProof(blockTxs, 20, appconsts.LatestVersion)
+			require.NoError(t, err)
+			tc.mutate(&pfbProof)
+			_, _, err = pfbProof.Verify(encCfg.TxConfig, tc.root, appconsts.LatestVersion)
+			assert.Error(t, err)
+		})
+	}
+}
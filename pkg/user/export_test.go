@@ -0,0 +1,8 @@
+package user
+
+import sdktypes "github.com/cosmos/cosmos-sdk/types"
+
+// GasPrice exposes gasPrice to the tests of the user_test package.
+func (client *TxClient) GasPrice(msgs []sdktypes.Msg) float64 {
+	return client.gasPrice(msgs)
+}
@@ -6,12 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/celestiaorg/go-square/blob"
+	appns "github.com/celestiaorg/go-square/namespace"
 	"github.com/cosmos/cosmos-sdk/client"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
@@ -19,9 +19,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/celestiaorg/celestia-app/v2/app"
 	"github.com/celestiaorg/celestia-app/v2/app/encoding"
@@ -52,6 +54,23 @@ func WithDefaultGasPrice(price float64) Option {
 	}
 }
 
+// WithMsgGasPriceMultipliers sets the multipliers of the gas price per
+// message type that the network enforces.
+func WithMsgGasPriceMultipliers(multipliers []minfee.MsgGasPriceMultiplier) Option {
+	return func(c *TxClient) {
+		c.gasPriceMultipliers = multipliers
+	}
+}
+
+// WithBlobParams sets the blob params of the network, which are used to
+// estimate the gas of blob transactions.
+func WithBlobParams(params types.Params) Option {
+	return func(c *TxClient) {
+		c.gasPerBlobByte = params.GasPerBlobByte
+		c.blobGasPricing = params.BlobGasPricing()
+	}
+}
+
 func WithPollTime(time time.Duration) Option {
 	return func(c *TxClient) {
 		c.pollTime = time
@@ -99,8 +118,15 @@ type TxClient struct {
 	gasMultiplier float64
 	// defaultGasPrice is the price used if no price is provided
 	defaultGasPrice float64
-	defaultAccount  string
-	defaultAddress  sdktypes.AccAddress
+	// gasPriceMultipliers are the multipliers of the network minimum gas
+	// price per message type
+	gasPriceMultipliers []minfee.MsgGasPriceMultiplier
+	// gasPerBlobByte and blobGasPricing are the blob params of the network
+	// that the gas of blob transactions is estimated with
+	gasPerBlobByte uint32
+	blobGasPricing types.BlobGasPricing
+	defaultAccount string
+	defaultAddress sdktypes.AccAddress
 }
 
 // NewTxClient returns a new signer using the provided keyring
@@ -131,6 +157,7 @@ func NewTxClient(
 		pollTime:        DefaultPollTime,
 		gasMultiplier:   DefaultGasMultiplier,
 		defaultGasPrice: appconsts.DefaultMinGasPrice,
+		gasPerBlobByte:  appconsts.DefaultGasPerBlobByte,
 		defaultAccount:  records[0].Name,
 		defaultAddress:  addr,
 	}
@@ -187,7 +214,15 @@ func SetupTxClient(
 	if err != nil {
 		return nil, fmt.Errorf("querying minimum gas price: %w", err)
 	}
-	options = append([]Option{WithDefaultGasPrice(minPrice)}, options...)
+	multipliers, err := QueryMsgGasPriceMultipliers(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	blobParams, err := QueryBlobParams(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	options = append([]Option{WithDefaultGasPrice(minPrice), WithMsgGasPriceMultipliers(multipliers), WithBlobParams(blobParams)}, options...)
 
 	signer, err := NewSigner(keys, encCfg.TxConfig, chainID, appVersion, accounts...)
 	if err != nil {
@@ -233,12 +268,19 @@ func (client *TxClient) BroadcastPayForBlobWithAccount(ctx context.Context, acco
 	}
 
 	blobSizes := make([]uint32, len(blobs))
+	namespaces := make([][]byte, len(blobs))
 	for i, blob := range blobs {
 		blobSizes[i] = uint32(len(blob.Data))
+		namespace, err := appns.New(uint8(blob.NamespaceVersion), blob.NamespaceId)
+		if err != nil {
+			return nil, err
+		}
+		namespaces[i] = namespace.Bytes()
 	}
 
-	gasLimit := uint64(float64(types.DefaultEstimateGas(blobSizes)) * client.gasMultiplier)
-	fee := uint64(math.Ceil(appconsts.DefaultMinGasPrice * float64(gasLimit)))
+	gas := types.EstimateGasWithPricing(blobSizes, namespaces, client.gasPerBlobByte, client.blobGasPricing, authtypes.DefaultTxSizeCostPerByte)
+	gasLimit := uint64(float64(gas) * client.gasMultiplier)
+	fee := uint64(math.Ceil(client.gasPrice([]sdktypes.Msg{&types.MsgPayForBlobs{}}) * float64(gasLimit)))
 	// prepend calculated params, so it can be overwritten in case the user has specified it.
 	opts = append([]TxOption{SetGasLimit(gasLimit), SetFee(fee)}, opts...)
 
@@ -300,7 +342,7 @@ func (client *TxClient) BroadcastTx(ctx context.Context, msgs []sdktypes.Msg, op
 	}
 
 	if !hasUserSetFee {
-		fee := int64(math.Ceil(appconsts.DefaultMinGasPrice * float64(gasLimit)))
+		fee := int64(math.Ceil(client.gasPrice(msgs) * float64(gasLimit)))
 		txBuilder.SetFeeAmount(sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdktypes.NewInt(fee))))
 	}
 
@@ -558,8 +600,20 @@ func (client *TxClient) SetGasMultiplier(multiplier float64) {
 	client.gasMultiplier = multiplier
 }
 
+// gasPrice returns the gas price of a tx with msgs. It is the default gas
+// price, which is never lower than the default min gas price, multiplied by
+// the gas price multiplier that the network enforces for the message types of
+// msgs. Multipliers below one aren't applied because they don't lower the min
+// gas price of the node.
+func (client *TxClient) gasPrice(msgs []sdktypes.Msg) float64 {
+	price := max(appconsts.DefaultMinGasPrice, client.defaultGasPrice)
+	multiplier := minfee.TxGasPriceMultiplier(msgs, client.gasPriceMultipliers)
+	return price * max(1, multiplier.MustFloat64())
+}
+
 // QueryMinimumGasPrice queries both the nodes local and network wide
-// minimum gas prices, returning the maximum of the two.
+// minimum gas prices, returning the maximum of the two. The network wide
+// minimum gas price is the dynamic network base gas price if it is enabled.
 func QueryMinimumGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float64, error) {
 	cfgRsp, err := nodeservice.NewServiceClient(grpcConn).Config(ctx, &nodeservice.ConfigRequest{})
 	if err != nil {
@@ -572,14 +626,13 @@ func QueryMinimumGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float
 	}
 	localMinPrice := localMinCoins.AmountOf(app.BondDenom).MustFloat64()
 
-	networkMinPrice, err := QueryNetworkMinGasPrice(ctx, grpcConn)
+	networkMinPrice, err := QueryNetworkBaseGasPrice(ctx, grpcConn)
+	if status.Code(err) == codes.Unimplemented {
+		// fall back to the network min gas price for nodes that don't
+		// support querying the network base gas price
+		networkMinPrice, err = QueryNetworkMinGasPrice(ctx, grpcConn)
+	}
 	if err != nil {
-		// check if the network version supports a global min gas
-		// price using a regex check. If not (i.e. v1) use the
-		// local price only
-		if strings.Contains(err.Error(), "unknown subspace: minfee") {
-			return localMinPrice, nil
-		}
 		return 0, err
 	}
 
@@ -590,21 +643,64 @@ func QueryMinimumGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float
 	return localMinPrice, nil
 }
 
+// QueryNetworkBaseGasPrice queries the minimum gas price currently enforced by
+// the network. It is the dynamic network base gas price if enabled and the
+// network min gas price otherwise. It returns zero if the network doesn't
+// enforce a minimum gas price, i.e. before app version 2.
+func QueryNetworkBaseGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float64, error) {
+	resp, err := minfee.NewQueryClient(grpcConn).NetworkBaseGasPrice(ctx, &minfee.QueryNetworkBaseGasPrice{})
+	if status.Code(err) == codes.NotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("querying network base gas price: %w", err)
+	}
+	return resp.NetworkBaseGasPrice.Float64()
+}
+
+// QueryNetworkMinGasPrice queries the network min gas price. It returns zero
+// if the network doesn't enforce a minimum gas price, i.e. before app version
+// 2, or if the node doesn't serve the minfee queries, i.e. a v1 binary.
 func QueryNetworkMinGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float64, error) {
-	paramsClient := paramtypes.NewQueryClient(grpcConn)
 	// NOTE: that we don't prove that this is the correct value
-	paramResponse, err := paramsClient.Params(ctx, &paramtypes.QueryParamsRequest{Subspace: minfee.ModuleName, Key: string(minfee.KeyNetworkMinGasPrice)})
+	resp, err := minfee.NewQueryClient(grpcConn).NetworkMinGasPrice(ctx, &minfee.QueryNetworkMinGasPrice{})
+	if code := status.Code(err); code == codes.NotFound || code == codes.Unimplemented {
+		return 0, nil
+	}
 	if err != nil {
-		return 0, fmt.Errorf("querying params module: %w", err)
+		return 0, fmt.Errorf("querying network min gas price: %w", err)
 	}
+	return resp.NetworkMinGasPrice.Float64()
+}
 
-	var networkMinPrice float64
-	// Value is empty if network min gas price is not supported i.e. v1 state machine.
-	if paramResponse.Param.Value != "" {
-		networkMinPrice, err = strconv.ParseFloat(strings.Trim(paramResponse.Param.Value, `"`), 64)
-		if err != nil {
-			return 0, fmt.Errorf("parsing network min gas price: %w", err)
-		}
+// QueryMsgGasPriceMultipliers queries the multipliers of the network minimum
+// gas price per message type. It returns no multipliers if the network doesn't
+// enforce a minimum gas price, i.e. before app version 2.
+func QueryMsgGasPriceMultipliers(ctx context.Context, grpcConn *grpc.ClientConn) ([]minfee.MsgGasPriceMultiplier, error) {
+	resp, err := minfee.NewQueryClient(grpcConn).Params(ctx, &minfee.QueryParams{})
+	if code := status.Code(err); code == codes.NotFound || code == codes.Unimplemented {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("querying msg gas price multipliers: %w", err)
+	}
+	return resp.Params.MsgGasPriceMultipliers, nil
+}
+
+// QueryBlobParams queries the params of the blob module.
+func QueryBlobParams(ctx context.Context, grpcConn *grpc.ClientConn) (types.Params, error) {
+	resp, err := types.NewQueryClient(grpcConn).Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return types.Params{}, fmt.Errorf("querying blob params: %w", err)
+	}
+	return resp.Params, nil
+}
+
+// QueryMinFeeParams queries the params of the minfee module.
+func QueryMinFeeParams(ctx context.Context, grpcConn *grpc.ClientConn) (minfee.Params, error) {
+	resp, err := minfee.NewQueryClient(grpcConn).Params(ctx, &minfee.QueryParams{})
+	if err != nil {
+		return minfee.Params{}, fmt.Errorf("querying minfee params: %w", err)
 	}
-	return networkMinPrice, nil
+	return resp.Params, nil
 }
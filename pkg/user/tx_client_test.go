@@ -2,22 +2,39 @@ package user_test
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"net"
 	"testing"
 	"time"
 
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
 
 	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/app/ante"
 	"github.com/celestiaorg/celestia-app/v2/app/encoding"
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	v2 "github.com/celestiaorg/celestia-app/v2/pkg/appconsts/v2"
 	"github.com/celestiaorg/celestia-app/v2/pkg/user"
+	"github.com/celestiaorg/celestia-app/v2/test/util"
 	"github.com/celestiaorg/celestia-app/v2/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v2/test/util/genesis"
+	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
 	"github.com/celestiaorg/celestia-app/v2/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v2/x/minfee"
 )
 
 func TestTxClientTestSuite(t *testing.T) {
@@ -27,6 +44,212 @@ func TestTxClientTestSuite(t *testing.T) {
 	suite.Run(t, new(TxClientTestSuite))
 }
 
+// TestQueryMinimumGasPriceV1Node verifies that the minimum gas price queries
+// fall back to the local minimum gas price of nodes that run a binary that
+// doesn't serve the minfee queries.
+func TestQueryMinimumGasPriceV1Node(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	nodeservice.RegisterServiceServer(server, v1NodeService{minimumGasPrice: "0.002utia"})
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	networkMinPrice, err := user.QueryNetworkMinGasPrice(context.Background(), conn)
+	require.NoError(t, err)
+	require.Zero(t, networkMinPrice)
+
+	minPrice, err := user.QueryMinimumGasPrice(context.Background(), conn)
+	require.NoError(t, err)
+	require.Equal(t, 0.002, minPrice)
+}
+
+// v1NodeService serves the node config of a node whose binary doesn't serve
+// the minfee queries.
+type v1NodeService struct {
+	nodeservice.UnimplementedServiceServer
+	minimumGasPrice string
+}
+
+func (s v1NodeService) Config(context.Context, *nodeservice.ConfigRequest) (*nodeservice.ConfigResponse, error) {
+	return &nodeservice.ConfigResponse{MinimumGasPrice: s.minimumGasPrice}, nil
+}
+
+// TestTxClientMsgGasPriceMultipliers verifies that the tx client pays the gas
+// price surcharge that the network enforces for a message type.
+func TestTxClientMsgGasPriceMultipliers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	msgSendTypeURL := sdk.MsgTypeURL(&bank.MsgSend{})
+	params := minfee.DefaultParams()
+	// the network min gas price with the surcharge is above the default min
+	// gas price of the client.
+	params.NetworkMinGasPrice = sdk.MustNewDecFromStr(fmt.Sprint(appconsts.DefaultMinGasPrice))
+	params.MsgGasPriceMultipliers = []minfee.MsgGasPriceMultiplier{{MsgTypeUrl: msgSendTypeURL, Multiplier: sdk.NewDec(2)}}
+	cfg := testnode.DefaultConfig().
+		WithFundedAccounts("a").
+		WithModifiers(genesis.SetMinFeeParams(encCfg.Codec, params))
+	ctx, _, _ := testnode.NewNetwork(t, cfg)
+	_, err := ctx.WaitForHeight(1)
+	require.NoError(t, err)
+
+	txClient, err := user.SetupTxClient(ctx.GoContext(), ctx.Keyring, ctx.GRPCClient, encCfg)
+	require.NoError(t, err)
+	multipliers, err := user.QueryMsgGasPriceMultipliers(ctx.GoContext(), ctx.GRPCClient)
+	require.NoError(t, err)
+	require.Equal(t, params.MsgGasPriceMultipliers, multipliers)
+
+	addr := txClient.DefaultAddress()
+	msg := bank.NewMsgSend(addr, testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+	resp, err := txClient.SubmitTx(ctx.GoContext(), []sdk.Msg{msg})
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resp.Code)
+}
+
+// TestTxClientNetworkMinGasPrice verifies that the tx client pays the network
+// min gas price when it is above the default min gas price.
+func TestTxClientNetworkMinGasPrice(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	params := minfee.DefaultParams()
+	params.NetworkMinGasPrice = sdk.MustNewDecFromStr(fmt.Sprint(appconsts.DefaultMinGasPrice * 2))
+	cfg := testnode.DefaultConfig().
+		WithFundedAccounts("a").
+		WithModifiers(genesis.SetMinFeeParams(encCfg.Codec, params))
+	ctx, _, _ := testnode.NewNetwork(t, cfg)
+	_, err := ctx.WaitForHeight(1)
+	require.NoError(t, err)
+
+	txClient, err := user.SetupTxClient(ctx.GoContext(), ctx.Keyring, ctx.GRPCClient, encCfg)
+	require.NoError(t, err)
+
+	addr := txClient.DefaultAddress()
+	msg := bank.NewMsgSend(addr, testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+	resp, err := txClient.SubmitTx(ctx.GoContext(), []sdk.Msg{msg})
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resp.Code)
+
+	blobs := blobfactory.ManyRandBlobs(rand.NewRand(), 1e3)
+	resp, err = txClient.SubmitPayForBlob(ctx.GoContext(), blobs)
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resp.Code)
+}
+
+// TestTxClientGasPriceValidateTxFee verifies that the fee the tx client pays
+// for a tx passes the network min fee check for the same minfee params.
+func TestTxClientGasPriceValidateTxFee(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	kr, _ := testnode.NewKeyring("a")
+	signer, err := user.NewSigner(kr, encCfg.TxConfig, testfactory.ChainID, v2.Version, user.NewAccount("a", 0, 0))
+	require.NoError(t, err)
+	msg := bank.NewMsgSend(testnode.RandomAddress().(sdk.AccAddress), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+	gasLimit := uint64(100_000)
+
+	testCases := []struct {
+		name               string
+		networkMinGasPrice float64
+		multiplier         sdk.Dec
+		// exact is true if the client pays exactly the network min fee
+		exact bool
+	}{
+		{
+			name:               "network min gas price below the default",
+			networkMinGasPrice: appconsts.DefaultMinGasPrice / 2,
+			multiplier:         sdk.OneDec(),
+		},
+		{
+			name:               "network min gas price above the default",
+			networkMinGasPrice: appconsts.DefaultMinGasPrice * 2,
+			multiplier:         sdk.OneDec(),
+			exact:              true,
+		},
+		{
+			name:               "surcharge",
+			networkMinGasPrice: appconsts.DefaultMinGasPrice * 2,
+			multiplier:         sdk.NewDec(3),
+			exact:              true,
+		},
+		{
+			name:               "discount",
+			networkMinGasPrice: appconsts.DefaultMinGasPrice * 2,
+			multiplier:         sdk.NewDecWithPrec(5, 1),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := minfee.DefaultParams()
+			params.NetworkMinGasPrice = sdk.MustNewDecFromStr(fmt.Sprint(tc.networkMinGasPrice))
+			params.MsgGasPriceMultipliers = []minfee.MsgGasPriceMultiplier{{MsgTypeUrl: sdk.MsgTypeURL(msg), Multiplier: tc.multiplier}}
+
+			testApp := util.NewTestApp()
+			ctx := testApp.NewUncachedContext(false, tmproto.Header{Version: version.Consensus{App: v2.Version}})
+			subspace, _ := testApp.ParamsKeeper.GetSubspace(minfee.ModuleName)
+			subspace = minfee.RegisterMinFeeParamTable(subspace)
+			subspace.SetParamSet(ctx, &params)
+
+			// the client uses the network min gas price as its default gas
+			// price, as set up by SetupTxClient.
+			txClient, err := user.NewTxClient(signer, nil, encCfg.InterfaceRegistry,
+				user.WithDefaultGasPrice(tc.networkMinGasPrice),
+				user.WithMsgGasPriceMultipliers(params.MsgGasPriceMultipliers),
+			)
+			require.NoError(t, err)
+			fee := int64(math.Ceil(txClient.GasPrice([]sdk.Msg{msg}) * float64(gasLimit)))
+
+			validateFee := func(fee int64) error {
+				builder := encCfg.TxConfig.NewTxBuilder()
+				require.NoError(t, builder.SetMsgs(msg))
+				builder.SetGasLimit(gasLimit)
+				builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, fee)))
+				_, _, err := ante.ValidateTxFee(ctx, builder.GetTx(), testApp.ParamsKeeper, ante.DefaultTxPriorityMode)
+				return err
+			}
+			require.NoError(t, validateFee(fee))
+			if tc.exact {
+				require.ErrorIs(t, validateFee(fee-1), sdkerrors.ErrInsufficientFee)
+			}
+		})
+	}
+}
+
+// TestTxClientBlobGasPricing verifies that the tx client estimates the gas of
+// blob transactions with the blob gas pricing table of the network.
+func TestTxClientBlobGasPricing(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	params := blobtypes.DefaultParams()
+	// all blobs are charged three times the gas of the default estimate
+	params.BlobSizeGasTiers = []blobtypes.BlobSizeGasTier{{MinBlobSize: 0, Multiplier: sdk.NewDec(3)}}
+	cfg := testnode.DefaultConfig().
+		WithFundedAccounts("a").
+		WithModifiers(genesis.SetBlobParams(encCfg.Codec, params))
+	ctx, _, _ := testnode.NewNetwork(t, cfg)
+	_, err := ctx.WaitForHeight(1)
+	require.NoError(t, err)
+
+	txClient, err := user.SetupTxClient(ctx.GoContext(), ctx.Keyring, ctx.GRPCClient, encCfg)
+	require.NoError(t, err)
+
+	blobs := blobfactory.ManyRandBlobs(rand.NewRand(), 1e4)
+	resp, err := txClient.SubmitPayForBlob(ctx.GoContext(), blobs)
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resp.Code)
+	require.Greater(t, uint64(resp.GasWanted), blobtypes.DefaultEstimateGas([]uint32{1e4})*2)
+}
+
 type TxClientTestSuite struct {
 	suite.Suite
 
@@ -188,6 +411,29 @@ func (suite *TxClientTestSuite) TestGasEstimation() {
 	require.Greater(suite.T(), gas, uint64(0))
 }
 
+func (suite *TxClientTestSuite) TestQueryMinFee() {
+	t := suite.T()
+	params, err := user.QueryMinFeeParams(suite.ctx.GoContext(), suite.ctx.GRPCClient)
+	require.NoError(t, err)
+	require.Equal(t, minfee.DefaultParams(), params)
+
+	networkMinPrice, err := user.QueryNetworkMinGasPrice(suite.ctx.GoContext(), suite.ctx.GRPCClient)
+	require.NoError(t, err)
+	require.Equal(t, v2.NetworkMinGasPrice, networkMinPrice)
+
+	networkBasePrice, err := user.QueryNetworkBaseGasPrice(suite.ctx.GoContext(), suite.ctx.GRPCClient)
+	require.NoError(t, err)
+	require.Equal(t, v2.NetworkMinGasPrice, networkBasePrice)
+
+	minPrice, err := user.QueryMinimumGasPrice(suite.ctx.GoContext(), suite.ctx.GRPCClient)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, minPrice, networkBasePrice)
+
+	multipliers, err := user.QueryMsgGasPriceMultipliers(suite.ctx.GoContext(), suite.ctx.GRPCClient)
+	require.NoError(t, err)
+	require.Empty(t, multipliers)
+}
+
 // TestGasConsumption verifies that the amount deducted from a user's balance is
 // based on the fee provided in the tx instead of the gas used by the tx. This
 // behavior leads to poor UX because tx submitters must over-estimate the amount
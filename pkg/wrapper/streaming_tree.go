@@ -0,0 +1,108 @@
+package wrapper
+
+import (
+	"errors"
+	"fmt"
+	"hash"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/nmt/namespace"
+)
+
+var _ Tree = &streamingTree{}
+
+// errProofsNotSupported is returned by streamingTree.ProveRange because the
+// tree does not retain the leaves that were pushed to it.
+var errProofsNotSupported = errors.New("streaming tree does not support proofs")
+
+// leafPrefix is written to the base hasher before each leaf.
+var leafPrefix = []byte{nmt.LeafPrefix}
+
+// streamingTree computes the same root as the NamespacedMerkleTree used by
+// ErasuredNamespacedMerkleTree without retaining the leaves pushed to it.
+// Leaves are hashed as soon as they are pushed and sibling subtrees are merged
+// as soon as both are complete, so the tree only holds O(log(n)) subtree roots.
+type streamingTree struct {
+	baseHasher hash.Hash
+	nmtHasher  *nmt.NmtHasher
+	// lastNamespace is the namespace of the last leaf that was pushed. It is
+	// used to enforce that leaves are pushed in namespace order.
+	lastNamespace namespace.ID
+	// subtrees contains the roots of the complete subtrees pushed so far in
+	// the order that they were pushed. heights[i] is the height of subtrees[i].
+	subtrees [][]byte
+	heights  []int
+}
+
+// NewStreamingErasuredNamespacedMerkleTree creates a new
+// ErasuredNamespacedMerkleTree that only computes its root. The root is
+// identical to the one computed by NewErasuredNamespacedMerkleTree but leaves
+// are not retained after they are hashed, so ProveRange always returns an
+// error. squareSize must be greater than zero.
+func NewStreamingErasuredNamespacedMerkleTree(squareSize uint64, axisIndex uint) ErasuredNamespacedMerkleTree {
+	tree := NewErasuredNamespacedMerkleTree(squareSize, axisIndex)
+	tree.SetTree(&streamingTree{
+		baseHasher: appconsts.NewBaseHashFunc(),
+		nmtHasher:  nmt.NewNmtHasher(appconsts.NewBaseHashFunc(), appconsts.NamespaceSize, true),
+	})
+	return tree
+}
+
+// Push hashes the provided leaf and merges any complete subtrees.
+func (t *streamingTree) Push(namespacedData namespace.PrefixedData) error {
+	if len(namespacedData) < appconsts.NamespaceSize {
+		return fmt.Errorf("%w: got: %v, want >= %v", nmt.ErrInvalidLeafLen, len(namespacedData), appconsts.NamespaceSize)
+	}
+	nID := namespace.ID(namespacedData[:appconsts.NamespaceSize])
+	if t.lastNamespace != nil && nID.Less(t.lastNamespace) {
+		return fmt.Errorf("%w: last namespace: %x, pushed: %x", nmt.ErrInvalidPushOrder, t.lastNamespace, nID)
+	}
+	t.lastNamespace = nID
+
+	// the leaf hash is nID || nID || hash(LeafPrefix || ndata). Write the
+	// prefix and data separately to avoid copying the leaf.
+	leafHash := make([]byte, 0, 2*appconsts.NamespaceSize+t.baseHasher.Size())
+	leafHash = append(leafHash, nID...)
+	leafHash = append(leafHash, nID...)
+	t.baseHasher.Reset()
+	t.baseHasher.Write(leafPrefix)
+	t.baseHasher.Write(namespacedData)
+	leafHash = t.baseHasher.Sum(leafHash)
+
+	t.subtrees = append(t.subtrees, leafHash)
+	t.heights = append(t.heights, 0)
+	for n := len(t.subtrees); n >= 2 && t.heights[n-1] == t.heights[n-2]; n = len(t.subtrees) {
+		node, err := t.nmtHasher.HashNode(t.subtrees[n-2], t.subtrees[n-1])
+		if err != nil {
+			return err
+		}
+		t.subtrees = append(t.subtrees[:n-2], node)
+		t.heights = append(t.heights[:n-2], t.heights[n-2]+1)
+	}
+	return nil
+}
+
+// Root returns the root of the leaves pushed so far. If the number of leaves
+// is not a power of two, the remaining subtrees are merged from right to left
+// which matches how NamespacedMerkleTree splits a tree at the largest power of
+// two smaller than the number of leaves.
+func (t *streamingTree) Root() ([]byte, error) {
+	if len(t.subtrees) == 0 {
+		return t.nmtHasher.EmptyRoot(), nil
+	}
+	root := t.subtrees[len(t.subtrees)-1]
+	for i := len(t.subtrees) - 2; i >= 0; i-- {
+		var err error
+		root, err = t.nmtHasher.HashNode(t.subtrees[i], root)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return root, nil
+}
+
+// ProveRange always returns an error because the leaves are not retained.
+func (t *streamingTree) ProveRange(_, _ int) (nmt.Proof, error) {
+	return nmt.Proof{}, errProofsNotSupported
+}
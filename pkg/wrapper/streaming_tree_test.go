@@ -0,0 +1,66 @@
+package wrapper_test
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/pkg/wrapper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStreamingErasuredNamespacedMerkleTreeRoot checks that the streaming tree
+// computes the same root as the standard erasured NMT for every axis of a
+// square and for a number of leaves that is not a power of two.
+func TestStreamingErasuredNamespacedMerkleTreeRoot(t *testing.T) {
+	squareSize := 8
+	data := generateErasuredData(t, squareSize, appconsts.DefaultCodec())
+
+	for _, axisIndex := range []uint{0, uint(squareSize) - 1, uint(squareSize), 2*uint(squareSize) - 1} {
+		for _, numLeaves := range []int{0, 1, 3, 5, 2 * squareSize} {
+			t.Run(fmt.Sprintf("axis %d with %d leaves", axisIndex, numLeaves), func(t *testing.T) {
+				standard := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), axisIndex)
+				streaming := wrapper.NewStreamingErasuredNamespacedMerkleTree(uint64(squareSize), axisIndex)
+				for _, d := range data[:numLeaves] {
+					require.NoError(t, standard.Push(d))
+					require.NoError(t, streaming.Push(d))
+				}
+
+				want, err := standard.Root()
+				require.NoError(t, err)
+				got, err := streaming.Root()
+				require.NoError(t, err)
+				assert.Equal(t, want, got)
+			})
+		}
+	}
+}
+
+func TestStreamingErasuredNamespacedMerkleTreeErrors(t *testing.T) {
+	squareSize := 16
+
+	t.Run("push in incorrect lexicographic order", func(t *testing.T) {
+		data := generateErasuredData(t, squareSize, appconsts.DefaultCodec())
+		sort.Slice(data, func(i, j int) bool {
+			return bytes.Compare(data[i], data[j]) > 0
+		})
+		tree := wrapper.NewStreamingErasuredNamespacedMerkleTree(uint64(squareSize), 0)
+		var err error
+		for _, d := range data {
+			err = tree.Push(d)
+		}
+		assert.Error(t, err)
+	})
+
+	t.Run("prove range", func(t *testing.T) {
+		tree := wrapper.NewStreamingErasuredNamespacedMerkleTree(uint64(squareSize), 0)
+		for _, d := range generateErasuredData(t, squareSize, appconsts.DefaultCodec()) {
+			require.NoError(t, tree.Push(d))
+		}
+		_, err := tree.ProveRange(0, 1)
+		assert.Error(t, err)
+	})
+}
@@ -289,7 +289,7 @@ func CreateTestEnvWithoutBlobstreamKeysInit(t *testing.T) TestInput {
 	)
 
 	blobstreamKeeper := keeper.NewKeeper(marshaler, keyBlobstream, getSubspace(paramsKeeper, blobstreamtypes.DefaultParamspace), &stakingKeeper)
-	blobstreamKeeper.SetParams(ctx, *blobstreamtypes.DefaultGenesis().Params)
+	blobstreamKeeper.InitParams(ctx, *blobstreamtypes.DefaultGenesis().Params)
 
 	stakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
@@ -7,6 +7,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v2/app"
 	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
 	bstypes "github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	"github.com/celestiaorg/celestia-app/v2/x/minfee"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -30,6 +31,22 @@ func SetBlobParams(codec codec.Codec, params blobtypes.Params) Modifier {
 	}
 }
 
+// SetMinFeeParams will set the provided minfee params as genesis state.
+func SetMinFeeParams(codec codec.Codec, params minfee.Params) Modifier {
+	return func(state map[string]json.RawMessage) map[string]json.RawMessage {
+		minFeeGenState := minfee.DefaultGenesis()
+		minFeeGenState.NetworkMinGasPrice = params.NetworkMinGasPrice
+		minFeeGenState.DynamicBaseGasPriceEnabled = params.DynamicBaseGasPriceEnabled
+		minFeeGenState.MaxNetworkBaseGasPrice = params.MaxNetworkBaseGasPrice
+		minFeeGenState.BaseGasPriceChangeRate = params.BaseGasPriceChangeRate
+		minFeeGenState.TargetBlobSquareUtilization = params.TargetBlobSquareUtilization
+		minFeeGenState.FeeDenomRates = params.FeeDenomRates
+		minFeeGenState.MsgGasPriceMultipliers = params.MsgGasPriceMultipliers
+		state[minfee.ModuleName] = codec.MustMarshalJSON(minFeeGenState)
+		return state
+	}
+}
+
 // ImmediateProposals sets the thresholds for getting a gov proposal to very low
 // levels.
 func ImmediateProposals(codec codec.Codec) Modifier {
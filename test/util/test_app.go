@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -58,7 +59,13 @@ func (ao EmptyAppOptions) Get(_ string) interface{} {
 // is bonded with a delegation of one consensus engine unit in the default token
 // of the app from first genesis account. A no-op logger is set in app.
 func SetupTestAppWithGenesisValSet(cparams *tmproto.ConsensusParams, genAccounts ...string) (*app.App, keyring.Keyring) {
-	testApp, valSet, kr := NewTestAppWithGenesisSet(cparams, genAccounts...)
+	return SetupTestAppWithGenesisValSetAndOptions(EmptyAppOptions{}, cparams, genAccounts...)
+}
+
+// SetupTestAppWithGenesisValSetAndOptions is like SetupTestAppWithGenesisValSet
+// but creates the app with appOpts, e.g. to set the flags of a node.
+func SetupTestAppWithGenesisValSetAndOptions(appOpts servertypes.AppOptions, cparams *tmproto.ConsensusParams, genAccounts ...string) (*app.App, keyring.Keyring) {
+	testApp, valSet, kr := initTestAppWithGenesisSet(newTestApp(appOpts), cparams, genAccounts...)
 
 	// commit genesis changes
 	testApp.Commit()
@@ -79,7 +86,12 @@ func SetupTestAppWithGenesisValSet(cparams *tmproto.ConsensusParams, genAccounts
 // NewTestApp creates a new app instance with an empty memDB and a no-op logger.
 func NewTestApp() *app.App {
 	// EmptyAppOptions is a stub implementing AppOptions
-	emptyOpts := EmptyAppOptions{}
+	return newTestApp(EmptyAppOptions{})
+}
+
+// newTestApp creates a new app instance with appOpts, an empty memDB and a
+// no-op logger.
+func newTestApp(appOpts servertypes.AppOptions) *app.App {
 	// var anteOpt = func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(nil) }
 	db := dbm.NewMemDB()
 
@@ -87,10 +99,10 @@ func NewTestApp() *app.App {
 
 	return app.New(
 		log.NewNopLogger(), db, nil,
-		cast.ToUint(emptyOpts.Get(server.FlagInvCheckPeriod)),
+		cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod)),
 		encCfg,
 		0,
-		emptyOpts,
+		appOpts,
 	)
 }
 
@@ -168,7 +180,12 @@ func SetupDeterministicGenesisState(testApp *app.App, pubKeys []cryptotypes.PubK
 
 // NewTestAppWithGenesisSet initializes a new app with genesis accounts and returns the testApp, validator set and keyring.
 func NewTestAppWithGenesisSet(cparams *tmproto.ConsensusParams, genAccounts ...string) (*app.App, *tmtypes.ValidatorSet, keyring.Keyring) {
-	testApp := NewTestApp()
+	return initTestAppWithGenesisSet(NewTestApp(), cparams, genAccounts...)
+}
+
+// initTestAppWithGenesisSet initializes testApp with genesis accounts and
+// returns it with the validator set and keyring.
+func initTestAppWithGenesisSet(testApp *app.App, cparams *tmproto.ConsensusParams, genAccounts ...string) (*app.App, *tmtypes.ValidatorSet, keyring.Keyring) {
 	genesisState, valSet, kr := GenesisStateWithSingleValidator(testApp, genAccounts...)
 
 	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
@@ -27,16 +27,22 @@ func (d MinGasPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 		return next(ctx, tx, simulate)
 	}
 
-	var gasPerByte uint32
+	var (
+		gasPerByte uint32
+		pricing    types.BlobGasPricing
+	)
 	txGas := ctx.GasMeter().GasRemaining()
 	for _, m := range tx.GetMsgs() {
 		// NOTE: here we assume only one PFB per transaction
 		if pfb, ok := m.(*types.MsgPayForBlobs); ok {
 			if gasPerByte == 0 {
-				// lazily fetch the gas per byte param
+				// lazily fetch the gas per byte and the pricing params. The
+				// pricing params are read without consuming gas so that the
+				// gas of a PFB can be estimated from its blobs.
 				gasPerByte = d.k.GasPerBlobByte(ctx)
+				pricing = d.k.BlobGasPricing(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
 			}
-			gasToConsume := pfb.Gas(gasPerByte)
+			gasToConsume := pfb.Gas(gasPerByte, pricing)
 			if gasToConsume > txGas {
 				return ctx, errors.Wrapf(sdkerrors.ErrInsufficientFee, "not enough gas to pay for blobs (minimum: %d, got: %d)", gasToConsume, txGas)
 			}
@@ -49,4 +55,5 @@ func (d MinGasPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 type BlobKeeper interface {
 	GasPerBlobByte(ctx sdk.Context) uint32
 	GovMaxSquareSize(ctx sdk.Context) uint64
+	BlobGasPricing(ctx sdk.Context) types.BlobGasPricing
 }
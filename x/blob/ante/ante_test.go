@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v2/app"
@@ -8,6 +9,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
 	ante "github.com/celestiaorg/celestia-app/v2/x/blob/ante"
 	blob "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	appns "github.com/celestiaorg/go-square/namespace"
 	"github.com/celestiaorg/go-square/shares"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -23,6 +25,7 @@ func TestPFBAnteHandler(t *testing.T) {
 	testCases := []struct {
 		name        string
 		pfb         *blob.MsgPayForBlobs
+		pricing     blob.BlobGasPricing
 		txGas       uint64
 		gasConsumed uint64
 		wantErr     bool
@@ -85,10 +88,35 @@ func TestPFBAnteHandler(t *testing.T) {
 			gasConsumed: 10000,
 			wantErr:     false,
 		},
+		{
+			name: "valid pfb with discounted namespace",
+			pfb: &blob.MsgPayForBlobs{
+				BlobSizes:  []uint32{uint32(shares.AvailableBytesFromSparseShares(2))},
+				Namespaces: [][]byte{discountedNamespace},
+			},
+			pricing: blob.BlobGasPricing{
+				NamespaceMultipliers: []blob.NamespaceGasMultiplier{{NamespacePrefix: discountedNamespace[:10], Multiplier: sdk.NewDecWithPrec(5, 1)}},
+			},
+			txGas:       appconsts.ShareSize * testGasPerBlobByte,
+			gasConsumed: 0,
+			wantErr:     false,
+		},
+		{
+			name: "pfb with size tier surcharge not enough gas",
+			pfb: &blob.MsgPayForBlobs{
+				BlobSizes: []uint32{uint32(shares.AvailableBytesFromSparseShares(2))},
+			},
+			pricing: blob.BlobGasPricing{
+				SizeTiers: []blob.BlobSizeGasTier{{MinBlobSize: 500, Multiplier: sdk.NewDec(2)}},
+			},
+			txGas:       4*appconsts.ShareSize*testGasPerBlobByte - 1,
+			gasConsumed: 0,
+			wantErr:     true,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			anteHandler := ante.NewMinGasPFBDecorator(mockBlobKeeper{})
+			anteHandler := ante.NewMinGasPFBDecorator(mockBlobKeeper{pricing: tc.pricing})
 			ctx := sdk.Context{}.WithGasMeter(sdk.NewGasMeter(tc.txGas)).WithIsCheckTx(true)
 			ctx.GasMeter().ConsumeGas(tc.gasConsumed, "test")
 			txBuilder := txConfig.NewTxBuilder()
@@ -104,7 +132,11 @@ func TestPFBAnteHandler(t *testing.T) {
 	}
 }
 
-type mockBlobKeeper struct{}
+var discountedNamespace = bytes.Repeat([]byte{1}, appns.NamespaceSize)
+
+type mockBlobKeeper struct {
+	pricing blob.BlobGasPricing
+}
 
 func (mockBlobKeeper) GasPerBlobByte(_ sdk.Context) uint32 {
 	return testGasPerBlobByte
@@ -113,3 +145,7 @@ func (mockBlobKeeper) GasPerBlobByte(_ sdk.Context) uint32 {
 func (mockBlobKeeper) GovMaxSquareSize(_ sdk.Context) uint64 {
 	return testGovMaxSquareSize
 }
+
+func (m mockBlobKeeper) BlobGasPricing(_ sdk.Context) blob.BlobGasPricing {
+	return m.pricing
+}
@@ -20,6 +20,8 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryBurnedBlobFees())
+	cmd.AddCommand(CmdQueryEstimateBlobGas())
 
 	return cmd
 }
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryBurnedBlobFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-blob-fees",
+		Short: "shows the total amount of blob fees burned",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurnedBlobFees(context.Background(), &types.QueryBurnedBlobFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// FlagNamespaces is the flag of the hex encoded namespaces of the blobs of
+// the estimate-blob-gas command.
+const FlagNamespaces = "namespaces"
+
+func CmdQueryEstimateBlobGas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-blob-gas [blob-sizes]",
+		Short: "shows the gas charged for blobs of the given comma separated sizes",
+		Long: `Shows the gas charged for blobs of the given comma separated sizes with the
+blob gas pricing params of the network. The namespaces of the blobs can be given
+as comma separated hex encoded namespaces (version byte and 28 byte ID) with the
+--namespaces flag, one per blob.`,
+		Example: "estimate-blob-gas 1000,200000 --namespaces 0x00...01,0x00...02",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			req := &types.QueryEstimateBlobGasRequest{}
+			for _, arg := range strings.Split(args[0], ",") {
+				size, err := strconv.ParseUint(strings.TrimSpace(arg), 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid blob size %q: %w", arg, err)
+				}
+				req.BlobSizes = append(req.BlobSizes, uint32(size))
+			}
+
+			namespaces, err := cmd.Flags().GetStringSlice(FlagNamespaces)
+			if err != nil {
+				return err
+			}
+			for _, ns := range namespaces {
+				namespace, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(ns), "0x"))
+				if err != nil {
+					return fmt.Errorf("failed to decode hex namespace %q: %w", ns, err)
+				}
+				req.Namespaces = append(req.Namespaces, namespace)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateBlobGas(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagNamespaces, nil, "Comma separated hex encoded namespaces of the blobs")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
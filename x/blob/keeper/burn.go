@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BurnBlobFee burns amount from the fee collector and adds it to the total
+// amount of blob fees burned. payer is the address that paid the fee.
+func (k Keeper) BurnBlobFee(ctx sdk.Context, payer sdk.AccAddress, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, amount); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount); err != nil {
+		return err
+	}
+	k.addBurnedBlobFees(ctx, amount)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBurnBlobFee,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyFeePayer, payer.String()),
+		sdk.NewAttribute(types.AttributeKeyBurnedFee, amount.String()),
+	))
+	return nil
+}
+
+// GetBurnedBlobFees returns the total amount of blob fees burned.
+func (k Keeper) GetBurnedBlobFees(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnedBlobFeesPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	burned := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		burned = burned.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return burned
+}
+
+// SetBurnedBlobFees sets the total amount of blob fees burned.
+func (k Keeper) SetBurnedBlobFees(ctx sdk.Context, burned sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range burned {
+		k.setBurnedBlobFee(store, coin)
+	}
+}
+
+func (k Keeper) addBurnedBlobFees(ctx sdk.Context, amount sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	burned := k.GetBurnedBlobFees(ctx)
+	for _, coin := range amount {
+		k.setBurnedBlobFee(store, sdk.NewCoin(coin.Denom, burned.AmountOf(coin.Denom).Add(coin.Amount)))
+	}
+}
+
+func (k Keeper) setBurnedBlobFee(store sdk.KVStore, coin sdk.Coin) {
+	bz, err := coin.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.BurnedBlobFeesKey(coin.Denom), bz)
+}
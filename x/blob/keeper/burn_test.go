@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blob"
+	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockBankKeeper tracks the balances of module accounts and accounts and the
+// coins burned.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	burned   sdk.Coins
+}
+
+func newMockBankKeeper(feeCollectorBalance sdk.Coins) *mockBankKeeper {
+	return &mockBankKeeper{
+		balances: map[string]sdk.Coins{authtypes.FeeCollectorName: feeCollectorBalance},
+		burned:   sdk.NewCoins(),
+	}
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToModule(_ sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	balance, hasNeg := m.balances[senderModule].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.balances[senderModule] = balance
+	m.balances[recipientModule] = m.balances[recipientModule].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := m.balances[senderModule].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.balances[senderModule] = balance
+	m.balances[recipientAddr.String()] = m.balances[recipientAddr.String()].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(_ sdk.Context, moduleName string, amt sdk.Coins) error {
+	balance, hasNeg := m.balances[moduleName].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.balances[moduleName] = balance
+	m.burned = m.burned.Add(amt...)
+	return nil
+}
+
+func TestBurnBlobFee(t *testing.T) {
+	bankKeeper := newMockBankKeeper(sdk.NewCoins(sdk.NewInt64Coin("utia", 1000)))
+	k, _, ctx := createKeeperWithBankKeeper(t, bankKeeper)
+	payer := sdk.AccAddress("payer")
+
+	require.NoError(t, k.BurnBlobFee(ctx, payer, sdk.NewCoins(sdk.NewInt64Coin("utia", 100))))
+	require.NoError(t, k.BurnBlobFee(ctx, payer, sdk.NewCoins(sdk.NewInt64Coin("utia", 50))))
+	// burning nothing is a no-op
+	require.NoError(t, k.BurnBlobFee(ctx, payer, sdk.NewCoins()))
+
+	want := sdk.NewCoins(sdk.NewInt64Coin("utia", 150))
+	assert.Equal(t, want, bankKeeper.burned)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utia", 850)), bankKeeper.balances[authtypes.FeeCollectorName])
+	assert.True(t, bankKeeper.balances[types.ModuleName].IsZero())
+	assert.Equal(t, want, k.GetBurnedBlobFees(ctx))
+
+	resp, err := k.BurnedBlobFees(sdk.WrapSDKContext(ctx), &types.QueryBurnedBlobFeesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, want, resp.BurnedBlobFees)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	assert.Equal(t, types.EventTypeBurnBlobFee, events[0].Type)
+	assert.Contains(t, events[0].Attributes, sdk.NewAttribute(types.AttributeKeyFeePayer, payer.String()).ToKVPair())
+	assert.Contains(t, events[0].Attributes, sdk.NewAttribute(types.AttributeKeyBurnedFee, "100utia").ToKVPair())
+}
+
+func TestBurnBlobFeeInsufficientFunds(t *testing.T) {
+	bankKeeper := newMockBankKeeper(sdk.NewCoins(sdk.NewInt64Coin("utia", 10)))
+	k, _, ctx := createKeeperWithBankKeeper(t, bankKeeper)
+
+	err := k.BurnBlobFee(ctx, sdk.AccAddress("payer"), sdk.NewCoins(sdk.NewInt64Coin("utia", 100)))
+	require.Error(t, err)
+	assert.True(t, k.GetBurnedBlobFees(ctx).IsZero())
+	assert.Empty(t, ctx.EventManager().Events())
+}
+
+func TestBurnedBlobFeesGenesis(t *testing.T) {
+	k, _, ctx := CreateKeeper(t)
+	genesisState := types.GenesisState{
+		Params:         types.DefaultParams(),
+		BurnedBlobFees: sdk.NewCoins(sdk.NewInt64Coin("utia", 100), sdk.NewInt64Coin("uatom", 7)),
+	}
+	require.NoError(t, genesisState.Validate())
+
+	blob.InitGenesis(ctx, *k, genesisState)
+	got := blob.ExportGenesis(ctx, *k)
+	assert.Equal(t, genesisState.BurnedBlobFees, got.BurnedBlobFees)
+}
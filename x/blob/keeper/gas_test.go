@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
@@ -83,3 +84,54 @@ func TestChangingGasParam(t *testing.T) {
 		)
 	}
 }
+
+func TestPayForBlobGasWithPricing(t *testing.T) {
+	k, stateStore, _ := CreateKeeper(t)
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, nil)
+	params := types.DefaultParams()
+	params.NamespaceGasMultipliers = []types.NamespaceGasMultiplier{
+		{NamespacePrefix: []byte{0, 0, 1}, Multiplier: sdk.NewDecWithPrec(5, 1)},
+	}
+	params.BlobSizeGasTiers = []types.BlobSizeGasTier{
+		{MinBlobSize: 1000, Multiplier: sdk.NewDec(2)},
+	}
+	k.SetParams(ctx, params)
+
+	discounted := append([]byte{0, 0, 1}, make([]byte, 26)...)
+	other := make([]byte, 29)
+	msg := types.MsgPayForBlobs{
+		BlobSizes:  []uint32{100, 1024, 100},
+		Namespaces: [][]byte{discounted, other, other},
+	}
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+	_, err := k.PayForBlobs(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+
+	shareGas := uint64(appconsts.ShareSize * types.DefaultGasPerBlobByte)
+	// the first blob is discounted by half, the second occupies 3 shares in
+	// the 2x size tier and the third is charged the uniform gas.
+	wantBlobGas := shareGas/2 + 2*3*shareGas + shareGas
+	paramLookUpCost := uint64(1060)
+	require.Equal(t, wantBlobGas+paramLookUpCost, ctx.GasMeter().GasConsumed())
+
+	var tierEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBlobGasTier {
+			tierEvents = append(tierEvents, event)
+		}
+	}
+	require.Len(t, tierEvents, 2)
+	wantAttributes := [][3]string{
+		{"0", "2", fmt.Sprint(shareGas/2 + shareGas)},
+		{"1000", "1", fmt.Sprint(2 * 3 * shareGas)},
+	}
+	for i, event := range tierEvents {
+		attributes := map[string]string{}
+		for _, attr := range event.Attributes {
+			attributes[string(attr.Key)] = string(attr.Value)
+		}
+		require.Equal(t, wantAttributes[i][0], attributes[types.AttributeKeyMinBlobSize])
+		require.Equal(t, wantAttributes[i][1], attributes[types.AttributeKeyBlobs])
+		require.Equal(t, wantAttributes[i][2], attributes[types.AttributeKeyBlobGas])
+	}
+}
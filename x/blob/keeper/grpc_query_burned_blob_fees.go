@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) BurnedBlobFees(c context.Context, req *types.QueryBurnedBlobFeesRequest) (*types.QueryBurnedBlobFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBurnedBlobFeesResponse{BurnedBlobFees: k.GetBurnedBlobFees(ctx)}, nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EstimateBlobGas returns the gas that PayForBlobs charges for blobs of the
+// given sizes and namespaces with the current blob gas pricing params.
+func (k Keeper) EstimateBlobGas(c context.Context, req *types.QueryEstimateBlobGasRequest) (*types.QueryEstimateBlobGasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.BlobSizes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no blob sizes")
+	}
+	if len(req.Namespaces) != 0 && len(req.Namespaces) != len(req.BlobSizes) {
+		return nil, status.Errorf(codes.InvalidArgument, "number of namespaces %d doesn't match number of blob sizes %d", len(req.Namespaces), len(req.BlobSizes))
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	charges := types.BlobGasCharges(req.BlobSizes, req.Namespaces, k.GasPerBlobByte(ctx), k.BlobGasPricing(ctx))
+	var blobGas uint64
+	for _, charge := range charges {
+		blobGas += charge.Gas
+	}
+	return &types.QueryEstimateBlobGasResponse{Charges: charges, BlobGas: blobGas}, nil
+}
@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateBlobGasQuery(t *testing.T) {
+	k, _, ctx := CreateKeeper(t)
+	params := types.DefaultParams()
+	params.NamespaceGasMultipliers = []types.NamespaceGasMultiplier{
+		{NamespacePrefix: []byte{0, 0, 1}, Multiplier: sdk.NewDecWithPrec(5, 1)},
+	}
+	k.SetParams(ctx, params)
+	wctx := sdk.WrapSDKContext(ctx)
+	discounted := append([]byte{0, 0, 1}, make([]byte, 26)...)
+
+	_, err := k.EstimateBlobGas(wctx, nil)
+	require.Error(t, err)
+	_, err = k.EstimateBlobGas(wctx, &types.QueryEstimateBlobGasRequest{})
+	require.Error(t, err)
+	_, err = k.EstimateBlobGas(wctx, &types.QueryEstimateBlobGasRequest{BlobSizes: []uint32{1, 2}, Namespaces: [][]byte{discounted}})
+	require.Error(t, err)
+
+	req := &types.QueryEstimateBlobGasRequest{BlobSizes: []uint32{100, 100}, Namespaces: [][]byte{discounted, make([]byte, 29)}}
+	resp, err := k.EstimateBlobGas(wctx, req)
+	require.NoError(t, err)
+	shareGas := uint64(appconsts.ShareSize * types.DefaultGasPerBlobByte)
+	require.Equal(t, shareGas/2+shareGas, resp.BlobGas)
+	require.Equal(t, types.BlobGasCharges(req.BlobSizes, req.Namespaces, params.GasPerBlobByte, params.BlobGasPricing()), resp.Charges)
+
+	// namespaces are optional
+	resp, err = k.EstimateBlobGas(wctx, &types.QueryEstimateBlobGasRequest{BlobSizes: []uint32{100, 100}})
+	require.NoError(t, err)
+	require.Equal(t, 2*shareGas, resp.BlobGas)
+}
@@ -3,9 +3,12 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 
 	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -18,12 +21,16 @@ const (
 // Keeper handles all the state changes for the blob module.
 type Keeper struct {
 	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
 	paramStore paramtypes.Subspace
+	bankKeeper types.BankKeeper
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
 ) *Keeper {
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
@@ -31,7 +38,9 @@ func NewKeeper(
 
 	return &Keeper{
 		cdc:        cdc,
+		storeKey:   storeKey,
 		paramStore: ps,
+		bankKeeper: bankKeeper,
 	}
 }
 
@@ -39,11 +48,19 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-// PayForBlobs consumes gas based on the blob sizes in the MsgPayForBlobs.
+// PayForBlobs consumes gas based on the blob sizes and namespaces in the
+// MsgPayForBlobs and the blob gas pricing params.
 func (k Keeper) PayForBlobs(goCtx context.Context, msg *types.MsgPayForBlobs) (*types.MsgPayForBlobsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	gasToConsume := types.GasToConsume(msg.BlobSizes, k.GasPerBlobByte(ctx))
+	// the pricing params are read without consuming gas so that the gas
+	// consumed by a PFB only depends on the gas charged for its blobs.
+	pricing := k.BlobGasPricing(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+	charges := types.BlobGasCharges(msg.BlobSizes, msg.Namespaces, k.GasPerBlobByte(ctx), pricing)
+	var gasToConsume uint64
+	for _, charge := range charges {
+		gasToConsume += charge.Gas
+	}
 	ctx.GasMeter().ConsumeGas(gasToConsume, payForBlobGasDescriptor)
 
 	err := ctx.EventManager().EmitTypedEvent(
@@ -52,6 +69,41 @@ func (k Keeper) PayForBlobs(goCtx context.Context, msg *types.MsgPayForBlobs) (*
 	if err != nil {
 		return &types.MsgPayForBlobsResponse{}, err
 	}
+	if !pricing.IsEmpty() {
+		emitBlobGasTierEvents(ctx, charges)
+	}
 
 	return &types.MsgPayForBlobsResponse{}, nil
 }
+
+// emitBlobGasTierEvents emits an event with the number of blobs and the gas
+// charged for them per size tier, in increasing order of size tier.
+func emitBlobGasTierEvents(ctx sdk.Context, charges []types.BlobGasCharge) {
+	type tierTotal struct {
+		blobs uint64
+		gas   uint64
+	}
+	totals := make(map[uint32]*tierTotal)
+	tiers := make([]uint32, 0)
+	for _, charge := range charges {
+		total, ok := totals[charge.TierMinBlobSize]
+		if !ok {
+			total = &tierTotal{}
+			totals[charge.TierMinBlobSize] = total
+			tiers = append(tiers, charge.TierMinBlobSize)
+		}
+		total.blobs++
+		total.gas += charge.Gas
+	}
+	sort.Slice(tiers, func(i, j int) bool { return tiers[i] < tiers[j] })
+
+	for _, tier := range tiers {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeBlobGasTier,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyMinBlobSize, strconv.FormatUint(uint64(tier), 10)),
+			sdk.NewAttribute(types.AttributeKeyBlobs, strconv.FormatUint(totals[tier].blobs, 10)),
+			sdk.NewAttribute(types.AttributeKeyBlobGas, strconv.FormatUint(totals[tier].gas, 10)),
+		))
+	}
+}
@@ -73,12 +73,18 @@ func createMsgPayForBlob(t *testing.T, signer string, namespace appns.Namespace,
 }
 
 func CreateKeeper(t *testing.T) (*keeper.Keeper, store.CommitMultiStore, sdk.Context) {
+	return createKeeperWithBankKeeper(t, nil)
+}
+
+func createKeeperWithBankKeeper(t *testing.T, bankKeeper types.BankKeeper) (*keeper.Keeper, store.CommitMultiStore, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
 	tStoreKey := storetypes.NewTransientStoreKey(paramtypes.TStoreKey)
+	blobStoreKey := sdk.NewKVStoreKey(types.StoreKey)
 
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(blobStoreKey, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
@@ -99,7 +105,9 @@ func CreateKeeper(t *testing.T) (*keeper.Keeper, store.CommitMultiStore, sdk.Con
 	)
 	k := keeper.NewKeeper(
 		cdc,
+		blobStoreKey,
 		paramsSubspace,
+		bankKeeper,
 	)
 	k.SetParams(ctx, types.DefaultParams())
 
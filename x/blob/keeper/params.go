@@ -7,15 +7,39 @@ import (
 
 // GetParams gets all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(
+	params := types.NewParams(
 		k.GasPerBlobByte(ctx),
 		k.GovMaxSquareSize(ctx),
 	)
+	params.BlobFeeBurnFraction = k.BlobFeeBurnFraction(ctx)
+	params.GasRefundFraction, params.MaxGasRefund = k.GasRefundParams(ctx)
+	pricing := k.BlobGasPricing(ctx)
+	params.NamespaceGasMultipliers, params.BlobSizeGasTiers = pricing.NamespaceMultipliers, pricing.SizeTiers
+	return params
 }
 
-// SetParams sets the params
+// SetParams sets the params. The blob fee burn fraction, the gas refund params
+// and the blob gas pricing params are only stored if they differ from their
+// defaults or were stored before.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramStore.SetParamSet(ctx, &params)
+	if !params.BlobFeeBurnFraction.IsNil() {
+		k.setOptionalParam(ctx, types.KeyBlobFeeBurnFraction, params.BlobFeeBurnFraction, params.BlobFeeBurnFraction.Equal(types.DefaultBlobFeeBurnFraction))
+	}
+	if !params.GasRefundFraction.IsNil() {
+		k.setOptionalParam(ctx, types.KeyGasRefundFraction, params.GasRefundFraction, params.GasRefundFraction.Equal(types.DefaultGasRefundFraction))
+	}
+	k.setOptionalParam(ctx, types.KeyMaxGasRefund, params.MaxGasRefund, params.MaxGasRefund == types.DefaultMaxGasRefund)
+	k.setOptionalParam(ctx, types.KeyNamespaceGasMultipliers, params.NamespaceGasMultipliers, len(params.NamespaceGasMultipliers) == 0)
+	k.setOptionalParam(ctx, types.KeyBlobSizeGasTiers, params.BlobSizeGasTiers, len(params.BlobSizeGasTiers) == 0)
+}
+
+// setOptionalParam stores a param that isn't part of the param set pairs
+// unless it is the default and was never stored.
+func (k Keeper) setOptionalParam(ctx sdk.Context, key []byte, value interface{}, isDefault bool) {
+	if !isDefault || k.paramStore.Has(ctx, key) {
+		k.paramStore.Set(ctx, key, value)
+	}
 }
 
 // GasPerBlobByte returns the GasPerBlobByte param
@@ -29,3 +53,36 @@ func (k Keeper) GovMaxSquareSize(ctx sdk.Context) (res uint64) {
 	k.paramStore.Get(ctx, types.KeyGovMaxSquareSize, &res)
 	return res
 }
+
+// BlobFeeBurnFraction returns the BlobFeeBurnFraction param. It is zero if it
+// was never set.
+func (k Keeper) BlobFeeBurnFraction(ctx sdk.Context) sdk.Dec {
+	res := types.DefaultBlobFeeBurnFraction
+	k.paramStore.GetIfExists(ctx, types.KeyBlobFeeBurnFraction, &res)
+	return res
+}
+
+// GasRefundParams returns the GasRefundFraction and MaxGasRefund params. They
+// are the defaults if they were never set.
+func (k Keeper) GasRefundParams(ctx sdk.Context) (fraction sdk.Dec, maxRefund uint64) {
+	fraction, maxRefund = types.DefaultGasRefundFraction, types.DefaultMaxGasRefund
+	k.paramStore.GetIfExists(ctx, types.KeyGasRefundFraction, &fraction)
+	k.paramStore.GetIfExists(ctx, types.KeyMaxGasRefund, &maxRefund)
+	return fraction, maxRefund
+}
+
+// BlobGasPricing returns the NamespaceGasMultipliers and BlobSizeGasTiers
+// params. They are empty if they were never set.
+func (k Keeper) BlobGasPricing(ctx sdk.Context) types.BlobGasPricing {
+	var pricing types.BlobGasPricing
+	k.paramStore.GetIfExists(ctx, types.KeyNamespaceGasMultipliers, &pricing.NamespaceMultipliers)
+	k.paramStore.GetIfExists(ctx, types.KeyBlobSizeGasTiers, &pricing.SizeTiers)
+	// params that were reset to empty are decoded as empty slices
+	if len(pricing.NamespaceMultipliers) == 0 {
+		pricing.NamespaceMultipliers = nil
+	}
+	if len(pricing.SizeTiers) == 0 {
+		pricing.SizeTiers = nil
+	}
+	return pricing
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -16,3 +17,58 @@ func TestGetParams(t *testing.T) {
 	require.EqualValues(t, params, k.GetParams(ctx))
 	require.EqualValues(t, params.GasPerBlobByte, k.GasPerBlobByte(ctx))
 }
+
+func TestSetBlobFeeBurnFraction(t *testing.T) {
+	k, _, ctx := CreateKeeper(t)
+	require.True(t, k.BlobFeeBurnFraction(ctx).IsZero())
+
+	params := types.DefaultParams()
+	params.BlobFeeBurnFraction = sdk.NewDecWithPrec(25, 2)
+	k.SetParams(ctx, params)
+	require.Equal(t, params, k.GetParams(ctx))
+
+	// a fraction that was set can be reset to zero
+	params.BlobFeeBurnFraction = sdk.ZeroDec()
+	k.SetParams(ctx, params)
+	require.True(t, k.BlobFeeBurnFraction(ctx).IsZero())
+}
+
+func TestSetGasRefundParams(t *testing.T) {
+	k, _, ctx := CreateKeeper(t)
+	fraction, maxRefund := k.GasRefundParams(ctx)
+	require.True(t, fraction.IsZero())
+	require.Equal(t, types.DefaultMaxGasRefund, maxRefund)
+
+	params := types.DefaultParams()
+	params.GasRefundFraction = sdk.NewDecWithPrec(5, 1)
+	params.MaxGasRefund = 1
+	k.SetParams(ctx, params)
+	require.Equal(t, params, k.GetParams(ctx))
+
+	// params that were set can be reset to their defaults
+	params = types.DefaultParams()
+	k.SetParams(ctx, params)
+	require.Equal(t, params, k.GetParams(ctx))
+}
+
+func TestSetBlobGasPricing(t *testing.T) {
+	k, _, ctx := CreateKeeper(t)
+	require.True(t, k.BlobGasPricing(ctx).IsEmpty())
+
+	params := types.DefaultParams()
+	params.NamespaceGasMultipliers = []types.NamespaceGasMultiplier{
+		{NamespacePrefix: []byte{0, 0, 1}, Multiplier: sdk.NewDecWithPrec(5, 1)},
+	}
+	params.BlobSizeGasTiers = []types.BlobSizeGasTier{
+		{MinBlobSize: 1_000_000, Multiplier: sdk.NewDecWithPrec(15, 1)},
+	}
+	k.SetParams(ctx, params)
+	require.Equal(t, params, k.GetParams(ctx))
+	require.Equal(t, params.BlobGasPricing(), k.BlobGasPricing(ctx))
+
+	// a pricing table that was set can be reset to empty
+	params = types.DefaultParams()
+	k.SetParams(ctx, params)
+	require.Equal(t, params, k.GetParams(ctx))
+	require.True(t, k.BlobGasPricing(ctx).IsEmpty())
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RefundGas refunds amount from the fee collector to payer, which is the
+// address that paid the fee for a tx that left gasUnused of its gas limit
+// unused.
+func (k Keeper) RefundGas(ctx sdk.Context, payer sdk.AccAddress, gasUnused uint64, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, payer, amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRefundGas,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyFeePayer, payer.String()),
+		sdk.NewAttribute(types.AttributeKeyGasUnused, strconv.FormatUint(gasUnused, 10)),
+		sdk.NewAttribute(types.AttributeKeyRefundedFee, amount.String()),
+	))
+	return nil
+}
@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefundGas(t *testing.T) {
+	bankKeeper := newMockBankKeeper(sdk.NewCoins(sdk.NewInt64Coin("utia", 1000)))
+	k, _, ctx := createKeeperWithBankKeeper(t, bankKeeper)
+	payer := sdk.AccAddress("payer")
+
+	require.NoError(t, k.RefundGas(ctx, payer, 500, sdk.NewCoins(sdk.NewInt64Coin("utia", 100))))
+	// refunding nothing is a no-op
+	require.NoError(t, k.RefundGas(ctx, payer, 0, sdk.NewCoins()))
+
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utia", 900)), bankKeeper.balances[authtypes.FeeCollectorName])
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utia", 100)), bankKeeper.balances[payer.String()])
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, types.EventTypeRefundGas, events[0].Type)
+	assert.Contains(t, events[0].Attributes, sdk.NewAttribute(types.AttributeKeyFeePayer, payer.String()).ToKVPair())
+	assert.Contains(t, events[0].Attributes, sdk.NewAttribute(types.AttributeKeyGasUnused, "500").ToKVPair())
+	assert.Contains(t, events[0].Attributes, sdk.NewAttribute(types.AttributeKeyRefundedFee, "100utia").ToKVPair())
+}
+
+func TestRefundGasInsufficientFunds(t *testing.T) {
+	bankKeeper := newMockBankKeeper(sdk.NewCoins(sdk.NewInt64Coin("utia", 10)))
+	k, _, ctx := createKeeperWithBankKeeper(t, bankKeeper)
+
+	err := k.RefundGas(ctx, sdk.AccAddress("payer"), 500, sdk.NewCoins(sdk.NewInt64Coin("utia", 100)))
+	require.Error(t, err)
+	assert.Empty(t, ctx.EventManager().Events())
+}
@@ -6,6 +6,19 @@ import (
 
 var EventTypePayForBlob = proto.MessageName(&EventPayForBlobs{})
 
+const (
+	EventTypeBurnBlobFee    = "burn_blob_fee"
+	EventTypeRefundGas      = "refund_gas"
+	EventTypeBlobGasTier    = "blob_gas_tier"
+	AttributeKeyBurnedFee   = "burned_fee"
+	AttributeKeyRefundedFee = "refunded_fee"
+	AttributeKeyFeePayer    = "fee_payer"
+	AttributeKeyGasUnused   = "gas_unused"
+	AttributeKeyMinBlobSize = "min_blob_size"
+	AttributeKeyBlobs       = "blobs"
+	AttributeKeyBlobGas     = "blob_gas"
+)
+
 // NewPayForBlobsEvent returns a new EventPayForBlobs
 func NewPayForBlobsEvent(signer string, blobSizes []uint32, namespaces [][]byte) *EventPayForBlobs {
 	return &EventPayForBlobs{
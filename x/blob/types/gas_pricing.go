@@ -0,0 +1,95 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	appshares "github.com/celestiaorg/go-square/shares"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxGasMultiplier is the maximum namespace or size tier multiplier of the gas
+// charged for a blob.
+var MaxGasMultiplier = sdk.NewDec(100)
+
+// BlobGasPricing is the optional pricing table of the gas charged for blobs.
+// The gas charged for a blob is the gas for its shares multiplied by the
+// multiplier of its namespace and the multiplier of its size tier. The
+// multipliers are one if there is no matching namespace prefix or size tier.
+type BlobGasPricing struct {
+	NamespaceMultipliers []NamespaceGasMultiplier
+	SizeTiers            []BlobSizeGasTier
+}
+
+// IsEmpty returns true if the pricing charges every blob the uniform gas per
+// blob byte.
+func (p BlobGasPricing) IsEmpty() bool {
+	return len(p.NamespaceMultipliers) == 0 && len(p.SizeTiers) == 0
+}
+
+// namespaceMultiplier returns the multiplier of the longest namespace prefix
+// that namespace starts with.
+func (p BlobGasPricing) namespaceMultiplier(namespace []byte) sdk.Dec {
+	multiplier, prefixLen := sdk.OneDec(), 0
+	for _, m := range p.NamespaceMultipliers {
+		if len(m.NamespacePrefix) > prefixLen && bytes.HasPrefix(namespace, m.NamespacePrefix) {
+			multiplier, prefixLen = m.Multiplier, len(m.NamespacePrefix)
+		}
+	}
+	return multiplier
+}
+
+// sizeTier returns the min blob size and the multiplier of the size tier of a
+// blob of the given size. The size tiers are in increasing order of size.
+func (p BlobGasPricing) sizeTier(blobSize uint32) (uint32, sdk.Dec) {
+	minBlobSize, multiplier := uint32(0), sdk.OneDec()
+	for _, tier := range p.SizeTiers {
+		if blobSize < tier.MinBlobSize {
+			break
+		}
+		minBlobSize, multiplier = tier.MinBlobSize, tier.Multiplier
+	}
+	return minBlobSize, multiplier
+}
+
+// BlobGasCharges returns the gas charged for each blob of a PFB. The
+// namespaces are optional: a blob without a namespace only gets the multiplier
+// of its size tier. The MinGasPFBDecorator, the PayForBlobs handler, the gas
+// estimation and the EstimateBlobGas query all price blobs with this function.
+func BlobGasCharges(blobSizes []uint32, namespaces [][]byte, gasPerByte uint32, pricing BlobGasPricing) []BlobGasCharge {
+	charges := make([]BlobGasCharge, len(blobSizes))
+	for i, size := range blobSizes {
+		var namespace []byte
+		if i < len(namespaces) {
+			namespace = namespaces[i]
+		}
+		gas := uint64(appshares.SparseSharesNeeded(size)) * appconsts.ShareSize * uint64(gasPerByte)
+		tierMinBlobSize, multiplier := uint32(0), sdk.OneDec()
+		if !pricing.IsEmpty() {
+			var tierMultiplier sdk.Dec
+			tierMinBlobSize, tierMultiplier = pricing.sizeTier(size)
+			multiplier = pricing.namespaceMultiplier(namespace).Mul(tierMultiplier)
+			// the gas is rounded up so that a multiplier never makes a
+			// non-empty blob free.
+			gas = multiplier.MulInt64(int64(gas)).Ceil().TruncateInt().Uint64()
+		}
+		charges[i] = BlobGasCharge{
+			BlobSize:        size,
+			Namespace:       namespace,
+			TierMinBlobSize: tierMinBlobSize,
+			Multiplier:      multiplier,
+			Gas:             gas,
+		}
+	}
+	return charges
+}
+
+// BlobGas returns the total gas charged for the blobs of a PFB. See
+// BlobGasCharges.
+func BlobGas(blobSizes []uint32, namespaces [][]byte, gasPerByte uint32, pricing BlobGasPricing) uint64 {
+	var gas uint64
+	for _, charge := range BlobGasCharges(blobSizes, namespaces, gasPerByte, pricing) {
+		gas += charge.Gas
+	}
+	return gas
+}
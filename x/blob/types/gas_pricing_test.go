@@ -0,0 +1,135 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobGasCharges(t *testing.T) {
+	gasPerByte := uint32(appconsts.DefaultGasPerBlobByte)
+	shareGas := uint64(appconsts.ShareSize) * uint64(gasPerByte)
+	namespace := func(prefix ...byte) []byte {
+		return append(prefix, make([]byte, 29-len(prefix))...)
+	}
+	pricing := blobtypes.BlobGasPricing{
+		NamespaceMultipliers: []blobtypes.NamespaceGasMultiplier{
+			{NamespacePrefix: []byte{0, 0, 1}, Multiplier: sdk.NewDecWithPrec(5, 1)},
+			{NamespacePrefix: []byte{0, 0, 1, 2}, Multiplier: sdk.NewDecWithPrec(25, 2)},
+			{NamespacePrefix: []byte{0, 0, 3}, Multiplier: sdk.NewDecWithPrec(1, 9)},
+		},
+		SizeTiers: []blobtypes.BlobSizeGasTier{
+			{MinBlobSize: 1000, Multiplier: sdk.NewDecWithPrec(15, 1)},
+			{MinBlobSize: 10_000, Multiplier: sdk.NewDec(3)},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		blobSize       uint32
+		namespace      []byte
+		pricing        blobtypes.BlobGasPricing
+		wantTier       uint32
+		wantMultiplier sdk.Dec
+		wantGas        uint64
+	}{
+		{
+			name:           "no pricing",
+			blobSize:       100,
+			namespace:      namespace(0, 0, 1),
+			wantMultiplier: sdk.OneDec(),
+			wantGas:        shareGas,
+		},
+		{
+			name:           "no matching prefix or tier",
+			blobSize:       100,
+			namespace:      namespace(0, 0, 2),
+			pricing:        pricing,
+			wantMultiplier: sdk.OneDec(),
+			wantGas:        shareGas,
+		},
+		{
+			name:           "no namespace",
+			blobSize:       100,
+			pricing:        pricing,
+			wantMultiplier: sdk.OneDec(),
+			wantGas:        shareGas,
+		},
+		{
+			name:           "namespace discount",
+			blobSize:       100,
+			namespace:      namespace(0, 0, 1),
+			pricing:        pricing,
+			wantMultiplier: sdk.NewDecWithPrec(5, 1),
+			wantGas:        shareGas / 2,
+		},
+		{
+			name:           "longest matching prefix",
+			blobSize:       100,
+			namespace:      namespace(0, 0, 1, 2),
+			pricing:        pricing,
+			wantMultiplier: sdk.NewDecWithPrec(25, 2),
+			wantGas:        shareGas / 4,
+		},
+		{
+			name:           "gas is rounded up",
+			blobSize:       100,
+			namespace:      namespace(0, 0, 3),
+			pricing:        pricing,
+			wantMultiplier: sdk.NewDecWithPrec(1, 9),
+			wantGas:        1,
+		},
+		{
+			name:           "size tier at its min blob size",
+			blobSize:       1000,
+			namespace:      namespace(0, 0, 2),
+			pricing:        pricing,
+			wantTier:       1000,
+			wantMultiplier: sdk.NewDecWithPrec(15, 1),
+			wantGas:        3 * shareGas * 3 / 2,
+		},
+		{
+			name:           "namespace and size tier",
+			blobSize:       10_000,
+			namespace:      namespace(0, 0, 1),
+			pricing:        pricing,
+			wantTier:       10_000,
+			wantMultiplier: sdk.NewDecWithPrec(15, 1),
+			wantGas:        21 * shareGas * 3 / 2,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			charges := blobtypes.BlobGasCharges([]uint32{tc.blobSize}, [][]byte{tc.namespace}, gasPerByte, tc.pricing)
+			require.Len(t, charges, 1)
+			require.Equal(t, tc.blobSize, charges[0].BlobSize)
+			require.Equal(t, tc.wantTier, charges[0].TierMinBlobSize)
+			require.True(t, tc.wantMultiplier.Equal(charges[0].Multiplier), charges[0].Multiplier)
+			require.Equal(t, tc.wantGas, charges[0].Gas)
+		})
+	}
+}
+
+func TestEstimateGasWithPricing(t *testing.T) {
+	blobSizes := []uint32{100, 2000, 50_000}
+	gasPerByte := uint32(appconsts.DefaultGasPerBlobByte)
+
+	// without a pricing table the estimate is the uniform estimate
+	require.Equal(t,
+		blobtypes.EstimateGas(blobSizes, gasPerByte, auth.DefaultTxSizeCostPerByte),
+		blobtypes.EstimateGasWithPricing(blobSizes, nil, gasPerByte, blobtypes.BlobGasPricing{}, auth.DefaultTxSizeCostPerByte),
+	)
+
+	pricing := blobtypes.BlobGasPricing{
+		SizeTiers: []blobtypes.BlobSizeGasTier{{MinBlobSize: 1000, Multiplier: sdk.NewDec(2)}},
+	}
+	uniform := blobtypes.EstimateGas(blobSizes, gasPerByte, auth.DefaultTxSizeCostPerByte)
+	priced := blobtypes.EstimateGasWithPricing(blobSizes, nil, gasPerByte, pricing, auth.DefaultTxSizeCostPerByte)
+	tieredGas := blobtypes.GasToConsume(blobSizes[1:], gasPerByte)
+	require.Equal(t, uniform+tieredGas, priced)
+	require.Equal(t, blobtypes.BlobGas(blobSizes, nil, gasPerByte, pricing), blobtypes.GasToConsume(blobSizes, gasPerByte)+tieredGas)
+}
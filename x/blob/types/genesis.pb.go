@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -26,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the capability module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// burned_blob_fees is the total amount of blob fees burned.
+	BurnedBlobFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=burned_blob_fees,json=burnedBlobFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_blob_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +72,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetBurnedBlobFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedBlobFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "celestia.blob.v1.GenesisState")
 }
@@ -75,20 +86,26 @@ func init() {
 func init() { proto.RegisterFile("celestia/blob/v1/genesis.proto", fileDescriptor_c0b3a6e29bb6777c) }
 
 var fileDescriptor_c0b3a6e29bb6777c = []byte{
-	// 198 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xeb, 0x81,
-	0xe4, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44,
-	0x9d, 0x94, 0x2c, 0x86, 0x39, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x63, 0x94, 0xdc, 0xb8, 0x78,
-	0xdc, 0x21, 0xe6, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x99, 0x71, 0xb1, 0x41, 0xe4, 0x25, 0x18,
-	0x15, 0x18, 0x35, 0xb8, 0x8d, 0x24, 0xf4, 0xd0, 0xed, 0xd1, 0x0b, 0x00, 0xcb, 0x3b, 0xb1, 0x9c,
-	0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55, 0xed, 0xe4, 0x75, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0x06, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x30,
-	0xb3, 0xf2, 0x8b, 0xd2, 0xe1, 0x6c, 0xdd, 0xc4, 0x82, 0x02, 0xfd, 0x0a, 0x88, 0xeb, 0x4a, 0x2a,
-	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x4e, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x63,
-	0x14, 0xe2, 0x03, 0x01, 0x00, 0x00,
+	// 298 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x41, 0x4a, 0xc3, 0x40,
+	0x14, 0x86, 0x33, 0x2a, 0x5d, 0xa4, 0x22, 0xa5, 0xb8, 0x88, 0x01, 0xa7, 0xc5, 0x55, 0x36, 0x9d,
+	0x69, 0x2a, 0x78, 0x80, 0x08, 0x0a, 0xae, 0xa4, 0xee, 0xdc, 0x94, 0x99, 0xf4, 0x19, 0x83, 0x49,
+	0x5e, 0xc8, 0x4c, 0x82, 0xde, 0xc2, 0x73, 0x78, 0x07, 0xf7, 0x5d, 0x76, 0xe9, 0x4a, 0x25, 0xb9,
+	0x88, 0x24, 0x13, 0x45, 0x74, 0x35, 0x0f, 0xfe, 0x7f, 0xfe, 0xff, 0x7b, 0xcf, 0xa6, 0x21, 0x24,
+	0xa0, 0x74, 0x2c, 0xb8, 0x4c, 0x50, 0xf2, 0xca, 0xe7, 0x11, 0x64, 0xa0, 0x62, 0xc5, 0xf2, 0x02,
+	0x35, 0x8e, 0x47, 0xdf, 0x3a, 0x6b, 0x75, 0x56, 0xf9, 0xee, 0x61, 0x84, 0x11, 0x76, 0x22, 0x6f,
+	0x27, 0xe3, 0x73, 0x8f, 0xff, 0xe5, 0xe4, 0xa2, 0x10, 0x69, 0x1f, 0xe3, 0xd2, 0x10, 0x55, 0x8a,
+	0x8a, 0x4b, 0xa1, 0x80, 0x57, 0xbe, 0x04, 0x2d, 0x7c, 0x1e, 0x62, 0x9c, 0x19, 0xfd, 0xe4, 0x95,
+	0xd8, 0xfb, 0x97, 0xa6, 0xf8, 0x46, 0x0b, 0x0d, 0xe3, 0x33, 0x7b, 0x60, 0x02, 0x1c, 0x32, 0x25,
+	0xde, 0x70, 0xe1, 0xb0, 0xbf, 0x20, 0xec, 0xba, 0xd3, 0x83, 0xbd, 0xcd, 0xfb, 0xc4, 0x5a, 0xf6,
+	0xee, 0x71, 0x69, 0x8f, 0x64, 0x59, 0x64, 0xb0, 0x5e, 0xb5, 0xb6, 0xd5, 0x1d, 0x80, 0x72, 0x76,
+	0xa6, 0xbb, 0xde, 0x70, 0x71, 0xc4, 0x0c, 0x03, 0x6b, 0x19, 0x58, 0xcf, 0xc0, 0xce, 0x31, 0xce,
+	0x82, 0x79, 0x1b, 0xf1, 0xf2, 0x31, 0xf1, 0xa2, 0x58, 0xdf, 0x97, 0x92, 0x85, 0x98, 0xf2, 0x1e,
+	0xd8, 0x3c, 0x33, 0xb5, 0x7e, 0xe0, 0xfa, 0x29, 0x07, 0xd5, 0x7d, 0x50, 0xcb, 0x03, 0x53, 0x12,
+	0x24, 0x28, 0x2f, 0x00, 0x54, 0x70, 0xb5, 0xa9, 0x29, 0xd9, 0xd6, 0x94, 0x7c, 0xd6, 0x94, 0x3c,
+	0x37, 0xd4, 0xda, 0x36, 0xd4, 0x7a, 0x6b, 0xa8, 0x75, 0x3b, 0xff, 0x9d, 0xd9, 0xaf, 0x80, 0x45,
+	0xf4, 0x33, 0xcf, 0x44, 0x9e, 0xf3, 0x47, 0x73, 0xb5, 0xae, 0x41, 0x0e, 0xba, 0x93, 0x9c, 0x7e,
+	0x0d, 0x00, 0x01, 0x87, 0x53, 0xee, 0x9b, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +128,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BurnedBlobFees) > 0 {
+		for iNdEx := len(m.BurnedBlobFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedBlobFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -143,6 +174,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BurnedBlobFees) > 0 {
+		for _, e := range m.BurnedBlobFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -214,6 +251,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedBlobFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedBlobFees = append(m.BurnedBlobFees, types.Coin{})
+			if err := m.BurnedBlobFees[len(m.BurnedBlobFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
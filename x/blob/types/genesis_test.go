@@ -5,6 +5,7 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -39,6 +40,25 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "invalid genesis state because BlobFeeBurnFraction",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					GasPerBlobByte:      20,
+					GovMaxSquareSize:    uint64(appconsts.DefaultSquareSizeUpperBound),
+					BlobFeeBurnFraction: sdk.NewDec(2),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid genesis state because BurnedBlobFees",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				BurnedBlobFees: sdk.Coins{sdk.Coin{Denom: "utia", Amount: sdk.NewInt(-1)}},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
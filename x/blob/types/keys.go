@@ -17,6 +17,16 @@ const (
 	MemStoreKey = "mem_blob"
 )
 
+// BurnedBlobFeesPrefix is the prefix of the keys that store the total amount
+// of blob fees burned for each denom.
+var BurnedBlobFeesPrefix = []byte{0x01}
+
+// BurnedBlobFeesKey returns the key that stores the total amount of blob fees
+// burned in denom.
+func BurnedBlobFeesKey(denom string) []byte {
+	return append(append([]byte{}, BurnedBlobFeesPrefix...), denom...)
+}
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	appns "github.com/celestiaorg/go-square/namespace"
 	"github.com/celestiaorg/go-square/shares"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
@@ -12,22 +14,41 @@ import (
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 var (
-	KeyGasPerBlobByte              = []byte("GasPerBlobByte")
-	DefaultGasPerBlobByte   uint32 = appconsts.DefaultGasPerBlobByte
-	KeyGovMaxSquareSize            = []byte("GovMaxSquareSize")
-	DefaultGovMaxSquareSize uint64 = appconsts.DefaultGovMaxSquareSize
+	KeyGasPerBlobByte                 = []byte("GasPerBlobByte")
+	DefaultGasPerBlobByte      uint32 = appconsts.DefaultGasPerBlobByte
+	KeyGovMaxSquareSize               = []byte("GovMaxSquareSize")
+	DefaultGovMaxSquareSize    uint64 = appconsts.DefaultGovMaxSquareSize
+	KeyBlobFeeBurnFraction            = []byte("BlobFeeBurnFraction")
+	DefaultBlobFeeBurnFraction        = sdk.ZeroDec()
+	KeyGasRefundFraction              = []byte("GasRefundFraction")
+	DefaultGasRefundFraction          = sdk.ZeroDec()
+	KeyMaxGasRefund                   = []byte("MaxGasRefund")
+	DefaultMaxGasRefund        uint64 = 100_000 // utia
+	KeyNamespaceGasMultipliers        = []byte("NamespaceGasMultipliers")
+	KeyBlobSizeGasTiers               = []byte("BlobSizeGasTiers")
 )
 
-// ParamKeyTable returns the param key table for the blob module
+// ParamKeyTable returns the param key table for the blob module. The blob
+// fee burn fraction, the gas refund params and the blob gas pricing params are
+// registered separately because they aren't part of the param set pairs.
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+	return paramtypes.NewKeyTable().
+		RegisterParamSet(&Params{}).
+		RegisterType(paramtypes.NewParamSetPair(KeyBlobFeeBurnFraction, sdk.Dec{}, validateBlobFeeBurnFraction)).
+		RegisterType(paramtypes.NewParamSetPair(KeyGasRefundFraction, sdk.Dec{}, validateGasRefundFraction)).
+		RegisterType(paramtypes.NewParamSetPair(KeyMaxGasRefund, uint64(0), validateMaxGasRefund)).
+		RegisterType(paramtypes.NewParamSetPair(KeyNamespaceGasMultipliers, []NamespaceGasMultiplier{}, validateNamespaceGasMultipliers)).
+		RegisterType(paramtypes.NewParamSetPair(KeyBlobSizeGasTiers, []BlobSizeGasTier{}, validateBlobSizeGasTiers))
 }
 
 // NewParams creates a new Params instance
 func NewParams(gasPerBlobByte uint32, govMaxSquareSize uint64) Params {
 	return Params{
-		GasPerBlobByte:   gasPerBlobByte,
-		GovMaxSquareSize: govMaxSquareSize,
+		GasPerBlobByte:      gasPerBlobByte,
+		GovMaxSquareSize:    govMaxSquareSize,
+		BlobFeeBurnFraction: DefaultBlobFeeBurnFraction,
+		GasRefundFraction:   DefaultGasRefundFraction,
+		MaxGasRefund:        DefaultMaxGasRefund,
 	}
 }
 
@@ -36,7 +57,10 @@ func DefaultParams() Params {
 	return NewParams(DefaultGasPerBlobByte, appconsts.DefaultGovMaxSquareSize)
 }
 
-// ParamSetPairs gets the list of param key-value pairs
+// ParamSetPairs gets the list of param key-value pairs. It doesn't include the
+// blob fee burn fraction, the gas refund params and the blob gas pricing
+// params, which are only stored once they differ from their defaults, so that
+// setting the params of a chain that never uses them doesn't change its state.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyGasPerBlobByte, &p.GasPerBlobByte, validateGasPerBlobByte),
@@ -50,7 +74,42 @@ func (p Params) Validate() error {
 	if err != nil {
 		return err
 	}
-	return validateGovMaxSquareSize(p.GovMaxSquareSize)
+	err = validateGovMaxSquareSize(p.GovMaxSquareSize)
+	if err != nil {
+		return err
+	}
+	// the blob fee burn fraction and the gas refund fraction are missing from
+	// params created before they were introduced, which is equivalent to not
+	// burning blob fees and not refunding gas.
+	if !p.BlobFeeBurnFraction.IsNil() {
+		err = validateBlobFeeBurnFraction(p.BlobFeeBurnFraction)
+		if err != nil {
+			return err
+		}
+	}
+	if !p.GasRefundFraction.IsNil() {
+		err = validateGasRefundFraction(p.GasRefundFraction)
+		if err != nil {
+			return err
+		}
+	}
+	err = validateMaxGasRefund(p.MaxGasRefund)
+	if err != nil {
+		return err
+	}
+	err = validateNamespaceGasMultipliers(p.NamespaceGasMultipliers)
+	if err != nil {
+		return err
+	}
+	return validateBlobSizeGasTiers(p.BlobSizeGasTiers)
+}
+
+// BlobGasPricing returns the blob gas pricing table of the params.
+func (p Params) BlobGasPricing() BlobGasPricing {
+	return BlobGasPricing{
+		NamespaceMultipliers: p.NamespaceGasMultipliers,
+		SizeTiers:            p.BlobSizeGasTiers,
+	}
 }
 
 // String implements the Stringer interface.
@@ -93,3 +152,91 @@ func validateGovMaxSquareSize(v interface{}) error {
 
 	return nil
 }
+
+// validateBlobFeeBurnFraction validates the BlobFeeBurnFraction param
+func validateBlobFeeBurnFraction(v interface{}) error {
+	fraction, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if fraction.IsNil() || fraction.IsNegative() || fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("blob fee burn fraction must be between 0 and 1: %v", fraction)
+	}
+
+	return nil
+}
+
+// validateGasRefundFraction validates the GasRefundFraction param
+func validateGasRefundFraction(v interface{}) error {
+	fraction, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if fraction.IsNil() || fraction.IsNegative() || fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("gas refund fraction must be between 0 and 1: %v", fraction)
+	}
+
+	return nil
+}
+
+// validateMaxGasRefund validates the MaxGasRefund param
+func validateMaxGasRefund(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateNamespaceGasMultipliers validates the NamespaceGasMultipliers param
+func validateNamespaceGasMultipliers(v interface{}) error {
+	multipliers, ok := v.([]NamespaceGasMultiplier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	prefixes := make(map[string]struct{}, len(multipliers))
+	for _, m := range multipliers {
+		if len(m.NamespacePrefix) == 0 || len(m.NamespacePrefix) > appns.NamespaceSize {
+			return fmt.Errorf("namespace prefix must be between 1 and %d bytes: %X", appns.NamespaceSize, m.NamespacePrefix)
+		}
+		if _, ok := prefixes[string(m.NamespacePrefix)]; ok {
+			return fmt.Errorf("duplicate namespace prefix: %X", m.NamespacePrefix)
+		}
+		prefixes[string(m.NamespacePrefix)] = struct{}{}
+		if err := validateGasMultiplier(m.Multiplier); err != nil {
+			return fmt.Errorf("namespace prefix %X: %w", m.NamespacePrefix, err)
+		}
+	}
+
+	return nil
+}
+
+// validateBlobSizeGasTiers validates the BlobSizeGasTiers param
+func validateBlobSizeGasTiers(v interface{}) error {
+	tiers, ok := v.([]BlobSizeGasTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	for i, tier := range tiers {
+		if i > 0 && tier.MinBlobSize <= tiers[i-1].MinBlobSize {
+			return fmt.Errorf("blob size gas tiers must be in strictly increasing order of min blob size: %d after %d", tier.MinBlobSize, tiers[i-1].MinBlobSize)
+		}
+		if err := validateGasMultiplier(tier.Multiplier); err != nil {
+			return fmt.Errorf("blob size gas tier %d: %w", tier.MinBlobSize, err)
+		}
+	}
+
+	return nil
+}
+
+func validateGasMultiplier(multiplier sdk.Dec) error {
+	if multiplier.IsNil() || !multiplier.IsPositive() || multiplier.GT(MaxGasMultiplier) {
+		return fmt.Errorf("gas multiplier must be positive and at most %v: %v", MaxGasMultiplier, multiplier)
+	}
+	return nil
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -27,6 +29,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	GasPerBlobByte   uint32 `protobuf:"varint,1,opt,name=gas_per_blob_byte,json=gasPerBlobByte,proto3" json:"gas_per_blob_byte,omitempty" yaml:"gas_per_blob_byte"`
 	GovMaxSquareSize uint64 `protobuf:"varint,2,opt,name=gov_max_square_size,json=govMaxSquareSize,proto3" json:"gov_max_square_size,omitempty" yaml:"gov_max_square_size"`
+	// blob_fee_burn_fraction is the fraction of the fee paid for the blob bytes
+	// of a PFB that is burned instead of going to the fee collector.
+	BlobFeeBurnFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=blob_fee_burn_fraction,json=blobFeeBurnFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"blob_fee_burn_fraction" yaml:"blob_fee_burn_fraction"`
+	// gas_refund_fraction is the fraction of the fee paid for the unused gas of
+	// a PFB that is refunded to the fee payer or fee granter.
+	GasRefundFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=gas_refund_fraction,json=gasRefundFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gas_refund_fraction" yaml:"gas_refund_fraction"`
+	// max_gas_refund is the maximum amount of utia refunded for a PFB.
+	MaxGasRefund uint64 `protobuf:"varint,5,opt,name=max_gas_refund,json=maxGasRefund,proto3" json:"max_gas_refund,omitempty" yaml:"max_gas_refund"`
+	// namespace_gas_multipliers are the multipliers of the gas charged for the
+	// blobs whose namespace starts with a prefix. The longest matching prefix
+	// applies.
+	NamespaceGasMultipliers []NamespaceGasMultiplier `protobuf:"bytes,6,rep,name=namespace_gas_multipliers,json=namespaceGasMultipliers,proto3" json:"namespace_gas_multipliers" yaml:"namespace_gas_multipliers"`
+	// blob_size_gas_tiers are the multipliers of the gas charged for the blobs
+	// of at least a size, in increasing order of size. The tier with the largest
+	// min blob size that a blob reaches applies.
+	BlobSizeGasTiers []BlobSizeGasTier `protobuf:"bytes,7,rep,name=blob_size_gas_tiers,json=blobSizeGasTiers,proto3" json:"blob_size_gas_tiers" yaml:"blob_size_gas_tiers"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -75,31 +93,174 @@ func (m *Params) GetGovMaxSquareSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxGasRefund() uint64 {
+	if m != nil {
+		return m.MaxGasRefund
+	}
+	return 0
+}
+
+func (m *Params) GetNamespaceGasMultipliers() []NamespaceGasMultiplier {
+	if m != nil {
+		return m.NamespaceGasMultipliers
+	}
+	return nil
+}
+
+func (m *Params) GetBlobSizeGasTiers() []BlobSizeGasTier {
+	if m != nil {
+		return m.BlobSizeGasTiers
+	}
+	return nil
+}
+
+// NamespaceGasMultiplier multiplies the gas charged for the blobs whose
+// namespace starts with a prefix.
+type NamespaceGasMultiplier struct {
+	// namespace_prefix is the prefix of the namespace, including its version.
+	NamespacePrefix []byte `protobuf:"bytes,1,opt,name=namespace_prefix,json=namespacePrefix,proto3" json:"namespace_prefix,omitempty" yaml:"namespace_prefix"`
+	// multiplier is the multiplier of the gas charged for the blobs.
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier" yaml:"multiplier"`
+}
+
+func (m *NamespaceGasMultiplier) Reset()         { *m = NamespaceGasMultiplier{} }
+func (m *NamespaceGasMultiplier) String() string { return proto.CompactTextString(m) }
+func (*NamespaceGasMultiplier) ProtoMessage()    {}
+func (*NamespaceGasMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2145b82d3e5371c6, []int{1}
+}
+func (m *NamespaceGasMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceGasMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceGasMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceGasMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceGasMultiplier.Merge(m, src)
+}
+func (m *NamespaceGasMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceGasMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceGasMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceGasMultiplier proto.InternalMessageInfo
+
+func (m *NamespaceGasMultiplier) GetNamespacePrefix() []byte {
+	if m != nil {
+		return m.NamespacePrefix
+	}
+	return nil
+}
+
+// BlobSizeGasTier multiplies the gas charged for the blobs of at least a size.
+type BlobSizeGasTier struct {
+	// min_blob_size is the minimum size of the blobs in the tier in bytes.
+	MinBlobSize uint32 `protobuf:"varint,1,opt,name=min_blob_size,json=minBlobSize,proto3" json:"min_blob_size,omitempty" yaml:"min_blob_size"`
+	// multiplier is the multiplier of the gas charged for the blobs.
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier" yaml:"multiplier"`
+}
+
+func (m *BlobSizeGasTier) Reset()         { *m = BlobSizeGasTier{} }
+func (m *BlobSizeGasTier) String() string { return proto.CompactTextString(m) }
+func (*BlobSizeGasTier) ProtoMessage()    {}
+func (*BlobSizeGasTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2145b82d3e5371c6, []int{2}
+}
+func (m *BlobSizeGasTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobSizeGasTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobSizeGasTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobSizeGasTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobSizeGasTier.Merge(m, src)
+}
+func (m *BlobSizeGasTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobSizeGasTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobSizeGasTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobSizeGasTier proto.InternalMessageInfo
+
+func (m *BlobSizeGasTier) GetMinBlobSize() uint32 {
+	if m != nil {
+		return m.MinBlobSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "celestia.blob.v1.Params")
+	proto.RegisterType((*NamespaceGasMultiplier)(nil), "celestia.blob.v1.NamespaceGasMultiplier")
+	proto.RegisterType((*BlobSizeGasTier)(nil), "celestia.blob.v1.BlobSizeGasTier")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/params.proto", fileDescriptor_2145b82d3e5371c6) }
 
 var fileDescriptor_2145b82d3e5371c6 = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x49, 0xeb, 0x81, 0xa4,
-	0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d,
-	0xd2, 0x32, 0x46, 0x2e, 0xb6, 0x00, 0xb0, 0x46, 0x21, 0x77, 0x2e, 0xc1, 0xf4, 0xc4, 0xe2, 0xf8,
-	0x82, 0xd4, 0xa2, 0x78, 0x90, 0x9e, 0xf8, 0xa4, 0xca, 0x92, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x5e, 0x27, 0x99, 0x4f, 0xf7, 0xe4, 0x25, 0x2a, 0x13, 0x73, 0x73, 0xac, 0x94, 0x30, 0x94, 0x28,
-	0x05, 0xf1, 0xa5, 0x27, 0x16, 0x07, 0xa4, 0x16, 0x39, 0xe5, 0xe4, 0x27, 0x39, 0x55, 0x96, 0xa4,
-	0x0a, 0xf9, 0x72, 0x09, 0xa7, 0xe7, 0x97, 0xc5, 0xe7, 0x26, 0x56, 0xc4, 0x17, 0x17, 0x96, 0x26,
-	0x16, 0xa5, 0xc6, 0x17, 0x67, 0x56, 0xa5, 0x4a, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x38, 0xc9, 0x7d,
-	0xba, 0x27, 0x2f, 0x05, 0x35, 0x0a, 0x53, 0x91, 0x52, 0x90, 0x40, 0x7a, 0x7e, 0x99, 0x6f, 0x62,
-	0x45, 0x30, 0x58, 0x2c, 0x38, 0xb3, 0x2a, 0xd5, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06, 0x27, 0xaf,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xf9, 0x3a, 0xbf, 0x28, 0x1d, 0xce, 0xd6, 0x4d, 0x2c,
-	0x28, 0xd0, 0xaf, 0x80, 0x04, 0x53, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xef, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x2d, 0x7d, 0x23, 0x44, 0x01, 0x00, 0x00,
+	// 637 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xcd, 0x7c, 0xed, 0x17, 0xd4, 0xe9, 0x5f, 0xea, 0x96, 0xd6, 0x0d, 0xd4, 0x0e, 0xb3, 0x40,
+	0xd9, 0xd4, 0xa1, 0xb0, 0xab, 0x90, 0x90, 0xac, 0xaa, 0x91, 0x90, 0x8a, 0x8a, 0xcb, 0xaa, 0x12,
+	0xb2, 0xc6, 0xee, 0xc4, 0x58, 0xd8, 0x1e, 0x77, 0xc6, 0x8e, 0x92, 0x3e, 0x02, 0x0b, 0x54, 0xb1,
+	0x62, 0xc9, 0x43, 0xf0, 0x04, 0xac, 0xba, 0xac, 0x60, 0x83, 0x58, 0x58, 0xa8, 0x7d, 0x03, 0x3f,
+	0x01, 0xf2, 0xd8, 0x71, 0x52, 0x37, 0x2c, 0xba, 0x62, 0xe5, 0xf1, 0xdc, 0x73, 0xcf, 0x3d, 0xf7,
+	0xcc, 0x9d, 0x81, 0x5b, 0x36, 0xf1, 0x08, 0x8f, 0x5c, 0xdc, 0xb1, 0x3c, 0x6a, 0x75, 0xfa, 0x3b,
+	0x9d, 0x10, 0x33, 0xec, 0x73, 0x2d, 0x64, 0x34, 0xa2, 0x52, 0x63, 0x14, 0xd6, 0xb2, 0xb0, 0xd6,
+	0xdf, 0x69, 0xae, 0x39, 0xd4, 0xa1, 0x22, 0xd8, 0xc9, 0x56, 0x39, 0xae, 0xb9, 0x69, 0x53, 0xee,
+	0x53, 0x6e, 0xe6, 0x81, 0xfc, 0x27, 0x0f, 0xa1, 0xf3, 0x3a, 0xac, 0x1f, 0x0a, 0x4e, 0xa9, 0x0b,
+	0x57, 0x1c, 0xcc, 0xcd, 0x90, 0x30, 0x33, 0xa3, 0x33, 0xad, 0x61, 0x44, 0x64, 0xd0, 0x02, 0xed,
+	0x45, 0xfd, 0x61, 0x9a, 0xa8, 0xf2, 0x10, 0xfb, 0xde, 0x2e, 0xba, 0x05, 0x41, 0xc6, 0x92, 0x83,
+	0xf9, 0x21, 0x61, 0xba, 0x47, 0x2d, 0x7d, 0x18, 0x11, 0xe9, 0x00, 0xae, 0x3a, 0xb4, 0x6f, 0xfa,
+	0x78, 0x60, 0xf2, 0xd3, 0x18, 0x33, 0x62, 0x72, 0xf7, 0x8c, 0xc8, 0xff, 0xb5, 0x40, 0x7b, 0x56,
+	0x57, 0xd2, 0x44, 0x6d, 0x16, 0x54, 0xb7, 0x41, 0xc8, 0x68, 0x38, 0xb4, 0x7f, 0x80, 0x07, 0x47,
+	0x62, 0xef, 0xc8, 0x3d, 0x23, 0xd2, 0x27, 0x00, 0xd7, 0x45, 0xb5, 0x1e, 0x21, 0xa6, 0x15, 0xb3,
+	0xc0, 0xec, 0x31, 0x6c, 0x47, 0x2e, 0x0d, 0xe4, 0x99, 0x16, 0x68, 0xcf, 0xe9, 0x6f, 0x2f, 0x12,
+	0xb5, 0xf6, 0x2b, 0x51, 0x1f, 0x3b, 0x6e, 0xf4, 0x2e, 0xb6, 0x34, 0x9b, 0xfa, 0x45, 0x93, 0xc5,
+	0x67, 0x9b, 0x9f, 0xbc, 0xef, 0x44, 0xc3, 0x90, 0x70, 0x6d, 0x8f, 0xd8, 0x69, 0xa2, 0x6e, 0xe5,
+	0x02, 0xa6, 0xb3, 0xa2, 0xef, 0x5f, 0xb7, 0x61, 0x61, 0xd2, 0x1e, 0xb1, 0x8d, 0xd5, 0x0c, 0xb6,
+	0x4f, 0x88, 0x1e, 0xb3, 0x60, 0xbf, 0xc0, 0x48, 0x1f, 0x00, 0x5c, 0xcd, 0xac, 0x60, 0xa4, 0x17,
+	0x07, 0x27, 0x63, 0x45, 0xb3, 0x42, 0xd1, 0xf1, 0x9d, 0x15, 0x35, 0xc7, 0xee, 0x56, 0x28, 0xab,
+	0x72, 0xb2, 0x43, 0x32, 0x04, 0xa4, 0x14, 0xf3, 0x02, 0x2e, 0x65, 0x3e, 0x8e, 0x93, 0xe5, 0xff,
+	0x85, 0xd7, 0x9b, 0x69, 0xa2, 0xde, 0xcf, 0x89, 0x6f, 0xc6, 0x91, 0xb1, 0xe0, 0xe3, 0x41, 0x77,
+	0x44, 0x24, 0x7d, 0x04, 0x70, 0x33, 0xc0, 0x3e, 0xe1, 0x21, 0xb6, 0x89, 0xc0, 0xf9, 0xb1, 0x17,
+	0xb9, 0xa1, 0xe7, 0x12, 0xc6, 0xe5, 0x7a, 0x6b, 0xa6, 0x3d, 0xff, 0xb4, 0xad, 0x55, 0xa7, 0x4d,
+	0x7b, 0x35, 0x4a, 0xe9, 0x62, 0x7e, 0x50, 0x26, 0xe8, 0xed, 0xac, 0xfb, 0x34, 0x51, 0x5b, 0x79,
+	0xe9, 0xbf, 0x12, 0x23, 0x63, 0x23, 0x98, 0xca, 0xc0, 0xa5, 0x08, 0x0a, 0xd7, 0xc5, 0x4c, 0x88,
+	0xb4, 0x48, 0x28, 0xb9, 0x27, 0x94, 0x3c, 0xba, 0xad, 0x24, 0x9b, 0xbd, 0x6c, 0x58, 0xba, 0x98,
+	0xbf, 0xc9, 0x24, 0xa0, 0x42, 0x42, 0x73, 0xe2, 0xa0, 0x6f, 0x72, 0x21, 0xa3, 0x61, 0xdd, 0x4c,
+	0xe2, 0xbb, 0xb3, 0x9f, 0xbf, 0xa8, 0x35, 0xf4, 0x03, 0xc0, 0xf5, 0xe9, 0x9d, 0x49, 0xfb, 0xb0,
+	0x31, 0xee, 0x26, 0x64, 0xa4, 0xe7, 0x0e, 0xc4, 0x0d, 0x59, 0xd0, 0x1f, 0xa4, 0x89, 0xba, 0x51,
+	0xed, 0x37, 0x47, 0x20, 0x63, 0xb9, 0xdc, 0x3a, 0x14, 0x3b, 0xd2, 0x29, 0x84, 0x63, 0x1f, 0xc4,
+	0xc5, 0x98, 0xd3, 0x5f, 0xdf, 0x79, 0x66, 0x56, 0x8a, 0xa3, 0x2d, 0x99, 0xaa, 0xa3, 0x32, 0x51,
+	0x04, 0x7d, 0x03, 0x70, 0xb9, 0xe2, 0x92, 0xf4, 0x1c, 0x2e, 0xfa, 0x6e, 0x60, 0x96, 0xee, 0x14,
+	0xb7, 0x5d, 0x4e, 0x13, 0x75, 0xad, 0xe0, 0x9e, 0x0c, 0x23, 0x63, 0xde, 0x77, 0x83, 0x11, 0xc9,
+	0x3f, 0x68, 0x42, 0x7f, 0x79, 0x71, 0xa5, 0x80, 0xcb, 0x2b, 0x05, 0xfc, 0xbe, 0x52, 0xc0, 0xf9,
+	0xb5, 0x52, 0xbb, 0xbc, 0x56, 0x6a, 0x3f, 0xaf, 0x95, 0xda, 0xf1, 0x93, 0xc9, 0x82, 0xc5, 0x74,
+	0x50, 0xe6, 0x94, 0xeb, 0x6d, 0x1c, 0x86, 0x9d, 0x41, 0xfe, 0x8c, 0x8a, 0xf2, 0x56, 0x5d, 0x3c,
+	0x80, 0xcf, 0xfe, 0x0c, 0x00, 0x1b, 0x6f, 0x4c, 0x85, 0x64, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +283,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlobSizeGasTiers) > 0 {
+		for iNdEx := len(m.BlobSizeGasTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlobSizeGasTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.NamespaceGasMultipliers) > 0 {
+		for iNdEx := len(m.NamespaceGasMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NamespaceGasMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxGasRefund != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGasRefund))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.GasRefundFraction.Size()
+		i -= size
+		if _, err := m.GasRefundFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BlobFeeBurnFraction.Size()
+		i -= size
+		if _, err := m.BlobFeeBurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.GovMaxSquareSize != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.GovMaxSquareSize))
 		i--
@@ -135,6 +349,84 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespaceGasMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceGasMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceGasMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.NamespacePrefix) > 0 {
+		i -= len(m.NamespacePrefix)
+		copy(dAtA[i:], m.NamespacePrefix)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.NamespacePrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobSizeGasTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobSizeGasTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobSizeGasTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MinBlobSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinBlobSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -158,6 +450,54 @@ func (m *Params) Size() (n int) {
 	if m.GovMaxSquareSize != 0 {
 		n += 1 + sovParams(uint64(m.GovMaxSquareSize))
 	}
+	l = m.BlobFeeBurnFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.GasRefundFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxGasRefund != 0 {
+		n += 1 + sovParams(uint64(m.MaxGasRefund))
+	}
+	if len(m.NamespaceGasMultipliers) > 0 {
+		for _, e := range m.NamespaceGasMultipliers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.BlobSizeGasTiers) > 0 {
+		for _, e := range m.BlobSizeGasTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceGasMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespacePrefix)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *BlobSizeGasTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinBlobSize != 0 {
+		n += 1 + sovParams(uint64(m.MinBlobSize))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -234,6 +574,382 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobFeeBurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlobFeeBurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRefundFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasRefundFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasRefund", wireType)
+			}
+			m.MaxGasRefund = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasRefund |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceGasMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceGasMultipliers = append(m.NamespaceGasMultipliers, NamespaceGasMultiplier{})
+			if err := m.NamespaceGasMultipliers[len(m.NamespaceGasMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobSizeGasTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlobSizeGasTiers = append(m.BlobSizeGasTiers, BlobSizeGasTier{})
+			if err := m.BlobSizeGasTiers[len(m.BlobSizeGasTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceGasMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceGasMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceGasMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespacePrefix", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespacePrefix = append(m.NamespacePrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.NamespacePrefix == nil {
+				m.NamespacePrefix = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobSizeGasTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobSizeGasTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobSizeGasTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlobSize", wireType)
+			}
+			m.MinBlobSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlobSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -42,3 +43,238 @@ func Test_validateGovMaxSquareSize(t *testing.T) {
 		}
 	}
 }
+
+func Test_validateBlobFeeBurnFraction(t *testing.T) {
+	type test struct {
+		name      string
+		input     interface{}
+		expectErr bool
+	}
+	tests := []test{
+		{
+			name:      "zero",
+			input:     sdk.ZeroDec(),
+			expectErr: false,
+		},
+		{
+			name:      "half",
+			input:     sdk.NewDecWithPrec(5, 1),
+			expectErr: false,
+		},
+		{
+			name:      "one",
+			input:     sdk.OneDec(),
+			expectErr: false,
+		},
+		{
+			name:      "negative",
+			input:     sdk.NewDec(-1),
+			expectErr: true,
+		},
+		{
+			name:      "greater than one",
+			input:     sdk.NewDecWithPrec(11, 1),
+			expectErr: true,
+		},
+		{
+			name:      "nil",
+			input:     sdk.Dec{},
+			expectErr: true,
+		},
+		{
+			name:      "wrong type",
+			input:     "0.5",
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBlobFeeBurnFraction(tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_validateGasRefundFraction(t *testing.T) {
+	type test struct {
+		name      string
+		input     interface{}
+		expectErr bool
+	}
+	tests := []test{
+		{
+			name:      "zero",
+			input:     sdk.ZeroDec(),
+			expectErr: false,
+		},
+		{
+			name:      "one",
+			input:     sdk.OneDec(),
+			expectErr: false,
+		},
+		{
+			name:      "negative",
+			input:     sdk.NewDecWithPrec(-1, 1),
+			expectErr: true,
+		},
+		{
+			name:      "greater than one",
+			input:     sdk.NewDecWithPrec(11, 1),
+			expectErr: true,
+		},
+		{
+			name:      "wrong type",
+			input:     uint64(1),
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateGasRefundFraction(tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_validateMaxGasRefund(t *testing.T) {
+	assert.NoError(t, validateMaxGasRefund(uint64(0)))
+	assert.NoError(t, validateMaxGasRefund(DefaultMaxGasRefund))
+	assert.Error(t, validateMaxGasRefund(int64(1)))
+}
+
+func Test_validateNamespaceGasMultipliers(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		name      string
+		input     interface{}
+		expectErr bool
+	}{
+		{
+			name:      "empty",
+			input:     []NamespaceGasMultiplier{},
+			expectErr: false,
+		},
+		{
+			name: "valid",
+			input: []NamespaceGasMultiplier{
+				{NamespacePrefix: []byte{0, 0, 1}, Multiplier: half},
+				{NamespacePrefix: []byte{0, 0, 1, 2}, Multiplier: MaxGasMultiplier},
+			},
+			expectErr: false,
+		},
+		{
+			name:      "empty prefix",
+			input:     []NamespaceGasMultiplier{{NamespacePrefix: []byte{}, Multiplier: half}},
+			expectErr: true,
+		},
+		{
+			name:      "prefix longer than a namespace",
+			input:     []NamespaceGasMultiplier{{NamespacePrefix: make([]byte, 30), Multiplier: half}},
+			expectErr: true,
+		},
+		{
+			name: "duplicate prefix",
+			input: []NamespaceGasMultiplier{
+				{NamespacePrefix: []byte{0, 0, 1}, Multiplier: half},
+				{NamespacePrefix: []byte{0, 0, 1}, Multiplier: sdk.OneDec()},
+			},
+			expectErr: true,
+		},
+		{
+			name:      "zero multiplier",
+			input:     []NamespaceGasMultiplier{{NamespacePrefix: []byte{1}, Multiplier: sdk.ZeroDec()}},
+			expectErr: true,
+		},
+		{
+			name:      "multiplier above the max",
+			input:     []NamespaceGasMultiplier{{NamespacePrefix: []byte{1}, Multiplier: MaxGasMultiplier.Add(sdk.OneDec())}},
+			expectErr: true,
+		},
+		{
+			name:      "nil multiplier",
+			input:     []NamespaceGasMultiplier{{NamespacePrefix: []byte{1}}},
+			expectErr: true,
+		},
+		{
+			name:      "wrong type",
+			input:     []BlobSizeGasTier{},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNamespaceGasMultipliers(tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_validateBlobSizeGasTiers(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     interface{}
+		expectErr bool
+	}{
+		{
+			name:      "empty",
+			input:     []BlobSizeGasTier{},
+			expectErr: false,
+		},
+		{
+			name: "valid",
+			input: []BlobSizeGasTier{
+				{MinBlobSize: 0, Multiplier: sdk.NewDecWithPrec(5, 1)},
+				{MinBlobSize: 1_000_000, Multiplier: sdk.NewDec(2)},
+			},
+			expectErr: false,
+		},
+		{
+			name: "not increasing",
+			input: []BlobSizeGasTier{
+				{MinBlobSize: 1_000_000, Multiplier: sdk.NewDec(2)},
+				{MinBlobSize: 1_000, Multiplier: sdk.NewDec(3)},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate min blob size",
+			input: []BlobSizeGasTier{
+				{MinBlobSize: 1_000, Multiplier: sdk.NewDec(2)},
+				{MinBlobSize: 1_000, Multiplier: sdk.NewDec(3)},
+			},
+			expectErr: true,
+		},
+		{
+			name:      "negative multiplier",
+			input:     []BlobSizeGasTier{{MinBlobSize: 1_000, Multiplier: sdk.NewDec(-1)}},
+			expectErr: true,
+		},
+		{
+			name:      "wrong type",
+			input:     []NamespaceGasMultiplier{},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBlobSizeGasTiers(tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
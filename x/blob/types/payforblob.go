@@ -10,7 +10,6 @@ import (
 	"github.com/celestiaorg/go-square/inclusion"
 	"github.com/celestiaorg/go-square/merkle"
 	appns "github.com/celestiaorg/go-square/namespace"
-	appshares "github.com/celestiaorg/go-square/shares"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -148,28 +147,33 @@ func (msg *MsgPayForBlobs) ValidateBasic() error {
 	return nil
 }
 
-func (msg *MsgPayForBlobs) Gas(gasPerByte uint32) uint64 {
-	return GasToConsume(msg.BlobSizes, gasPerByte)
+// Gas returns the gas charged for the blobs of the MsgPayForBlobs.
+func (msg *MsgPayForBlobs) Gas(gasPerByte uint32, pricing BlobGasPricing) uint64 {
+	return BlobGas(msg.BlobSizes, msg.Namespaces, gasPerByte, pricing)
 }
 
 // GasToConsume works out the extra gas charged to pay for a set of blobs in a PFB.
 // Note that transactions will incur other gas costs, such as the signature verification
-// and reads to the user's account.
+// and reads to the user's account. It assumes that there is no blob gas pricing
+// table. See BlobGas for the gas with a pricing table.
 func GasToConsume(blobSizes []uint32, gasPerByte uint32) uint64 {
-	var totalSharesUsed uint64
-	for _, size := range blobSizes {
-		totalSharesUsed += uint64(appshares.SparseSharesNeeded(size))
-	}
-
-	return totalSharesUsed * appconsts.ShareSize * uint64(gasPerByte)
+	return BlobGas(blobSizes, nil, gasPerByte, BlobGasPricing{})
 }
 
 // EstimateGas estimates the total gas required to pay for a set of blobs in a PFB.
 // It is based on a linear model that is dependent on the governance parameters:
 // gasPerByte and txSizeCost. It assumes other variables are constant. This includes
-// assuming the PFB is the only message in the transaction.
+// assuming the PFB is the only message in the transaction and that there is no
+// blob gas pricing table.
 func EstimateGas(blobSizes []uint32, gasPerByte uint32, txSizeCost uint64) uint64 {
-	return GasToConsume(blobSizes, gasPerByte) + (txSizeCost * BytesPerBlobInfo * uint64(len(blobSizes))) + PFBGasFixedCost
+	return EstimateGasWithPricing(blobSizes, nil, gasPerByte, BlobGasPricing{}, txSizeCost)
+}
+
+// EstimateGasWithPricing estimates the total gas required to pay for a set of
+// blobs in a PFB like EstimateGas, with the blob gas pricing table of the
+// network and the namespaces of the blobs.
+func EstimateGasWithPricing(blobSizes []uint32, namespaces [][]byte, gasPerByte uint32, pricing BlobGasPricing, txSizeCost uint64) uint64 {
+	return BlobGas(blobSizes, namespaces, gasPerByte, pricing) + (txSizeCost * BytesPerBlobInfo * uint64(len(blobSizes))) + PFBGasFixedCost
 }
 
 // DefaultEstimateGas runs EstimateGas with the system defaults. The network may change these values
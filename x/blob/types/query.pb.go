@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -111,33 +114,337 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryBurnedBlobFeesRequest is the request type for the Query/BurnedBlobFees
+// RPC method.
+type QueryBurnedBlobFeesRequest struct {
+}
+
+func (m *QueryBurnedBlobFeesRequest) Reset()         { *m = QueryBurnedBlobFeesRequest{} }
+func (m *QueryBurnedBlobFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedBlobFeesRequest) ProtoMessage()    {}
+func (*QueryBurnedBlobFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{2}
+}
+func (m *QueryBurnedBlobFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedBlobFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedBlobFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedBlobFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedBlobFeesRequest.Merge(m, src)
+}
+func (m *QueryBurnedBlobFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedBlobFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedBlobFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedBlobFeesRequest proto.InternalMessageInfo
+
+// QueryBurnedBlobFeesResponse is the response type for the
+// Query/BurnedBlobFees RPC method.
+type QueryBurnedBlobFeesResponse struct {
+	BurnedBlobFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned_blob_fees,json=burnedBlobFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_blob_fees"`
+}
+
+func (m *QueryBurnedBlobFeesResponse) Reset()         { *m = QueryBurnedBlobFeesResponse{} }
+func (m *QueryBurnedBlobFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedBlobFeesResponse) ProtoMessage()    {}
+func (*QueryBurnedBlobFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{3}
+}
+func (m *QueryBurnedBlobFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedBlobFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedBlobFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedBlobFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedBlobFeesResponse.Merge(m, src)
+}
+func (m *QueryBurnedBlobFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedBlobFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedBlobFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedBlobFeesResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedBlobFeesResponse) GetBurnedBlobFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedBlobFees
+	}
+	return nil
+}
+
+// QueryEstimateBlobGasRequest is the request type for the Query/EstimateBlobGas
+// RPC method.
+type QueryEstimateBlobGasRequest struct {
+	// blob_sizes are the sizes of the blobs in bytes.
+	BlobSizes []uint32 `protobuf:"varint,1,rep,packed,name=blob_sizes,json=blobSizes,proto3" json:"blob_sizes,omitempty"`
+	// namespaces are the namespaces of the blobs, including their version. They
+	// are optional and must match blob_sizes if set.
+	Namespaces [][]byte `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+}
+
+func (m *QueryEstimateBlobGasRequest) Reset()         { *m = QueryEstimateBlobGasRequest{} }
+func (m *QueryEstimateBlobGasRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateBlobGasRequest) ProtoMessage()    {}
+func (*QueryEstimateBlobGasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{4}
+}
+func (m *QueryEstimateBlobGasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateBlobGasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateBlobGasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateBlobGasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateBlobGasRequest.Merge(m, src)
+}
+func (m *QueryEstimateBlobGasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateBlobGasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateBlobGasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateBlobGasRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateBlobGasRequest) GetBlobSizes() []uint32 {
+	if m != nil {
+		return m.BlobSizes
+	}
+	return nil
+}
+
+func (m *QueryEstimateBlobGasRequest) GetNamespaces() [][]byte {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+// QueryEstimateBlobGasResponse is the response type for the
+// Query/EstimateBlobGas RPC method.
+type QueryEstimateBlobGasResponse struct {
+	// charges are the gas charged for each blob.
+	Charges []BlobGasCharge `protobuf:"bytes,1,rep,name=charges,proto3" json:"charges"`
+	// blob_gas is the total gas charged for the blobs. It doesn't include the
+	// other gas costs of a PFB tx.
+	BlobGas uint64 `protobuf:"varint,2,opt,name=blob_gas,json=blobGas,proto3" json:"blob_gas,omitempty"`
+}
+
+func (m *QueryEstimateBlobGasResponse) Reset()         { *m = QueryEstimateBlobGasResponse{} }
+func (m *QueryEstimateBlobGasResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateBlobGasResponse) ProtoMessage()    {}
+func (*QueryEstimateBlobGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{5}
+}
+func (m *QueryEstimateBlobGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateBlobGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateBlobGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateBlobGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateBlobGasResponse.Merge(m, src)
+}
+func (m *QueryEstimateBlobGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateBlobGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateBlobGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateBlobGasResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateBlobGasResponse) GetCharges() []BlobGasCharge {
+	if m != nil {
+		return m.Charges
+	}
+	return nil
+}
+
+func (m *QueryEstimateBlobGasResponse) GetBlobGas() uint64 {
+	if m != nil {
+		return m.BlobGas
+	}
+	return 0
+}
+
+// BlobGasCharge is the gas charged for a blob.
+type BlobGasCharge struct {
+	// blob_size is the size of the blob in bytes.
+	BlobSize uint32 `protobuf:"varint,1,opt,name=blob_size,json=blobSize,proto3" json:"blob_size,omitempty"`
+	// namespace is the namespace of the blob.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// tier_min_blob_size is the min blob size of the size tier of the blob. It
+	// is zero if the blob is in no size tier.
+	TierMinBlobSize uint32 `protobuf:"varint,3,opt,name=tier_min_blob_size,json=tierMinBlobSize,proto3" json:"tier_min_blob_size,omitempty"`
+	// multiplier is the product of the namespace and size tier multipliers of
+	// the blob.
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+	// gas is the gas charged for the blob.
+	Gas uint64 `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *BlobGasCharge) Reset()         { *m = BlobGasCharge{} }
+func (m *BlobGasCharge) String() string { return proto.CompactTextString(m) }
+func (*BlobGasCharge) ProtoMessage()    {}
+func (*BlobGasCharge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{6}
+}
+func (m *BlobGasCharge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobGasCharge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobGasCharge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobGasCharge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobGasCharge.Merge(m, src)
+}
+func (m *BlobGasCharge) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobGasCharge) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobGasCharge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobGasCharge proto.InternalMessageInfo
+
+func (m *BlobGasCharge) GetBlobSize() uint32 {
+	if m != nil {
+		return m.BlobSize
+	}
+	return 0
+}
+
+func (m *BlobGasCharge) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *BlobGasCharge) GetTierMinBlobSize() uint32 {
+	if m != nil {
+		return m.TierMinBlobSize
+	}
+	return 0
+}
+
+func (m *BlobGasCharge) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.blob.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.blob.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBurnedBlobFeesRequest)(nil), "celestia.blob.v1.QueryBurnedBlobFeesRequest")
+	proto.RegisterType((*QueryBurnedBlobFeesResponse)(nil), "celestia.blob.v1.QueryBurnedBlobFeesResponse")
+	proto.RegisterType((*QueryEstimateBlobGasRequest)(nil), "celestia.blob.v1.QueryEstimateBlobGasRequest")
+	proto.RegisterType((*QueryEstimateBlobGasResponse)(nil), "celestia.blob.v1.QueryEstimateBlobGasResponse")
+	proto.RegisterType((*BlobGasCharge)(nil), "celestia.blob.v1.BlobGasCharge")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/query.proto", fileDescriptor_29ba8a4248383b64) }
 
 var fileDescriptor_29ba8a4248383b64 = []byte{
-	// 275 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xea, 0x81, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94,
-	0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e,
-	0x49, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x56, 0x16, 0xc3, 0x8e, 0x82, 0xc4, 0xa2, 0xc4,
-	0x5c, 0xa8, 0xb4, 0x92, 0x08, 0x97, 0x50, 0x20, 0xc8, 0xce, 0x00, 0xb0, 0x60, 0x50, 0x6a, 0x61,
-	0x69, 0x6a, 0x71, 0x89, 0x92, 0x2f, 0x97, 0x30, 0x8a, 0x68, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa,
-	0x90, 0x19, 0x17, 0x1b, 0x44, 0xb3, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e, 0xba,
-	0x13, 0xf5, 0x20, 0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xaa, 0x36, 0x2a, 0xe7,
-	0x62, 0x05, 0x1b, 0x27, 0x94, 0xc7, 0xc5, 0x06, 0x51, 0x20, 0xa4, 0x82, 0xa9, 0x15, 0xd3, 0x1d,
-	0x52, 0xaa, 0x04, 0x54, 0x41, 0xdc, 0xa5, 0x24, 0xde, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x41, 0x21,
-	0x7e, 0x34, 0x3f, 0x3a, 0x79, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
-	0x41, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xcc, 0x8e, 0xfc, 0xa2,
-	0x74, 0x38, 0x5b, 0x37, 0xb1, 0xa0, 0x40, 0xbf, 0x02, 0x62, 0x5e, 0x49, 0x65, 0x41, 0x6a, 0x71,
-	0x12, 0x1b, 0x38, 0xc0, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x7e, 0x20, 0x5f, 0xb5,
-	0x01, 0x00, 0x00,
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x6b, 0xd4, 0x4c,
+	0x14, 0xde, 0x74, 0xfb, 0xb5, 0xa7, 0x9f, 0xef, 0xbc, 0x05, 0x77, 0xd3, 0x6d, 0xb6, 0x06, 0x95,
+	0x05, 0xdd, 0xa4, 0x5b, 0xc1, 0x2b, 0x41, 0x48, 0xab, 0x82, 0x50, 0xd0, 0x78, 0x27, 0x85, 0x65,
+	0x92, 0x8e, 0xe9, 0xe0, 0x26, 0x93, 0x66, 0xb2, 0xc5, 0xf6, 0xd2, 0x5f, 0x50, 0xb0, 0x7f, 0xc0,
+	0x5b, 0xaf, 0xfd, 0x11, 0xbd, 0x2c, 0x7a, 0x23, 0x5e, 0x54, 0x69, 0x05, 0xff, 0x86, 0xcc, 0x64,
+	0x92, 0x76, 0x3f, 0x8a, 0xbd, 0xda, 0xd9, 0xf3, 0x9c, 0xf3, 0x9c, 0xe7, 0x39, 0x73, 0x26, 0x50,
+	0xf7, 0x49, 0x97, 0xf0, 0x94, 0x62, 0xdb, 0xeb, 0x32, 0xcf, 0xde, 0x6f, 0xdb, 0x7b, 0x3d, 0x92,
+	0x1c, 0x58, 0x71, 0xc2, 0x52, 0x86, 0x16, 0x73, 0xd4, 0x12, 0xa8, 0xb5, 0xdf, 0xd6, 0x97, 0x02,
+	0x16, 0x30, 0x09, 0xda, 0xe2, 0x94, 0xe5, 0xe9, 0x35, 0x9f, 0xf1, 0x90, 0xf1, 0x4e, 0x06, 0x64,
+	0x7f, 0x14, 0x54, 0x0f, 0x18, 0x0b, 0xba, 0xc4, 0xc6, 0x31, 0xb5, 0x71, 0x14, 0xb1, 0x14, 0xa7,
+	0x94, 0x45, 0x39, 0xba, 0x32, 0xd4, 0x3e, 0xc6, 0x09, 0x0e, 0x73, 0xd8, 0xc8, 0xa8, 0x6c, 0x0f,
+	0x73, 0x62, 0xef, 0xb7, 0x3d, 0x92, 0xe2, 0xb6, 0xed, 0x33, 0x1a, 0x65, 0xb8, 0xb9, 0x04, 0xe8,
+	0x95, 0x90, 0xfb, 0x52, 0x16, 0xb9, 0x64, 0xaf, 0x47, 0x78, 0x6a, 0x6e, 0xc1, 0xff, 0x7d, 0x51,
+	0x1e, 0xb3, 0x88, 0x13, 0xf4, 0x08, 0x26, 0x33, 0xf2, 0xaa, 0xb6, 0xaa, 0x35, 0x67, 0xd6, 0xab,
+	0xd6, 0xa0, 0x3b, 0x2b, 0xab, 0x70, 0xc6, 0x4f, 0xce, 0x1a, 0x25, 0x57, 0x65, 0x9b, 0x75, 0xd0,
+	0x25, 0x9d, 0xd3, 0x4b, 0x22, 0xb2, 0xe3, 0x74, 0x99, 0xf7, 0x8c, 0x90, 0xa2, 0xd9, 0xb1, 0x06,
+	0xcb, 0x23, 0x61, 0xd5, 0xb5, 0x07, 0x8b, 0x9e, 0x44, 0x3a, 0xa2, 0x49, 0xe7, 0x2d, 0x21, 0xa2,
+	0x7f, 0xb9, 0x39, 0xb3, 0x5e, 0xb3, 0xd4, 0xa0, 0x84, 0x3b, 0x4b, 0xb9, 0xb3, 0x36, 0x18, 0x8d,
+	0x9c, 0x35, 0x21, 0xe0, 0xf3, 0xcf, 0x46, 0x33, 0xa0, 0xe9, 0x6e, 0xcf, 0xb3, 0x7c, 0x16, 0xaa,
+	0xa9, 0xaa, 0x9f, 0x16, 0xdf, 0x79, 0x67, 0xa7, 0x07, 0x31, 0xe1, 0xb2, 0x80, 0xbb, 0xf3, 0x5e,
+	0x5f, 0x7b, 0x73, 0x5b, 0xa9, 0x7a, 0xca, 0x53, 0x1a, 0xe2, 0x94, 0x08, 0xe0, 0x39, 0xce, 0x55,
+	0xa3, 0x15, 0x00, 0x29, 0x87, 0xd3, 0x43, 0xa5, 0x67, 0xce, 0xad, 0x88, 0xc8, 0x6b, 0x11, 0x40,
+	0x06, 0x40, 0x84, 0x43, 0xc2, 0x63, 0xec, 0x13, 0x5e, 0x1d, 0x5b, 0x2d, 0x37, 0x67, 0xdd, 0x2b,
+	0x11, 0xf3, 0x10, 0xea, 0xa3, 0xd9, 0x95, 0xe9, 0x27, 0x30, 0xe5, 0xef, 0xe2, 0x24, 0x28, 0xbc,
+	0x36, 0x86, 0x67, 0xad, 0x6a, 0x36, 0x64, 0x9e, 0x1a, 0x79, 0x5e, 0x85, 0x6a, 0x30, 0x2d, 0xf5,
+	0x05, 0x58, 0xb4, 0xd7, 0x9a, 0xe3, 0xee, 0x94, 0x97, 0xe5, 0x9b, 0x7f, 0x34, 0x98, 0xeb, 0xab,
+	0x45, 0xcb, 0x50, 0x29, 0xcc, 0xc8, 0xbb, 0x9d, 0x73, 0xa7, 0x73, 0x2f, 0xa8, 0x0e, 0x95, 0x42,
+	0xb8, 0xa4, 0x9a, 0x75, 0x2f, 0x03, 0xe8, 0x3e, 0xa0, 0x94, 0x92, 0xa4, 0x13, 0xd2, 0xa8, 0x73,
+	0xc9, 0x51, 0x96, 0x1c, 0x0b, 0x02, 0xd9, 0xa2, 0x91, 0x93, 0x53, 0x6d, 0x03, 0x84, 0xbd, 0x6e,
+	0x4a, 0xe3, 0x2e, 0x25, 0x49, 0x75, 0x7c, 0x55, 0x6b, 0x56, 0x9c, 0xc7, 0x42, 0xf7, 0x8f, 0xb3,
+	0xc6, 0xbd, 0x1b, 0xdc, 0xd4, 0x26, 0xf1, 0xbf, 0x7e, 0x69, 0x81, 0xba, 0xf5, 0x4d, 0xe2, 0xbb,
+	0x57, 0xf8, 0xd0, 0x22, 0x94, 0x85, 0xdb, 0x09, 0xe9, 0x56, 0x1c, 0xd7, 0x3f, 0x95, 0x61, 0x42,
+	0x8e, 0x19, 0x45, 0x30, 0x99, 0xad, 0x26, 0xba, 0x33, 0x3c, 0xc8, 0xe1, 0x17, 0xa0, 0xdf, 0xfd,
+	0x47, 0x56, 0x76, 0x4d, 0xe6, 0xad, 0x0f, 0xdf, 0x7e, 0x7f, 0x1c, 0xfb, 0x0f, 0x2d, 0x0c, 0xbc,
+	0x3e, 0x74, 0xa4, 0xc1, 0x7c, 0xff, 0x3e, 0xa3, 0x07, 0xd7, 0x50, 0x8e, 0x7c, 0x15, 0x7a, 0xeb,
+	0x86, 0xd9, 0x4a, 0xc8, 0x6d, 0x29, 0x64, 0x19, 0xd5, 0x0a, 0x21, 0x83, 0x6f, 0x06, 0x1d, 0x6b,
+	0xb0, 0x30, 0xb0, 0x6e, 0xe8, 0xba, 0x2e, 0xa3, 0x97, 0x5e, 0xb7, 0x6e, 0x9a, 0xae, 0x54, 0x99,
+	0x52, 0x55, 0x1d, 0xe9, 0x85, 0x2a, 0xa2, 0x32, 0x3b, 0xf9, 0x72, 0x3a, 0x2f, 0x4e, 0xce, 0x0d,
+	0xed, 0xf4, 0xdc, 0xd0, 0x7e, 0x9d, 0x1b, 0xda, 0xd1, 0x85, 0x51, 0x3a, 0xbd, 0x30, 0x4a, 0xdf,
+	0x2f, 0x8c, 0xd2, 0x9b, 0xb5, 0xab, 0x1b, 0xa1, 0xfa, 0xb2, 0x24, 0x28, 0xce, 0x2d, 0x1c, 0xc7,
+	0xf6, 0xfb, 0x8c, 0x5a, 0xee, 0x87, 0x37, 0x29, 0x3f, 0x6a, 0x0f, 0xff, 0x0e, 0x00, 0xbc, 0x8b,
+	0x5a, 0xc8, 0x94, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -154,6 +461,11 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BurnedBlobFees queries the total amount of blob fees burned.
+	BurnedBlobFees(ctx context.Context, in *QueryBurnedBlobFeesRequest, opts ...grpc.CallOption) (*QueryBurnedBlobFeesResponse, error)
+	// EstimateBlobGas is a dry run of the gas charged for the blobs of a PFB
+	// with the current params.
+	EstimateBlobGas(ctx context.Context, in *QueryEstimateBlobGasRequest, opts ...grpc.CallOption) (*QueryEstimateBlobGasResponse, error)
 }
 
 type queryClient struct {
@@ -173,10 +485,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) BurnedBlobFees(ctx context.Context, in *QueryBurnedBlobFeesRequest, opts ...grpc.CallOption) (*QueryBurnedBlobFeesResponse, error) {
+	out := new(QueryBurnedBlobFeesResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Query/BurnedBlobFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateBlobGas(ctx context.Context, in *QueryEstimateBlobGasRequest, opts ...grpc.CallOption) (*QueryEstimateBlobGasResponse, error) {
+	out := new(QueryEstimateBlobGasResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.Query/EstimateBlobGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BurnedBlobFees queries the total amount of blob fees burned.
+	BurnedBlobFees(context.Context, *QueryBurnedBlobFeesRequest) (*QueryBurnedBlobFeesResponse, error)
+	// EstimateBlobGas is a dry run of the gas charged for the blobs of a PFB
+	// with the current params.
+	EstimateBlobGas(context.Context, *QueryEstimateBlobGasRequest) (*QueryEstimateBlobGasResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -186,6 +521,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) BurnedBlobFees(ctx context.Context, req *QueryBurnedBlobFeesRequest) (*QueryBurnedBlobFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedBlobFees not implemented")
+}
+func (*UnimplementedQueryServer) EstimateBlobGas(ctx context.Context, req *QueryEstimateBlobGasRequest) (*QueryEstimateBlobGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateBlobGas not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -209,6 +550,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedBlobFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedBlobFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedBlobFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Query/BurnedBlobFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedBlobFees(ctx, req.(*QueryBurnedBlobFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateBlobGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateBlobGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateBlobGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.Query/EstimateBlobGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateBlobGas(ctx, req.(*QueryEstimateBlobGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.blob.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -217,6 +594,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "BurnedBlobFees",
+			Handler:    _Query_BurnedBlobFees_Handler,
+		},
+		{
+			MethodName: "EstimateBlobGas",
+			Handler:    _Query_EstimateBlobGas_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/blob/v1/query.proto",
@@ -278,44 +663,339 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBurnedBlobFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryBurnedBlobFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedBlobFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBurnedBlobFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedBlobFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedBlobFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.BurnedBlobFees) > 0 {
+		for iNdEx := len(m.BurnedBlobFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedBlobFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryEstimateBlobGasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+
+func (m *QueryEstimateBlobGasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateBlobGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BlobSizes) > 0 {
+		dAtA3 := make([]byte, len(m.BlobSizes)*10)
+		var j2 int
+		for _, num := range m.BlobSizes {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintQuery(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateBlobGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateBlobGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateBlobGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlobGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlobGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Charges) > 0 {
+		for iNdEx := len(m.Charges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Charges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobGasCharge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobGasCharge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobGasCharge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TierMinBlobSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TierMinBlobSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlobSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlobSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurnedBlobFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedBlobFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BurnedBlobFees) > 0 {
+		for _, e := range m.BurnedBlobFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateBlobGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlobSizes) > 0 {
+		l = 0
+		for _, e := range m.BlobSizes {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.Namespaces) > 0 {
+		for _, b := range m.Namespaces {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateBlobGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Charges) > 0 {
+		for _, e := range m.Charges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BlobGas != 0 {
+		n += 1 + sovQuery(uint64(m.BlobGas))
+	}
+	return n
+}
+
+func (m *BlobGasCharge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlobSize != 0 {
+		n += 1 + sovQuery(uint64(m.BlobSize))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TierMinBlobSize != 0 {
+		n += 1 + sovQuery(uint64(m.TierMinBlobSize))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -448,6 +1128,576 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBurnedBlobFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedBlobFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedBlobFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedBlobFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedBlobFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedBlobFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedBlobFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedBlobFees = append(m.BurnedBlobFees, types.Coin{})
+			if err := m.BurnedBlobFees[len(m.BurnedBlobFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateBlobGasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateBlobGasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateBlobGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlobSizes = append(m.BlobSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlobSizes) == 0 {
+					m.BlobSizes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlobSizes = append(m.BlobSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobSizes", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, make([]byte, postIndex-iNdEx))
+			copy(m.Namespaces[len(m.Namespaces)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateBlobGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateBlobGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateBlobGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Charges = append(m.Charges, BlobGasCharge{})
+			if err := m.Charges[len(m.Charges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobGas", wireType)
+			}
+			m.BlobGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobGasCharge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobGasCharge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobGasCharge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobSize", wireType)
+			}
+			m.BlobSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TierMinBlobSize", wireType)
+			}
+			m.TierMinBlobSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TierMinBlobSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,7 +2,6 @@ package blobstream
 
 import (
 	"errors"
-	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 
@@ -11,20 +10,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-const (
-	oneDay  = 24 * time.Hour
-	oneWeek = 7 * oneDay
-	// AttestationExpiryTime is the expiration time of an attestation. When this
-	// much time has passed after an attestation has been published, it will be
-	// pruned from state.
-	AttestationExpiryTime = 3 * oneWeek // 3 weeks
-)
-
-// SignificantPowerDifferenceThreshold is the threshold of change in the
-// validator set power that would trigger the creation of a new valset
-// request.
-var SignificantPowerDifferenceThreshold = sdk.NewDecWithPrec(5, 2) // 0.05
-
 // EndBlocker is called at the end of every block.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// we always want to create the valset at first so that if there is a new
@@ -44,6 +29,7 @@ func handleDataCommitmentRequest(ctx sdk.Context, k keeper.Keeper) {
 		if err != nil {
 			panic(err)
 		}
+		k.QueueAttestation(&dataCommitment)
 	}
 	dataCommitmentWindow := int64(k.GetDataCommitmentWindowParam(ctx))
 	// this will keep executing until all the needed data commitments are
@@ -117,7 +103,7 @@ func handleValsetRequest(ctx sdk.Context, k keeper.Keeper) {
 			panic(sdkerrors.Wrap(err, "invalid latest valset members"))
 		}
 
-		significantPowerDiff = intCurrMembers.PowerDiff(*intLatestMembers).GT(SignificantPowerDifferenceThreshold)
+		significantPowerDiff = intCurrMembers.PowerDiff(*intLatestMembers).GT(k.GetSignificantPowerDifferenceThresholdParam(ctx))
 
 	}
 
@@ -132,6 +118,7 @@ func handleValsetRequest(ctx sdk.Context, k keeper.Keeper) {
 		if err != nil {
 			panic(err)
 		}
+		k.QueueAttestation(&valset)
 	}
 }
 
@@ -149,6 +136,7 @@ func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
 	}
 
 	currentBlockTime := ctx.BlockTime()
+	attestationExpiryTime := k.GetAttestationExpiryTimeParam(ctx)
 	latestAttestationNonce := k.GetLatestAttestationNonce(ctx)
 	earliestNonce := k.GetEarliestAvailableAttestationNonce(ctx)
 	var newEarliestAvailableNonce uint64
@@ -166,7 +154,7 @@ func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
 			ctx.Logger().Error("nil attestation for pruning", "nonce", newEarliestAvailableNonce)
 			return
 		}
-		attestationExpirationTime := newEarliestAttestation.BlockTime().Add(AttestationExpiryTime)
+		attestationExpirationTime := newEarliestAttestation.BlockTime().Add(attestationExpiryTime)
 		if attestationExpirationTime.After(currentBlockTime) {
 			// the current attestation is unexpired so subsequent ones are also
 			// unexpired persist the new earliest available attestation nonce
@@ -11,6 +11,7 @@ import (
 
 	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
 	"github.com/celestiaorg/celestia-app/v2/test/util/testfactory"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/stretchr/testify/assert"
@@ -97,6 +98,29 @@ func TestValsetCreationWhenEditingEVMAddr(t *testing.T) {
 	assert.Equal(t, currentAttestationNonce+1, pk.GetLatestAttestationNonce(ctx))
 }
 
+func TestSignificantPowerDifferenceThresholdParam(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	pk := input.BlobstreamKeeper
+	params := pk.GetParams(ctx)
+	// editing the EVM address of one of the five validators results in a
+	// power difference of 40%.
+	params.SignificantPowerDifferenceThreshold = sdk.NewDecWithPrec(5, 1)
+	pk.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(1)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+	blobstream.EndBlocker(ctx, pk)
+	currentAttestationNonce := pk.GetLatestAttestationNonce(ctx)
+	require.Equal(t, uint64(1), currentAttestationNonce)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	pk.SetEVMAddress(ctx, testutil.ValAddrs[1], testfactory.RandomEVMAddress())
+	staking.EndBlocker(ctx, input.StakingKeeper)
+	blobstream.EndBlocker(ctx, pk)
+
+	assert.Equal(t, currentAttestationNonce, pk.GetLatestAttestationNonce(ctx))
+}
+
 func TestSetValset(t *testing.T) {
 	input, ctx := testutil.SetupFiveValChain(t)
 	pk := input.BlobstreamKeeper
@@ -201,7 +225,7 @@ func TestGetDataCommitment(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// set the data commitment window
-			qk.SetParams(ctx, types.Params{DataCommitmentWindow: tt.window})
+			qk.SetParams(ctx, paramsWithWindow(tt.window))
 			require.Equal(t, tt.window, qk.GetDataCommitmentWindowParam(ctx))
 
 			// change the block height
@@ -296,7 +320,7 @@ func TestHasDataCommitmentInStore(t *testing.T) {
 	input, ctx := testutil.SetupFiveValChain(t)
 	qk := input.BlobstreamKeeper
 	// set the data commitment window
-	qk.SetParams(ctx, types.Params{DataCommitmentWindow: 400})
+	qk.SetParams(ctx, paramsWithWindow(400))
 	require.Equal(t, uint64(400), qk.GetDataCommitmentWindowParam(ctx))
 
 	tests := []struct {
@@ -361,19 +385,19 @@ func TestDataCommitmentCreationCatchup(t *testing.T) {
 	ctx = ctx.WithBlockHeight(1)
 
 	// from height 1 to 1500 with a window of 400
-	qk.SetParams(ctx, types.Params{DataCommitmentWindow: 400})
+	qk.SetParams(ctx, paramsWithWindow(400))
 	ctx = testutil.ExecuteBlobstreamHeights(ctx, qk, 1, 1501)
 
 	// change window to 100 and execute up to 1920
-	qk.SetParams(ctx, types.Params{DataCommitmentWindow: 100})
+	qk.SetParams(ctx, paramsWithWindow(100))
 	ctx = testutil.ExecuteBlobstreamHeights(ctx, qk, 1501, 1921)
 
 	// change window to 1000 and execute up to 3500
-	qk.SetParams(ctx, types.Params{DataCommitmentWindow: 1000})
+	qk.SetParams(ctx, paramsWithWindow(1000))
 	ctx = testutil.ExecuteBlobstreamHeights(ctx, qk, 1921, 3501)
 
 	// change window to 111 and execute up to 3800
-	qk.SetParams(ctx, types.Params{DataCommitmentWindow: 111})
+	qk.SetParams(ctx, paramsWithWindow(111))
 	ctx = testutil.ExecuteBlobstreamHeights(ctx, qk, 3501, 3801)
 
 	// check if a data commitment was created
@@ -524,7 +548,7 @@ func TestPruning(t *testing.T) {
 	bsKeeper := input.BlobstreamKeeper
 	// set the data commitment window
 	window := uint64(101)
-	bsKeeper.SetParams(ctx, types.Params{DataCommitmentWindow: window})
+	bsKeeper.SetParams(ctx, paramsWithWindow(window))
 	initialBlockTime := ctx.BlockTime()
 	blockInterval := 10 * time.Minute
 	ctx = testutil.ExecuteBlobstreamHeightsWithTime(ctx, bsKeeper, 1, 1626, blockInterval)
@@ -558,7 +582,7 @@ func TestPruning(t *testing.T) {
 		assert.NoError(t, err)
 		assert.True(t, found)
 		// make sure the remaining attestations have not expired yet
-		assert.True(t, initialBlockTime.Before(at.BlockTime().Add(blobstream.AttestationExpiryTime)))
+		assert.True(t, initialBlockTime.Before(at.BlockTime().Add(types.DefaultAttestationExpiryTime)))
 	}
 
 	// check that no valset exists in store
@@ -579,3 +603,26 @@ func TestPruning(t *testing.T) {
 	// inconsistency happens after pruning
 	testutil.ExecuteBlobstreamHeightsWithTime(ctx, bsKeeper, 5000, 6000, blockInterval)
 }
+
+func TestPruningWithAttestationExpiryTimeParam(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	bsKeeper := input.BlobstreamKeeper
+	// with a window of 101 blocks of 10 minutes, every attestation expires
+	// before the next one is created.
+	params := paramsWithWindow(101)
+	params.AttestationExpiryTime = time.Hour
+	bsKeeper.SetParams(ctx, params)
+	ctx = testutil.ExecuteBlobstreamHeightsWithTime(ctx, bsKeeper, 1, 1626, 10*time.Minute)
+
+	// the latest attestation is never pruned.
+	assert.Equal(t, uint64(17), bsKeeper.GetLatestAttestationNonce(ctx))
+	assert.Equal(t, uint64(17), bsKeeper.GetEarliestAvailableAttestationNonce(ctx))
+}
+
+// paramsWithWindow returns the default params with the provided data
+// commitment window.
+func paramsWithWindow(window uint64) types.Params {
+	params := types.DefaultParams()
+	params.DataCommitmentWindow = window
+	return params
+}
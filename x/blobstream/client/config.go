@@ -14,6 +14,7 @@ const (
 	celesGRPCFlag       = "celes-grpc"
 	evmRPCFlag          = "evm-rpc"
 	contractAddressFlag = "contract-address"
+	evmSnapshotFlag     = "evm-snapshot"
 )
 
 func addVerifyFlags(cmd *cobra.Command) *cobra.Command {
@@ -23,6 +24,7 @@ func addVerifyFlags(cmd *cobra.Command) *cobra.Command {
 	cmd.Flags().StringP(evmRPCFlag, "e", "http://localhost:8545", "The EVM RPC address")
 	cmd.Flags().StringP(contractAddressFlag, "a", "", "The contract address at which Blobstream is deployed")
 	cmd.Flags().StringP(celesGRPCFlag, "c", "localhost:9090", "<host>:<port> To Celestia GRPC address")
+	cmd.Flags().String(evmSnapshotFlag, "", "Path to a JSON snapshot of the data root tuple roots committed to by the Blobstream contract. If set, the verification runs against a local stand-in contract loaded with the snapshot instead of the EVM RPC and the contract address")
 
 	return cmd
 }
@@ -32,6 +34,9 @@ type VerifyConfig struct {
 	EVMRPC, CelesGRPC, TendermintRPC string
 	EVMChainID                       uint64
 	ContractAddr                     ethcmn.Address
+	// EVMSnapshot is the path to the EVM snapshot to verify against. If set,
+	// EVMRPC and ContractAddr are not used.
+	EVMSnapshot string
 }
 
 func parseVerifyFlags(cmd *cobra.Command) (VerifyConfig, error) {
@@ -55,10 +60,23 @@ func parseVerifyFlags(cmd *cobra.Command) (VerifyConfig, error) {
 	if err != nil {
 		return VerifyConfig{}, err
 	}
+	evmSnapshot, err := cmd.Flags().GetString(evmSnapshotFlag)
+	if err != nil {
+		return VerifyConfig{}, err
+	}
 	contractAddr, err := cmd.Flags().GetString(contractAddressFlag)
 	if err != nil {
 		return VerifyConfig{}, err
 	}
+	if evmSnapshot != "" {
+		return VerifyConfig{
+			CelestiaChainID: chainID,
+			EVMChainID:      evmChainID,
+			CelesGRPC:       celesGRPC,
+			TendermintRPC:   tendermintRPC,
+			EVMSnapshot:     evmSnapshot,
+		}, nil
+	}
 	if contractAddr == "" {
 		return VerifyConfig{}, fmt.Errorf("contract address flag is required: %s", contractAddressFlag)
 	}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+)
+
+// EVMSnapshot is a record of the data root tuple roots committed to by a
+// Blobstream contract. It allows verifying that data was committed to by the
+// contract without access to an EVM node.
+type EVMSnapshot struct {
+	// DataRootTupleRoots are the data root tuple roots committed to by the
+	// contract in increasing nonce order.
+	DataRootTupleRoots []DataRootTupleRootSnapshot `json:"data_root_tuple_roots"`
+}
+
+// DataRootTupleRootSnapshot is a data root tuple root along with the nonce at
+// which it was committed to by the contract.
+type DataRootTupleRootSnapshot struct {
+	Nonce             uint64      `json:"nonce"`
+	DataRootTupleRoot ethcmn.Hash `json:"data_root_tuple_root"`
+}
+
+// ValidateBasic returns an error if the snapshot is empty or its nonces are not
+// in strictly increasing order.
+func (s EVMSnapshot) ValidateBasic() error {
+	if len(s.DataRootTupleRoots) == 0 {
+		return errors.New("the snapshot doesn't contain any data root tuple root")
+	}
+	for i, root := range s.DataRootTupleRoots {
+		if root.Nonce == 0 {
+			return fmt.Errorf("data root tuple root %d has nonce 0", i)
+		}
+		if i > 0 && root.Nonce <= s.DataRootTupleRoots[i-1].Nonce {
+			return fmt.Errorf("data root tuple root nonces must be strictly increasing: %d follows %d", root.Nonce, s.DataRootTupleRoots[i-1].Nonce)
+		}
+	}
+	return nil
+}
+
+// LoadEVMSnapshot reads and validates the EVM snapshot stored in the JSON file
+// at path.
+func LoadEVMSnapshot(path string) (EVMSnapshot, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return EVMSnapshot{}, err
+	}
+	var snapshot EVMSnapshot
+	if err := json.Unmarshal(bz, &snapshot); err != nil {
+		return EVMSnapshot{}, fmt.Errorf("failed to parse the EVM snapshot %s: %w", path, err)
+	}
+	if err := snapshot.ValidateBasic(); err != nil {
+		return EVMSnapshot{}, err
+	}
+	return snapshot, nil
+}
+
+// RecordEVMSnapshot reads the data root tuple roots committed to by the
+// Blobstream contract in the inclusive nonce range [fromNonce, toNonce]. The
+// nonces of valsets, which don't have a data root tuple root, are skipped.
+func RecordEVMSnapshot(ctx context.Context, bsWrapper *wrapper.Wrappers, fromNonce, toNonce uint64) (EVMSnapshot, error) {
+	if fromNonce == 0 || fromNonce > toNonce {
+		return EVMSnapshot{}, fmt.Errorf("invalid nonce range [%d, %d]", fromNonce, toNonce)
+	}
+	var snapshot EVMSnapshot
+	for nonce := fromNonce; nonce <= toNonce; nonce++ {
+		root, err := bsWrapper.StateDataRootTupleRoots(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(nonce))
+		if err != nil {
+			return EVMSnapshot{}, err
+		}
+		if root == [32]byte{} {
+			continue
+		}
+		snapshot.DataRootTupleRoots = append(snapshot.DataRootTupleRoots, DataRootTupleRootSnapshot{
+			Nonce:             nonce,
+			DataRootTupleRoot: root,
+		})
+	}
+	return snapshot, snapshot.ValidateBasic()
+}
@@ -0,0 +1,222 @@
+//go:build evm_snapshot
+
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	coretypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+)
+
+// simulatedChainID is the chain ID used by the go-ethereum simulated backend.
+const simulatedChainID = 1337
+
+// snapshotValidatorPower is the power of the single validator that signs the
+// attestations submitted to the snapshot backend.
+const snapshotValidatorPower = 1000
+
+// newSnapshotWrapper returns a binding to a SnapshotBackend loaded with the EVM
+// snapshot stored at path, along with a function that releases the backend.
+func newSnapshotWrapper(ctx context.Context, path string) (*wrapper.Wrappers, func() error, error) {
+	snapshot, err := LoadEVMSnapshot(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	backend, err := NewSnapshotBackend(ctx, snapshot)
+	if err != nil {
+		return nil, nil, err
+	}
+	return backend.Wrapper, backend.Close, nil
+}
+
+// SnapshotBackend is a Blobstream contract deployed to a go-ethereum simulated
+// backend and loaded with the data root tuple roots of an EVMSnapshot. It
+// stands in for the contract deployed to a live EVM chain.
+//
+// The contract accepts the snapshot roots because they are signed by a local
+// validator set, so verifying against it only proves that the data was
+// committed to by the roots in the snapshot.
+type SnapshotBackend struct {
+	Wrapper *wrapper.Wrappers
+
+	backend *simulated.Backend
+	auth    *bind.TransactOpts
+	key     *ecdsa.PrivateKey
+	valset  types.Valset
+}
+
+// NewSnapshotBackend deploys a Blobstream contract to a new simulated backend
+// and submits the data root tuple roots of the snapshot to it. The nonces
+// between the snapshot roots are filled with validator set updates. Close must
+// be called to release the backend.
+func NewSnapshotBackend(ctx context.Context, snapshot EVMSnapshot) (*SnapshotBackend, error) {
+	if err := snapshot.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(simulatedChainID))
+	if err != nil {
+		return nil, err
+	}
+	auth.Context = ctx
+	backend := simulated.NewBackend(coretypes.GenesisAlloc{
+		auth.From: {Balance: new(big.Int).Lsh(big.NewInt(1), 100)},
+	})
+	b := &SnapshotBackend{
+		backend: backend,
+		auth:    auth,
+		key:     key,
+		valset: types.Valset{
+			Members: []types.BridgeValidator{{Power: snapshotValidatorPower, EvmAddress: auth.From.Hex()}},
+		},
+	}
+	if err := b.load(snapshot); err != nil {
+		b.Close()
+		return nil, err
+	}
+	return b, nil
+}
+
+// Close stops the simulated backend.
+func (b *SnapshotBackend) Close() error {
+	return b.backend.Close()
+}
+
+func (b *SnapshotBackend) load(snapshot EVMSnapshot) error {
+	_, tx, bsWrapper, err := wrapper.DeployWrappers(b.auth, b.backend.Client())
+	if err != nil {
+		return err
+	}
+	if err := b.commit(tx); err != nil {
+		return fmt.Errorf("failed to deploy the Blobstream contract: %w", err)
+	}
+	b.Wrapper = bsWrapper
+
+	// the contract requires the nonces to be contiguous so it is initialized
+	// right before the first snapshot nonce.
+	b.valset.Nonce = snapshot.DataRootTupleRoots[0].Nonce - 1
+	vsHash, err := b.valset.Hash()
+	if err != nil {
+		return err
+	}
+	tx, err = bsWrapper.Initialize(b.auth, new(big.Int).SetUint64(b.valset.Nonce), new(big.Int).SetUint64(b.valset.TwoThirdsThreshold()), vsHash)
+	if err != nil {
+		return err
+	}
+	if err := b.commit(tx); err != nil {
+		return fmt.Errorf("failed to initialize the Blobstream contract: %w", err)
+	}
+
+	nonce := b.valset.Nonce + 1
+	for _, root := range snapshot.DataRootTupleRoots {
+		for ; nonce < root.Nonce; nonce++ {
+			if err := b.updateValset(nonce); err != nil {
+				return fmt.Errorf("failed to fill nonce %d: %w", nonce, err)
+			}
+		}
+		if err := b.submitDataRootTupleRoot(root); err != nil {
+			return fmt.Errorf("failed to submit the data root tuple root of nonce %d: %w", root.Nonce, err)
+		}
+		nonce++
+	}
+	return nil
+}
+
+// updateValset submits a validator set update, with the same members, at the
+// provided nonce.
+func (b *SnapshotBackend) updateValset(nonce uint64) error {
+	newValset := b.valset
+	newValset.Nonce = nonce
+	signBytes, err := newValset.SignBytes()
+	if err != nil {
+		return err
+	}
+	vsHash, err := newValset.Hash()
+	if err != nil {
+		return err
+	}
+	sig, err := b.sign(signBytes)
+	if err != nil {
+		return err
+	}
+	tx, err := b.Wrapper.UpdateValidatorSet(
+		b.auth,
+		new(big.Int).SetUint64(nonce),
+		new(big.Int).SetUint64(b.valset.Nonce),
+		new(big.Int).SetUint64(newValset.TwoThirdsThreshold()),
+		vsHash,
+		b.validators(),
+		[]wrapper.Signature{sig},
+	)
+	if err != nil {
+		return err
+	}
+	if err := b.commit(tx); err != nil {
+		return err
+	}
+	b.valset = newValset
+	return nil
+}
+
+func (b *SnapshotBackend) submitDataRootTupleRoot(root DataRootTupleRootSnapshot) error {
+	sig, err := b.sign(types.DataCommitmentTupleRootSignBytes(root.Nonce, root.DataRootTupleRoot))
+	if err != nil {
+		return err
+	}
+	tx, err := b.Wrapper.SubmitDataRootTupleRoot(
+		b.auth,
+		new(big.Int).SetUint64(root.Nonce),
+		new(big.Int).SetUint64(b.valset.Nonce),
+		root.DataRootTupleRoot,
+		b.validators(),
+		[]wrapper.Signature{sig},
+	)
+	if err != nil {
+		return err
+	}
+	return b.commit(tx)
+}
+
+func (b *SnapshotBackend) validators() []wrapper.Validator {
+	return []wrapper.Validator{{Addr: b.auth.From, Power: big.NewInt(snapshotValidatorPower)}}
+}
+
+// sign signs the EIP-191 hash of signBytes, as expected by the contract.
+func (b *SnapshotBackend) sign(signBytes ethcmn.Hash) (wrapper.Signature, error) {
+	sig, err := crypto.Sign(accounts.TextHash(signBytes.Bytes()), b.key)
+	if err != nil {
+		return wrapper.Signature{}, err
+	}
+	return wrapper.Signature{
+		V: sig[crypto.RecoveryIDOffset] + 27,
+		R: *(*[32]byte)(sig[:32]),
+		S: *(*[32]byte)(sig[32:64]),
+	}, nil
+}
+
+// commit mines a block containing tx and returns an error if tx failed.
+func (b *SnapshotBackend) commit(tx *coretypes.Transaction) error {
+	b.backend.Commit()
+	receipt, err := b.backend.Client().TransactionReceipt(b.auth.Context, tx.Hash())
+	if err != nil {
+		return err
+	}
+	if receipt.Status != coretypes.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s failed", tx.Hash().Hex())
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+//go:build !evm_snapshot
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+)
+
+// newSnapshotWrapper returns an error because the binary was built without the
+// go-ethereum simulated backend, which the snapshot verification requires.
+func newSnapshotWrapper(_ context.Context, _ string) (*wrapper.Wrappers, func() error, error) {
+	return nil, nil, fmt.Errorf("--%s requires a binary built with the evm_snapshot build tag", evmSnapshotFlag)
+}
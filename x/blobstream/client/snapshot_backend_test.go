@@ -0,0 +1,107 @@
+//go:build evm_snapshot
+
+package client_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/client"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	"github.com/celestiaorg/go-square/merkle"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotBackend(t *testing.T) {
+	const beginBlock = 1
+	dataRoots := make([][]byte, 8)
+	for i := range dataRoots {
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, uint64(i))
+		hash := sha256.Sum256(bz)
+		dataRoots[i] = hash[:]
+	}
+	root, err := types.DataRootTupleRoot(beginBlock, dataRoots)
+	require.NoError(t, err)
+
+	// the gaps between the nonces are filled with valset updates.
+	snapshot := client.EVMSnapshot{
+		DataRootTupleRoots: []client.DataRootTupleRootSnapshot{
+			{Nonce: 3, DataRootTupleRoot: ethcmn.BytesToHash(root)},
+			{Nonce: 6, DataRootTupleRoot: ethcmn.HexToHash("0x01")},
+		},
+	}
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	bz, err := json.Marshal(snapshot)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+	loaded, err := client.LoadEVMSnapshot(path)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, loaded)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	backend, err := client.NewSnapshotBackend(ctx, loaded)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	const height = 4
+	tmProof, err := types.ProveDataRootTuple(beginBlock, dataRoots, height)
+	require.NoError(t, err)
+	proof := merkle.Proof{Total: tmProof.Total, Index: tmProof.Index, LeafHash: tmProof.LeafHash, Aunts: tmProof.Aunts}
+
+	valid, err := client.VerifyDataRootInclusion(ctx, backend.Wrapper, 3, height, dataRoots[height-beginBlock], proof)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = client.VerifyDataRootInclusion(ctx, backend.Wrapper, 6, height, dataRoots[height-beginBlock], proof)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	valid, err = client.VerifyDataRootInclusion(ctx, backend.Wrapper, 3, height, dataRoots[0], proof)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	recorded, err := client.RecordEVMSnapshot(ctx, backend.Wrapper, 1, 6)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, recorded)
+}
+
+func (s *CLITestSuite) TestVerifyDataRootInclusionAgainstEVMSnapshot() {
+	_, err := s.cctx.WaitForHeightWithTimeout(402, 2*time.Minute)
+	s.Require().NoError(err)
+	ctx := s.cctx.GoContext()
+
+	const height = 10
+	resp, err := types.NewProofQueryClient(s.cctx.GRPCClient).DataRootTupleInclusionProof(
+		ctx,
+		&types.QueryDataRootTupleInclusionProofRequest{Height: height},
+	)
+	s.Require().NoError(err)
+
+	// the proof must be against the data root tuple root computed by
+	// Tendermint, which is the one signed by the orchestrators.
+	dc, err := s.cctx.Client.DataCommitment(ctx, resp.DataCommitment.BeginBlock, resp.DataCommitment.EndBlock)
+	s.Require().NoError(err)
+	snapshot := client.EVMSnapshot{
+		DataRootTupleRoots: []client.DataRootTupleRootSnapshot{
+			{Nonce: resp.DataCommitment.Nonce, DataRootTupleRoot: ethcmn.BytesToHash(dc.DataCommitment)},
+		},
+	}
+	backend, err := client.NewSnapshotBackend(ctx, snapshot)
+	s.Require().NoError(err)
+	defer backend.Close()
+
+	proof := merkle.Proof{Total: resp.Proof.Total, Index: resp.Proof.Index, LeafHash: resp.Proof.LeafHash, Aunts: resp.Proof.Aunts}
+	valid, err := client.VerifyDataRootInclusion(ctx, backend.Wrapper, resp.DataCommitment.Nonce, height, resp.DataRoot, proof)
+	s.Require().NoError(err)
+	s.True(valid)
+}
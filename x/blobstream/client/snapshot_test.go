@@ -0,0 +1,56 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/client"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEVMSnapshotValidateBasic(t *testing.T) {
+	root := ethcmn.HexToHash("0x01")
+	tests := []struct {
+		name     string
+		snapshot client.EVMSnapshot
+		wantErr  bool
+	}{
+		{
+			name: "valid snapshot",
+			snapshot: client.EVMSnapshot{DataRootTupleRoots: []client.DataRootTupleRootSnapshot{
+				{Nonce: 2, DataRootTupleRoot: root},
+				{Nonce: 4, DataRootTupleRoot: root},
+			}},
+		},
+		{
+			name:     "empty snapshot",
+			snapshot: client.EVMSnapshot{},
+			wantErr:  true,
+		},
+		{
+			name: "zero nonce",
+			snapshot: client.EVMSnapshot{DataRootTupleRoots: []client.DataRootTupleRootSnapshot{
+				{Nonce: 0, DataRootTupleRoot: root},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "unordered nonces",
+			snapshot: client.EVMSnapshot{DataRootTupleRoots: []client.DataRootTupleRootSnapshot{
+				{Nonce: 4, DataRootTupleRoot: root},
+				{Nonce: 2, DataRootTupleRoot: root},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.snapshot.ValidateBasic()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
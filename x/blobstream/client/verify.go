@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/celestiaorg/go-square/merkle"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcmn "github.com/ethereum/go-ethereum/common"
 
 	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
@@ -33,10 +35,64 @@ func VerifyCmd() *cobra.Command {
 		txCmd(),
 		sharesCmd(),
 		blobCmd(),
+		snapshotCmd(),
 	)
 	return command
 }
 
+func snapshotCmd() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "snapshot <from_nonce> <to_nonce>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Records the data root tuple roots committed to by the Blobstream contract, in the provided inclusive nonce range, to a JSON snapshot that can be used with --evm-snapshot",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromNonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			toNonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			evmRPC, err := cmd.Flags().GetString(evmRPCFlag)
+			if err != nil {
+				return err
+			}
+			contractAddr, err := cmd.Flags().GetString(contractAddressFlag)
+			if err != nil {
+				return err
+			}
+			if !ethcmn.IsHexAddress(contractAddr) {
+				return fmt.Errorf("valid contract address flag is required: %s", contractAddressFlag)
+			}
+
+			ethClient, err := ethclient.Dial(evmRPC)
+			if err != nil {
+				return err
+			}
+			defer ethClient.Close()
+			bsWrapper, err := wrapper.NewWrappers(ethcmn.HexToAddress(contractAddr), ethClient)
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := RecordEVMSnapshot(cmd.Context(), bsWrapper, fromNonce, toNonce)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(snapshot, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+	command.Flags().StringP(evmRPCFlag, "e", "http://localhost:8545", "The EVM RPC address")
+	command.Flags().StringP(contractAddressFlag, "a", "", "The contract address at which Blobstream is deployed")
+	return command
+}
+
 func txCmd() *cobra.Command {
 	command := &cobra.Command{
 		Use:   "tx <tx_hash>",
@@ -262,11 +318,12 @@ func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig,
 		}
 	}(bsGRPC)
 
-	queryClient := types.NewQueryClient(bsGRPC)
+	queryClient := types.NewProofQueryClient(bsGRPC)
 
-	resp, err := queryClient.DataCommitmentRangeForHeight(
+	logger.Debug("getting the data root to commitment inclusion proof")
+	resp, err := queryClient.DataRootTupleInclusionProof(
 		ctx,
-		&types.QueryDataCommitmentRangeForHeightRequest{Height: unsignedHeight},
+		&types.QueryDataRootTupleInclusionProofRequest{Height: unsignedHeight},
 	)
 	if err != nil {
 		return false, err
@@ -284,27 +341,15 @@ func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig,
 		resp.DataCommitment.Nonce,
 	)
 
-	logger.Debug("getting the data root to commitment inclusion proof")
-	dcProof, err := trpc.DataRootInclusionProof(ctx, unsignedHeight, resp.DataCommitment.BeginBlock, resp.DataCommitment.EndBlock)
-	if err != nil {
-		return false, err
-	}
-
-	block, err := trpc.Block(ctx, &height)
-	if err != nil {
-		return false, err
-	}
-
-	ethClient, err := ethclient.Dial(config.EVMRPC)
-	if err != nil {
-		return false, err
-	}
-	defer ethClient.Close()
-
-	bsWrapper, err := wrapper.NewWrappers(config.ContractAddr, ethClient)
+	bsWrapper, closeWrapper, err := newBlobstreamWrapper(ctx, config)
 	if err != nil {
 		return false, err
 	}
+	defer func() {
+		if err := closeWrapper(); err != nil {
+			logger.Debug("error closing the Blobstream contract backend", "err", err.Error())
+		}
+	}()
 
 	logger.Info("verifying that the data root was committed to in the Blobstream contract")
 	isCommittedTo, err = VerifyDataRootInclusion(
@@ -312,12 +357,12 @@ func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig,
 		bsWrapper,
 		resp.DataCommitment.Nonce,
 		height,
-		block.Block.DataHash,
+		resp.DataRoot,
 		merkle.Proof{
-			Total:    dcProof.Proof.Total,
-			Index:    dcProof.Proof.Index,
-			LeafHash: dcProof.Proof.LeafHash,
-			Aunts:    dcProof.Proof.Aunts,
+			Total:    resp.Proof.Total,
+			Index:    resp.Proof.Index,
+			LeafHash: resp.Proof.LeafHash,
+			Aunts:    resp.Proof.Aunts,
 		},
 	)
 	if err != nil {
@@ -333,6 +378,27 @@ func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig,
 	return isCommittedTo, nil
 }
 
+// newBlobstreamWrapper returns a binding to the Blobstream contract defined by
+// the config along with a function that releases its backend. If an EVM
+// snapshot is configured, the contract is a local stand-in loaded with the
+// snapshot.
+func newBlobstreamWrapper(ctx context.Context, config VerifyConfig) (*wrapper.Wrappers, func() error, error) {
+	if config.EVMSnapshot != "" {
+		return newSnapshotWrapper(ctx, config.EVMSnapshot)
+	}
+
+	ethClient, err := ethclient.Dial(config.EVMRPC)
+	if err != nil {
+		return nil, nil, err
+	}
+	bsWrapper, err := wrapper.NewWrappers(config.ContractAddr, ethClient)
+	if err != nil {
+		ethClient.Close()
+		return nil, nil, err
+	}
+	return bsWrapper, func() error { ethClient.Close(); return nil }, nil
+}
+
 func VerifyDataRootInclusion(
 	_ context.Context,
 	bsWrapper *wrapper.Wrappers,
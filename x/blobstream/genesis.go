@@ -24,12 +24,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// set it once here rather than conditionally setting it in abci.EndBlocker
 	// which is executed on every block.
 	k.SetEarliestAvailableAttestationNonce(ctx, InitialEarliestAvailableAttestationNonce)
-	k.SetParams(ctx, *genState.Params)
+	k.InitParams(ctx, *genState.Params)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	genesis := types.DefaultGenesis()
-	genesis.Params.DataCommitmentWindow = k.GetDataCommitmentWindowParam(ctx)
-	return genesis
+	params := k.GetParams(ctx)
+	return &types.GenesisState{
+		Params: &params,
+	}
 }
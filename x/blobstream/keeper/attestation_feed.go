@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"sync"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// attestationFeedBufferSize is the number of attestations that can be queued
+// for a subscriber before it is considered too slow and dropped.
+const attestationFeedBufferSize = 100
+
+// QueryContextFn returns a context that can be used to query the state at the
+// provided height. A height of zero refers to the latest committed height.
+// It matches the signature of BaseApp.CreateQueryContext.
+type QueryContextFn func(height int64, prove bool) (sdk.Context, error)
+
+// attestationFeed fans out the attestations created by the EndBlocker to the
+// attestation request streams once they are committed. It is shared by all the
+// copies of the keeper.
+type attestationFeed struct {
+	mu           sync.Mutex
+	queryContext QueryContextFn
+	subscribers  map[*attestationSubscription]struct{}
+	// queued are the attestations created by the block being executed. They
+	// are published once the block is committed.
+	queued []types.AttestationRequestI
+}
+
+// attestationSubscription receives the attestations published to the feed.
+type attestationSubscription struct {
+	attestations chan types.AttestationRequestI
+	// dropped is closed if the subscriber falls behind by more than
+	// attestationFeedBufferSize attestations.
+	dropped chan struct{}
+}
+
+func newAttestationFeed() *attestationFeed {
+	return &attestationFeed{
+		subscribers: make(map[*attestationSubscription]struct{}),
+	}
+}
+
+func (f *attestationFeed) setQueryContextFn(fn QueryContextFn) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.queryContext = fn
+}
+
+func (f *attestationFeed) queryContextFn() QueryContextFn {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.queryContext
+}
+
+func (f *attestationFeed) subscribe() *attestationSubscription {
+	sub := &attestationSubscription{
+		attestations: make(chan types.AttestationRequestI, attestationFeedBufferSize),
+		dropped:      make(chan struct{}),
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.subscribers[sub] = struct{}{}
+	return sub
+}
+
+func (f *attestationFeed) unsubscribe(sub *attestationSubscription) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.subscribers, sub)
+}
+
+func (f *attestationFeed) queue(at types.AttestationRequestI) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.queued = append(f.queued, at)
+}
+
+// publishQueued sends the queued attestations to every subscriber without
+// blocking. Subscribers whose buffer is full are dropped.
+func (f *attestationFeed) publishQueued() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, at := range f.queued {
+		for sub := range f.subscribers {
+			select {
+			case sub.attestations <- at:
+			default:
+				close(sub.dropped)
+				delete(f.subscribers, sub)
+			}
+		}
+	}
+	f.queued = nil
+}
+
+// SetQueryContextFn sets the function used by the attestation request streams
+// to read the committed state. Streaming is disabled until it is set.
+func (k Keeper) SetQueryContextFn(fn QueryContextFn) {
+	k.attestationFeed.setQueryContextFn(fn)
+}
+
+// QueueAttestation queues a new attestation to be sent to the attestation
+// request streams once the block that created it is committed. It should only
+// be called from the EndBlocker.
+func (k Keeper) QueueAttestation(at types.AttestationRequestI) {
+	k.attestationFeed.queue(at)
+}
+
+// PublishQueuedAttestations sends the queued attestations to the attestation
+// request streams. It must be called after the block that created them is
+// committed so that the streams can read them from the committed state.
+func (k Keeper) PublishQueuedAttestations() {
+	k.attestationFeed.publishQueued()
+}
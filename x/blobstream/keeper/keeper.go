@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -19,6 +20,10 @@ type Keeper struct {
 	paramSpace paramtypes.Subspace
 
 	StakingKeeper StakingKeeper
+
+	// attestationFeed is a pointer so that the attestations published by the
+	// EndBlocker reach the streams served by every copy of the keeper.
+	attestationFeed *attestationFeed
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, stakingKeeper StakingKeeper) *Keeper {
@@ -32,20 +37,58 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace p
 		storeKey:      storeKey,
 		StakingKeeper: stakingKeeper,
 		paramSpace:    paramSpace,
+
+		attestationFeed: newAttestationFeed(),
 	}
 }
 
-// GetParams returns the parameters from the store
+// GetParams returns the parameters from the store. The params that were added
+// after genesis and are not in the store are set to their default values.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
-	return params
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params.WithDefaults()
 }
 
-// SetParams sets the parameters in the store
+// SetParams sets the parameters in the store. It panics if any of them is
+// invalid.
 func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
 	k.paramSpace.SetParamSet(ctx, &ps)
 }
 
+// InitParams sets the parameters of a genesis state in the store. The params
+// that were added after genesis are only written if they are set, so that
+// importing a genesis file written before they were added doesn't change the
+// resulting state. They fall back to their default values until they are set
+// via governance.
+func (k Keeper) InitParams(ctx sdk.Context, ps types.Params) {
+	if err := ps.ValidateBasic(); err != nil {
+		panic(err)
+	}
+	k.paramSpace.Set(ctx, types.ParamsStoreKeyDataCommitmentWindow, ps.DataCommitmentWindow)
+	if !ps.SignificantPowerDifferenceThreshold.IsNil() && !ps.SignificantPowerDifferenceThreshold.IsZero() {
+		k.paramSpace.Set(ctx, types.ParamsStoreKeySignificantPowerDifferenceThreshold, ps.SignificantPowerDifferenceThreshold)
+	}
+	if ps.AttestationExpiryTime != 0 {
+		k.paramSpace.Set(ctx, types.ParamsStoreKeyAttestationExpiryTime, ps.AttestationExpiryTime)
+	}
+}
+
+// GetSignificantPowerDifferenceThresholdParam returns the threshold of change
+// in the validator set power that triggers the creation of a new valset.
+func (k Keeper) GetSignificantPowerDifferenceThresholdParam(ctx sdk.Context) sdk.Dec {
+	threshold := types.DefaultSignificantPowerDifferenceThreshold
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeySignificantPowerDifferenceThreshold, &threshold)
+	return threshold
+}
+
+// GetAttestationExpiryTimeParam returns the time after which an attestation
+// is pruned from state.
+func (k Keeper) GetAttestationExpiryTimeParam(ctx sdk.Context) time.Duration {
+	expiryTime := types.DefaultAttestationExpiryTime
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyAttestationExpiryTime, &expiryTime)
+	return expiryTime
+}
+
 // DeserializeValidatorIterator returns validators from the validator iterator.
 // Adding here in Blobstream keeper as cdc is not available inside endblocker.
 func (k Keeper) DeserializeValidatorIterator(vals []byte) stakingtypes.ValAddresses {
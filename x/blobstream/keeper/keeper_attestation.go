@@ -125,8 +125,8 @@ func (k Keeper) GetAttestationByNonce(ctx sdk.Context, nonce uint64) (types.Atte
 	return at, true, nil
 }
 
-// DeleteAttestation deletes an attestation from state. Will do nothing if the
-// attestation doesn't exist in store.
+// DeleteAttestation deletes an attestation, along with its confirms, from
+// state. Will do nothing if the attestation doesn't exist in store.
 func (k Keeper) DeleteAttestation(ctx sdk.Context, nonce uint64) {
 	key := []byte(types.GetAttestationKey(nonce))
 	store := ctx.KVStore(k.storeKey)
@@ -136,4 +136,5 @@ func (k Keeper) DeleteAttestation(ctx sdk.Context, nonce uint64) {
 		return
 	}
 	store.Delete(key)
+	k.DeleteAttestationConfirms(ctx, nonce)
 }
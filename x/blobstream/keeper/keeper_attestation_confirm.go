@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// SetAttestationConfirm stores the confirm of a validator for an attestation
+// request. It overwrites any previous confirm of the same validator for the
+// same nonce.
+func (k Keeper) SetAttestationConfirm(ctx sdk.Context, valAddress sdk.ValAddress, confirm types.AttestationConfirm) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAttestationConfirmKey(confirm.Nonce, valAddress), k.cdc.MustMarshal(&confirm))
+}
+
+// GetAttestationConfirm returns the confirm of a validator for the attestation
+// request at nonce. Returns false if the validator has not confirmed it.
+func (k Keeper) GetAttestationConfirm(ctx sdk.Context, nonce uint64, valAddress sdk.ValAddress) (types.AttestationConfirm, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAttestationConfirmKey(nonce, valAddress))
+	if bz == nil {
+		return types.AttestationConfirm{}, false
+	}
+	var confirm types.AttestationConfirm
+	k.cdc.MustUnmarshal(bz, &confirm)
+	return confirm, true
+}
+
+// GetAttestationConfirms returns all the confirms for the attestation request
+// at nonce ordered by validator address.
+func (k Keeper) GetAttestationConfirms(ctx sdk.Context, nonce uint64) []types.AttestationConfirm {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetAttestationConfirmPrefix(nonce))
+	defer iterator.Close()
+
+	confirms := []types.AttestationConfirm{}
+	for ; iterator.Valid(); iterator.Next() {
+		var confirm types.AttestationConfirm
+		k.cdc.MustUnmarshal(iterator.Value(), &confirm)
+		confirms = append(confirms, confirm)
+	}
+	return confirms
+}
+
+// DeleteAttestationConfirms deletes all the confirms for the attestation
+// request at nonce.
+func (k Keeper) DeleteAttestationConfirms(ctx sdk.Context, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetAttestationConfirmPrefix(nonce))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetSigningValset returns the valset whose members are expected to sign the
+// attestation request at nonce. This is the latest valset before nonce, as it
+// is the one the Blobstream contract checks the signatures against, except for
+// the first valset which has no valset before it and is signed by its own
+// members.
+func (k Keeper) GetSigningValset(ctx sdk.Context, nonce uint64) (*types.Valset, error) {
+	if nonce == 1 {
+		at, found, err := k.GetAttestationByNonce(ctx, nonce)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, types.ErrAttestationNotFound
+		}
+		valset, ok := at.(*types.Valset)
+		if !ok {
+			return nil, types.ErrAttestationNotValsetRequest
+		}
+		return valset, nil
+	}
+	return k.GetLatestValsetBeforeNonce(ctx, nonce)
+}
+
+// GetAttestationSignedPower returns the normalized power of the members of
+// the signing valset that confirmed the attestation request at nonce along
+// with the power needed for the Blobstream contract to accept it. Confirms of
+// data commitments are grouped by data root tuple root and only the power of
+// the root signed by the most power is returned, along with that root.
+func (k Keeper) GetAttestationSignedPower(ctx sdk.Context, nonce uint64) (signedPower, twoThirdsThreshold uint64, dataRootTupleRoot string, err error) {
+	valset, err := k.GetSigningValset(ctx, nonce)
+	if err != nil {
+		return 0, 0, "", err
+	}
+	powers := make(map[gethcommon.Address]uint64, len(valset.Members))
+	for _, member := range valset.Members {
+		powers[gethcommon.HexToAddress(member.EvmAddress)] = member.Power
+	}
+
+	powerByRoot := make(map[string]uint64)
+	for _, confirm := range k.GetAttestationConfirms(ctx, nonce) {
+		powerByRoot[confirm.DataRootTupleRoot] += powers[gethcommon.HexToAddress(confirm.EvmAddress)]
+	}
+	for root, power := range powerByRoot {
+		// break ties by root so that the result doesn't depend on the map
+		// iteration order.
+		if power > signedPower || (power == signedPower && root < dataRootTupleRoot) {
+			signedPower, dataRootTupleRoot = power, root
+		}
+	}
+	return signedPower, valset.TwoThirdsThreshold(), dataRootTupleRoot, nil
+}
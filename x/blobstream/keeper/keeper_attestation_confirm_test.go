@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/keeper"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitAttestationConfirm(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	keys := registerEVMKeys(t, ctx, k)
+
+	// create the first valset at nonce 1 and a data commitment at nonce 2.
+	window := int64(k.GetDataCommitmentWindowParam(ctx))
+	ctx = testutil.ExecuteBlobstreamHeights(ctx, k, 1, window+2)
+	require.Equal(t, uint64(2), k.GetLatestAttestationNonce(ctx))
+
+	at, found, err := k.GetAttestationByNonce(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, found)
+	valsetSignBytes, err := at.(*types.Valset).SignBytes()
+	require.NoError(t, err)
+	rootA := gethcommon.HexToHash("0x01")
+	rootB := gethcommon.HexToHash("0x02")
+
+	t.Run("valset confirms", func(t *testing.T) {
+		msg := types.NewMsgSubmitAttestationConfirm(testutil.ValAddrs[0], 1, "", sign(t, keys[0], valsetSignBytes))
+		_, err := k.SubmitAttestationConfirm(ctx, msg)
+		require.NoError(t, err)
+
+		// a validator can only confirm a nonce once.
+		_, err = k.SubmitAttestationConfirm(ctx, msg)
+		assert.ErrorIs(t, err, types.ErrDuplicate)
+
+		// the signature must be produced by the validator's EVM address.
+		msg = types.NewMsgSubmitAttestationConfirm(testutil.ValAddrs[1], 1, "", sign(t, keys[2], valsetSignBytes))
+		_, err = k.SubmitAttestationConfirm(ctx, msg)
+		assert.ErrorIs(t, err, types.ErrInvalidSignature)
+
+		// valset confirms don't contain a data root tuple root.
+		msg = types.NewMsgSubmitAttestationConfirm(testutil.ValAddrs[1], 1, rootA.Hex(), sign(t, keys[1], valsetSignBytes))
+		_, err = k.SubmitAttestationConfirm(ctx, msg)
+		assert.ErrorIs(t, err, types.ErrInvalidDataRootTupleRoot)
+
+		confirms := k.GetAttestationConfirms(ctx, 1)
+		require.Len(t, confirms, 1)
+		assert.Equal(t, testutil.ValAddrs[0].String(), confirms[0].ValidatorAddress)
+		assert.Equal(t, crypto.PubkeyToAddress(keys[0].PublicKey).Hex(), confirms[0].EvmAddress)
+	})
+
+	t.Run("data commitment confirms", func(t *testing.T) {
+		for i, key := range keys {
+			root := rootA
+			if i == len(keys)-1 {
+				root = rootB
+			}
+			signBytes := types.DataCommitmentTupleRootSignBytes(2, root)
+			msg := types.NewMsgSubmitAttestationConfirm(testutil.ValAddrs[i], 2, root.Hex(), sign(t, key, signBytes))
+			_, err := k.SubmitAttestationConfirm(ctx, msg)
+			require.NoError(t, err)
+		}
+
+		// a data commitment confirm must contain the signed data root tuple
+		// root.
+		_, err := k.SubmitAttestationConfirm(ctx, types.NewMsgSubmitAttestationConfirm(testutil.ValAddrs[0], 2, "", sign(t, keys[0], valsetSignBytes)))
+		assert.Error(t, err)
+
+		resp, err := k.AttestationSignedPower(ctx, &types.QueryAttestationSignedPowerRequest{Nonce: 2})
+		require.NoError(t, err)
+		valset, err := k.GetSigningValset(ctx, 2)
+		require.NoError(t, err)
+		// every member except the one that signed rootB.
+		rootBSigner := crypto.PubkeyToAddress(keys[len(keys)-1].PublicKey)
+		var expectedPower uint64
+		for _, member := range valset.Members {
+			if gethcommon.HexToAddress(member.EvmAddress) != rootBSigner {
+				expectedPower += member.Power
+			}
+		}
+		assert.Equal(t, expectedPower, resp.SignedPower)
+		assert.Equal(t, valset.TwoThirdsThreshold(), resp.TwoThirdsThreshold)
+		assert.Equal(t, rootA.Hex(), resp.DataRootTupleRoot)
+		assert.Greater(t, resp.SignedPower, resp.TwoThirdsThreshold)
+	})
+
+	t.Run("confirms are pruned with their attestation", func(t *testing.T) {
+		require.Len(t, k.GetAttestationConfirms(ctx, 2), len(keys))
+		k.DeleteAttestation(ctx, 2)
+		assert.Empty(t, k.GetAttestationConfirms(ctx, 2))
+	})
+}
+
+func TestSubmitAttestationConfirmNotFound(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	keys := registerEVMKeys(t, ctx, k)
+	blobstream.EndBlocker(ctx.WithBlockHeight(1), k)
+
+	msg := types.NewMsgSubmitAttestationConfirm(testutil.ValAddrs[0], 2, "", sign(t, keys[0], gethcommon.Hash{}))
+	_, err := k.SubmitAttestationConfirm(ctx, msg)
+	assert.ErrorIs(t, err, types.ErrAttestationNotFound)
+}
+
+// registerEVMKeys replaces the EVM addresses of the test validators with the
+// addresses of freshly generated keys and returns the keys.
+func registerEVMKeys(t *testing.T, ctx sdk.Context, k keeper.Keeper) []*ecdsa.PrivateKey {
+	keys := make([]*ecdsa.PrivateKey, 5)
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		k.SetEVMAddress(ctx, testutil.ValAddrs[i], crypto.PubkeyToAddress(key.PublicKey))
+		keys[i] = key
+	}
+	return keys
+}
+
+// sign returns the signature of key over the EIP-191 hash of signBytes, with V
+// set to 27 or 28 as expected by the Blobstream contracts.
+func sign(t *testing.T, key *ecdsa.PrivateKey, signBytes gethcommon.Hash) []byte {
+	sig, err := crypto.Sign(accounts.TextHash(signBytes.Bytes()), key)
+	require.NoError(t, err)
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig
+}
@@ -2,12 +2,14 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/errors"
 	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 var _ types.MsgServer = msgServer{}
@@ -46,3 +48,102 @@ func (k Keeper) RegisterEVMAddress(goCtx context.Context, msg *types.MsgRegister
 
 	return &types.MsgRegisterEVMAddressResponse{}, nil
 }
+
+// SubmitAttestationConfirm verifies that the signature in the message was
+// produced by the EVM address registered for the validator over the sign bytes
+// of the attestation request, and that this EVM address is a member of the
+// valset expected to sign it. It then stores the confirm. A validator can only
+// confirm an attestation request once.
+func (k Keeper) SubmitAttestationConfirm(goCtx context.Context, msg *types.MsgSubmitAttestationConfirm) (*types.MsgSubmitAttestationConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := types.ParseEVMSignature(msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, exists := k.StakingKeeper.GetValidator(ctx, valAddr); !exists {
+		return nil, staking.ErrNoValidatorFound
+	}
+	evmAddr, exists := k.GetEVMAddress(ctx, valAddr)
+	if !exists {
+		return nil, errors.Wrapf(types.ErrEVMAddressNotFound, "validator %s", msg.ValidatorAddress)
+	}
+	if _, found := k.GetAttestationConfirm(ctx, msg.Nonce, valAddr); found {
+		return nil, errors.Wrapf(types.ErrDuplicate, "validator %s already confirmed nonce %d", msg.ValidatorAddress, msg.Nonce)
+	}
+
+	at, found, err := k.GetAttestationByNonce(ctx, msg.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.Wrapf(types.ErrAttestationNotFound, "nonce %d", msg.Nonce)
+	}
+
+	var (
+		signBytes         gethcommon.Hash
+		dataRootTupleRoot string
+	)
+	switch at := at.(type) {
+	case *types.Valset:
+		if msg.DataRootTupleRoot != "" {
+			return nil, errors.Wrap(types.ErrInvalidDataRootTupleRoot, "valset confirms must not contain a data root tuple root")
+		}
+		signBytes, err = at.SignBytes()
+		if err != nil {
+			return nil, err
+		}
+	case *types.DataCommitment:
+		root, err := types.ParseDataRootTupleRoot(msg.DataRootTupleRoot)
+		if err != nil {
+			return nil, err
+		}
+		signBytes = types.DataCommitmentTupleRootSignBytes(at.Nonce, root)
+		dataRootTupleRoot = root.Hex()
+	default:
+		return nil, errors.Wrapf(types.ErrUnknownAttestationType, "nonce %d", msg.Nonce)
+	}
+
+	if err := types.VerifyEVMSignature(signBytes, signature, evmAddr); err != nil {
+		return nil, err
+	}
+
+	valset, err := k.GetSigningValset(ctx, msg.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	isMember := false
+	for _, member := range valset.Members {
+		if gethcommon.HexToAddress(member.EvmAddress) == evmAddr {
+			isMember = true
+			break
+		}
+	}
+	if !isMember {
+		return nil, errors.Wrapf(types.ErrNotValsetMember, "address %s, valset nonce %d", evmAddr.Hex(), valset.Nonce)
+	}
+
+	k.SetAttestationConfirm(ctx, valAddr, types.AttestationConfirm{
+		Nonce:             msg.Nonce,
+		ValidatorAddress:  msg.ValidatorAddress,
+		EvmAddress:        evmAddr.Hex(),
+		DataRootTupleRoot: dataRootTupleRoot,
+		Signature:         hexutil.Encode(signature),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttestationConfirm,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(msg.Nonce)),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		),
+	)
+
+	return &types.MsgSubmitAttestationConfirmResponse{}, nil
+}
@@ -48,3 +48,141 @@ func (k Keeper) LatestAttestationNonce(
 		Nonce: k.GetLatestAttestationNonce(sdk.UnwrapSDKContext(ctx)),
 	}, nil
 }
+
+// AttestationRequests streams the attestation requests in increasing nonce
+// order. It first sends the attestations that are already committed, starting
+// at request.StartNonce or at the earliest available attestation nonce if no
+// start nonce is provided, and then sends every new attestation created by the
+// EndBlocker once it is committed.
+func (k Keeper) AttestationRequests(
+	request *types.QueryAttestationRequestsRequest,
+	stream types.Query_AttestationRequestsServer,
+) error {
+	queryContext := k.attestationFeed.queryContextFn()
+	if queryContext == nil {
+		return types.ErrAttestationStreamDisabled
+	}
+
+	// subscribe before reading the store so that the attestations created
+	// while sending the stored ones are not missed.
+	sub := k.attestationFeed.subscribe()
+	defer k.attestationFeed.unsubscribe(sub)
+
+	ctx, err := queryContext(0, false)
+	if err != nil {
+		return err
+	}
+	next := request.StartNonce
+	if k.CheckEarliestAvailableAttestationNonce(ctx) {
+		earliestNonce := k.GetEarliestAvailableAttestationNonce(ctx)
+		if next == 0 {
+			next = earliestNonce
+		} else if next < earliestNonce {
+			return types.ErrRequestedNonceWasPruned.Wrapf("nonce %d, earliest available nonce %d", next, earliestNonce)
+		}
+	}
+	if next == 0 {
+		// no attestation has been created yet so the first one will have
+		// nonce 1.
+		next = 1
+	}
+	if k.CheckLatestAttestationNonce(ctx) {
+		next, err = k.sendStoredAttestations(ctx, stream, next, k.GetLatestAttestationNonce(ctx))
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-sub.dropped:
+			return types.ErrAttestationStreamTooSlow.Wrapf("resume from nonce %d", next)
+		case at := <-sub.attestations:
+			nonce := at.GetNonce()
+			if nonce < next {
+				// already sent from the store.
+				continue
+			}
+			if nonce > next {
+				// the missing attestations were committed after the store was
+				// read. Attestations are only published once committed, so
+				// they can be read from the store now.
+				ctx, err := queryContext(0, false)
+				if err != nil {
+					return err
+				}
+				next, err = k.sendStoredAttestations(ctx, stream, next, nonce-1)
+				if err != nil {
+					return err
+				}
+			}
+			if err := sendAttestation(stream, at); err != nil {
+				return err
+			}
+			next = nonce + 1
+		}
+	}
+}
+
+// sendStoredAttestations sends the attestations with nonces in [from, to] from
+// the store and returns the nonce of the next attestation to send.
+func (k Keeper) sendStoredAttestations(
+	ctx sdk.Context,
+	stream types.Query_AttestationRequestsServer,
+	from, to uint64,
+) (uint64, error) {
+	for nonce := from; nonce <= to; nonce++ {
+		attestation, found, err := k.GetAttestationByNonce(ctx, nonce)
+		if err != nil {
+			return nonce, err
+		}
+		if !found {
+			return nonce, types.ErrAttestationNotFound.Wrapf("nonce %d", nonce)
+		}
+		if err := sendAttestation(stream, attestation); err != nil {
+			return nonce, err
+		}
+	}
+	return to + 1, nil
+}
+
+func sendAttestation(stream types.Query_AttestationRequestsServer, at types.AttestationRequestI) error {
+	val, err := codectypes.NewAnyWithValue(at)
+	if err != nil {
+		return err
+	}
+	return stream.Send(&types.QueryAttestationRequestsResponse{
+		Attestation: val,
+	})
+}
+
+// AttestationConfirms queries the confirms submitted for the attestation
+// request at nonce.
+func (k Keeper) AttestationConfirms(
+	ctx context.Context,
+	request *types.QueryAttestationConfirmsRequest,
+) (*types.QueryAttestationConfirmsResponse, error) {
+	return &types.QueryAttestationConfirmsResponse{
+		Confirms: k.GetAttestationConfirms(sdk.UnwrapSDKContext(ctx), request.Nonce),
+	}, nil
+}
+
+// AttestationSignedPower queries the power that confirmed the attestation
+// request at nonce along with the power needed for the Blobstream contract to
+// accept it.
+func (k Keeper) AttestationSignedPower(
+	ctx context.Context,
+	request *types.QueryAttestationSignedPowerRequest,
+) (*types.QueryAttestationSignedPowerResponse, error) {
+	signedPower, twoThirdsThreshold, dataRootTupleRoot, err := k.GetAttestationSignedPower(sdk.UnwrapSDKContext(ctx), request.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAttestationSignedPowerResponse{
+		SignedPower:        signedPower,
+		TwoThirdsThreshold: twoThirdsThreshold,
+		DataRootTupleRoot:  dataRootTupleRoot,
+	}, nil
+}
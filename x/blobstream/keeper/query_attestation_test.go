@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/keeper"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	"google.golang.org/grpc"
+)
+
+func TestAttestationRequests(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	k.SetQueryContextFn(func(int64, bool) (sdk.Context, error) { return ctx, nil })
+	window := int64(k.GetDataCommitmentWindowParam(ctx))
+
+	// creates a valset at height 1 and data commitments at heights window + 1
+	// and 2 * window + 1.
+	ctx = testutil.ExecuteBlobstreamHeights(ctx, k, 1, 2*window+2)
+	k.PublishQueuedAttestations()
+	require.Equal(t, uint64(3), k.GetLatestAttestationNonce(ctx))
+
+	t.Run("sends the stored attestations then the new ones", func(t *testing.T) {
+		stream, errCh := startAttestationStream(t, k, 0)
+		assert.Equal(t, []uint64{1, 2, 3}, stream.receive(t, 3))
+
+		testutil.ExecuteBlobstreamHeights(ctx, k, 2*window+2, 3*window+2)
+		k.PublishQueuedAttestations()
+		assert.Equal(t, []uint64{4}, stream.receive(t, 1))
+
+		stream.cancel()
+		assert.ErrorIs(t, <-errCh, context.Canceled)
+	})
+
+	t.Run("resumes from the provided nonce", func(t *testing.T) {
+		stream, errCh := startAttestationStream(t, k, 3)
+		assert.Equal(t, []uint64{3, 4}, stream.receive(t, 2))
+
+		testutil.ExecuteBlobstreamHeights(ctx, k, 3*window+2, 4*window+2)
+		k.PublishQueuedAttestations()
+		assert.Equal(t, []uint64{5}, stream.receive(t, 1))
+
+		stream.cancel()
+		assert.ErrorIs(t, <-errCh, context.Canceled)
+	})
+
+	t.Run("returns an error if the start nonce was pruned", func(t *testing.T) {
+		k.SetEarliestAvailableAttestationNonce(ctx, 2)
+		defer k.SetEarliestAvailableAttestationNonce(ctx, 1)
+
+		_, errCh := startAttestationStream(t, k, 1)
+		assert.ErrorIs(t, <-errCh, types.ErrRequestedNonceWasPruned)
+	})
+}
+
+// TestAttestationRequestsCommitted verifies that the attestations are only
+// streamed once their block is committed, reading the stored ones from the
+// committed state of an app.
+func TestAttestationRequestsCommitted(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultInitialConsensusParams())
+	k := testApp.BlobstreamKeeper
+
+	// the first block creates the first valset.
+	testApp.EndBlock(abci.RequestEndBlock{Height: testApp.LastBlockHeight() + 1})
+	testApp.Commit()
+
+	stream, errCh := startAttestationStream(t, k, 0)
+	assert.Equal(t, []uint64{1}, stream.receive(t, 1))
+
+	// lowering the data commitment window makes a single block create the
+	// data commitments of two windows.
+	for testApp.LastBlockHeight()+1 < 2*types.MinimumDataCommitmentWindow+1 {
+		executeBlock(testApp, nil)
+		testApp.Commit()
+	}
+	executeBlock(testApp, func(ctx sdk.Context) {
+		params := k.GetParams(ctx)
+		params.DataCommitmentWindow = types.MinimumDataCommitmentWindow
+		k.SetParams(ctx, params)
+	})
+	stream.expectNone(t)
+
+	testApp.Commit()
+	assert.Equal(t, []uint64{2, 3}, stream.receive(t, 2))
+
+	// a subscriber that starts after the commit reads them from the store.
+	late, lateErrCh := startAttestationStream(t, k, 2)
+	assert.Equal(t, []uint64{2, 3}, late.receive(t, 2))
+
+	stream.cancel()
+	late.cancel()
+	assert.ErrorIs(t, <-errCh, context.Canceled)
+	assert.ErrorIs(t, <-lateErrCh, context.Canceled)
+}
+
+// executeBlock begins and ends the next block without committing it. The
+// provided function, if any, is run in the context of the block.
+func executeBlock(testApp *app.App, fn func(ctx sdk.Context)) {
+	height := testApp.LastBlockHeight() + 1
+	header := tmproto.Header{Height: height, Version: tmversion.Consensus{App: 1}}
+	testApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	if fn != nil {
+		fn(testApp.NewContext(false, header))
+	}
+	testApp.EndBlock(abci.RequestEndBlock{Height: height})
+}
+
+func TestAttestationRequestsDisabled(t *testing.T) {
+	input, _ := testutil.SetupFiveValChain(t)
+
+	_, errCh := startAttestationStream(t, input.BlobstreamKeeper, 0)
+	assert.ErrorIs(t, <-errCh, types.ErrAttestationStreamDisabled)
+}
+
+// startAttestationStream serves an attestation request stream starting at
+// startNonce in the background. The returned channel receives the error that
+// ended the stream.
+func startAttestationStream(t *testing.T, k keeper.Keeper, startNonce uint64) (*mockAttestationStream, <-chan error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	stream := &mockAttestationStream{
+		ctx:       ctx,
+		cancel:    cancel,
+		responses: make(chan *types.QueryAttestationRequestsResponse, 10),
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- k.AttestationRequests(&types.QueryAttestationRequestsRequest{StartNonce: startNonce}, stream)
+	}()
+	return stream, errCh
+}
+
+type mockAttestationStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	cancel    context.CancelFunc
+	responses chan *types.QueryAttestationRequestsResponse
+}
+
+var _ types.Query_AttestationRequestsServer = &mockAttestationStream{}
+
+func (m *mockAttestationStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockAttestationStream) Send(resp *types.QueryAttestationRequestsResponse) error {
+	m.responses <- resp
+	return nil
+}
+
+// expectNone fails if an attestation is received shortly.
+func (m *mockAttestationStream) expectNone(t *testing.T) {
+	select {
+	case resp := <-m.responses:
+		t.Fatalf("unexpected attestation %v", resp.Attestation)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// receive waits for count attestations and returns their nonces.
+func (m *mockAttestationStream) receive(t *testing.T, count int) []uint64 {
+	nonces := make([]uint64, 0, count)
+	for len(nonces) < count {
+		select {
+		case resp := <-m.responses:
+			at, ok := resp.Attestation.GetCachedValue().(types.AttestationRequestI)
+			require.True(t, ok)
+			nonces = append(nonces, at.GetNonce())
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for attestations, received %v", nonces)
+		}
+	}
+	return nonces
+}
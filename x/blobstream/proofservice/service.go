@@ -0,0 +1,118 @@
+// Package proofservice serves the Blobstream data root tuple inclusion proofs.
+// The proofs are computed by the node rather than by the module because the
+// data roots of past blocks are not kept in the Blobstream state: they are read
+// from the block store of the node.
+package proofservice
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// BlockchainInfoClient returns the metadata of the blocks in the inclusive
+// range [minHeight, maxHeight], in descending height order. The number of
+// blocks returned can be limited by the implementation. It is implemented by
+// the Tendermint RPC clients.
+type BlockchainInfoClient interface {
+	BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*coretypes.ResultBlockchainInfo, error)
+}
+
+// RegisterProofService registers the ProofQuery service on the gRPC router
+// of the node. The data commitments are queried from the committed state of
+// the app and the data roots from the node, both through clientCtx.
+func RegisterProofService(clientCtx client.Context, server gogogrpc.Server) {
+	types.RegisterProofQueryServer(server, NewQueryServer(types.NewQueryClient(clientCtx), clientCtx.Client))
+}
+
+// RegisterGRPCGatewayRoutes mounts the ProofQuery service's GRPC-gateway
+// routes on the given mux object.
+func RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	_ = types.RegisterProofQueryHandlerClient(context.Background(), mux, types.NewProofQueryClient(clientCtx))
+}
+
+var _ types.ProofQueryServer = queryServer{}
+
+type queryServer struct {
+	queryClient types.QueryClient
+	blocks      BlockchainInfoClient
+}
+
+// NewQueryServer returns a ProofQuery server that reads the data commitments
+// from queryClient and the data roots from blocks.
+func NewQueryServer(queryClient types.QueryClient, blocks BlockchainInfoClient) types.ProofQueryServer {
+	return queryServer{queryClient: queryClient, blocks: blocks}
+}
+
+func (s queryServer) DataRootTupleInclusionProof(
+	ctx context.Context,
+	request *types.QueryDataRootTupleInclusionProofRequest,
+) (*types.QueryDataRootTupleInclusionProofResponse, error) {
+	resp, err := s.queryClient.DataCommitmentRangeForHeight(
+		ctx,
+		&types.QueryDataCommitmentRangeForHeightRequest{Height: request.Height},
+	)
+	if err != nil {
+		return nil, err
+	}
+	dataCommitment := resp.DataCommitment
+	dataRoots, err := s.getDataRoots(ctx, dataCommitment.BeginBlock, dataCommitment.EndBlock)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := types.ProveDataRootTuple(dataCommitment.BeginBlock, dataRoots, request.Height)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDataRootTupleInclusionProofResponse{
+		DataCommitment: dataCommitment,
+		Height:         request.Height,
+		DataRoot:       dataRoots[request.Height-dataCommitment.BeginBlock],
+		Proof:          proof.ToProto(),
+	}, nil
+}
+
+// getDataRoots returns the data roots of the blocks in the end exclusive range
+// [beginBlock, endBlock). dataRoots[i] is the data root of the block at height
+// beginBlock+i.
+func (s queryServer) getDataRoots(ctx context.Context, beginBlock, endBlock uint64) ([][]byte, error) {
+	if beginBlock == 0 || beginBlock >= endBlock {
+		return nil, types.ErrDataRootNotFound.Wrapf("invalid block range [%d, %d)", beginBlock, endBlock)
+	}
+
+	dataRoots := make([][]byte, endBlock-beginBlock)
+	// the client returns the block metas in descending order so the range is
+	// requested from its end until all the data roots are collected.
+	maxHeight := endBlock - 1
+	for maxHeight >= beginBlock {
+		res, err := s.blocks.BlockchainInfo(ctx, int64(beginBlock), int64(maxHeight))
+		if err != nil {
+			return nil, err
+		}
+		lowest := maxHeight + 1
+		for _, meta := range res.BlockMetas {
+			height := uint64(meta.Header.Height)
+			if height < beginBlock || height > maxHeight {
+				continue
+			}
+			dataRoots[height-beginBlock] = meta.Header.DataHash
+			lowest = min(lowest, height)
+		}
+		if lowest > maxHeight {
+			// none of the requested blocks were returned.
+			break
+		}
+		maxHeight = lowest - 1
+	}
+
+	for i, dataRoot := range dataRoots {
+		if dataRoot == nil {
+			return nil, types.ErrDataRootNotFound.Wrapf("height %d", beginBlock+uint64(i))
+		}
+	}
+	return dataRoots, nil
+}
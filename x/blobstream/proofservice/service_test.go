@@ -0,0 +1,108 @@
+package proofservice_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/keeper"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/proofservice"
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+)
+
+func TestDataRootTupleInclusionProof(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	window := k.GetDataCommitmentWindowParam(ctx)
+
+	// creates a valset at height 1 and data commitments for the ranges
+	// [1, window + 1) and [window + 1, 2 * window + 1).
+	ctx = testutil.ExecuteBlobstreamHeights(ctx, k, 1, int64(2*window+2))
+
+	blocks := &mockBlockchainInfoClient{storeHeight: int64(2*window + 1)}
+	server := proofservice.NewQueryServer(keeperQueryClient{ctx: ctx, k: k}, blocks)
+
+	for _, height := range []uint64{1, 10, window, window + 1, 2 * window} {
+		resp, err := server.DataRootTupleInclusionProof(ctx, &types.QueryDataRootTupleInclusionProofRequest{Height: height})
+		require.NoError(t, err)
+
+		expected, err := k.GetDataCommitmentForHeight(ctx, height)
+		require.NoError(t, err)
+		assert.Equal(t, expected, *resp.DataCommitment)
+		assert.Equal(t, height, resp.Height)
+		assert.Equal(t, mockDataRoot(height), resp.DataRoot)
+
+		dataRoots := make([][]byte, 0, window)
+		for h := resp.DataCommitment.BeginBlock; h < resp.DataCommitment.EndBlock; h++ {
+			dataRoots = append(dataRoots, mockDataRoot(h))
+		}
+		root, err := types.DataRootTupleRoot(resp.DataCommitment.BeginBlock, dataRoots)
+		require.NoError(t, err)
+		tuple, err := types.EncodeDataRootTuple(height, resp.DataRoot)
+		require.NoError(t, err)
+		proof, err := merkle.ProofFromProto(resp.Proof)
+		require.NoError(t, err)
+		assert.NoError(t, proof.Verify(root, tuple))
+	}
+
+	t.Run("returns an error if a block is not available", func(t *testing.T) {
+		blocks.storeHeight = int64(window) - 1
+		_, err := server.DataRootTupleInclusionProof(ctx, &types.QueryDataRootTupleInclusionProofRequest{Height: 10})
+		assert.ErrorIs(t, err, types.ErrDataRootNotFound)
+	})
+
+	t.Run("returns an error if the height is not in a data commitment", func(t *testing.T) {
+		blocks.storeHeight = int64(3 * window)
+		_, err := server.DataRootTupleInclusionProof(ctx, &types.QueryDataRootTupleInclusionProofRequest{Height: 2*window + 1})
+		assert.ErrorIs(t, err, types.ErrDataCommitmentNotFound)
+	})
+}
+
+// keeperQueryClient serves the Blobstream queries from the keeper, in place of
+// the queries to the committed state of the app.
+type keeperQueryClient struct {
+	types.QueryClient
+	ctx sdk.Context
+	k   keeper.Keeper
+}
+
+func (c keeperQueryClient) DataCommitmentRangeForHeight(
+	_ context.Context,
+	request *types.QueryDataCommitmentRangeForHeightRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryDataCommitmentRangeForHeightResponse, error) {
+	return c.k.DataCommitmentRangeForHeight(sdk.WrapSDKContext(c.ctx), request)
+}
+
+// mockBlockchainInfoClient mimics the Tendermint RPC BlockchainInfo endpoint,
+// which returns at most 20 blocks, in descending order, and limits the range
+// to the store height.
+type mockBlockchainInfoClient struct {
+	storeHeight int64
+}
+
+func (m *mockBlockchainInfoClient) BlockchainInfo(_ context.Context, minHeight, maxHeight int64) (*coretypes.ResultBlockchainInfo, error) {
+	maxHeight = min(maxHeight, m.storeHeight)
+	minHeight = max(minHeight, maxHeight-19)
+	metas := make([]*tmtypes.BlockMeta, 0, 20)
+	for h := maxHeight; h >= minHeight; h-- {
+		metas = append(metas, &tmtypes.BlockMeta{Header: tmtypes.Header{Height: h, DataHash: mockDataRoot(uint64(h))}})
+	}
+	return &coretypes.ResultBlockchainInfo{LastHeight: m.storeHeight, BlockMetas: metas}, nil
+}
+
+func mockDataRoot(height uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, height)
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
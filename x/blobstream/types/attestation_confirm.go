@@ -0,0 +1,58 @@
+package types
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ParseDataRootTupleRoot decodes a HEX encoded data root tuple root.
+func ParseDataRootTupleRoot(s string) (ethcmn.Hash, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return ethcmn.Hash{}, ErrInvalidDataRootTupleRoot.Wrap(err.Error())
+	}
+	if len(bz) != ethcmn.HashLength {
+		return ethcmn.Hash{}, ErrInvalidDataRootTupleRoot.Wrapf("expected %d bytes, got %d", ethcmn.HashLength, len(bz))
+	}
+	return ethcmn.BytesToHash(bz), nil
+}
+
+// ParseEVMSignature decodes a HEX encoded EVM signature in the [R || S || V]
+// format. V can either be 0/1 or 27/28.
+func ParseEVMSignature(s string) ([]byte, error) {
+	sig, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, ErrInvalidSignature.Wrap(err.Error())
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, ErrInvalidSignature.Wrapf("expected %d bytes, got %d", crypto.SignatureLength, len(sig))
+	}
+	return sig, nil
+}
+
+// VerifyEVMSignature returns an error if signature was not produced by
+// evmAddress over signBytes. Like the Blobstream contracts, it expects the
+// signature to be over the EIP-191 hash of signBytes.
+func VerifyEVMSignature(signBytes ethcmn.Hash, signature []byte, evmAddress ethcmn.Address) error {
+	if len(signature) != crypto.SignatureLength {
+		return ErrInvalidSignature.Wrapf("expected %d bytes, got %d", crypto.SignatureLength, len(signature))
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	// the contracts expect V to be 27 or 28 while go-ethereum expects 0 or 1.
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pubKey, err := crypto.SigToPub(accounts.TextHash(signBytes.Bytes()), sig)
+	if err != nil {
+		return ErrInvalidSignature.Wrap(err.Error())
+	}
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != evmAddress {
+		return ErrInvalidSignature.Wrapf("signed by %s instead of %s", signer.Hex(), evmAddress.Hex())
+	}
+	return nil
+}
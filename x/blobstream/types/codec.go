@@ -7,15 +7,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
-const URLMsgRegisterEVMAddress = "/celestia.blob.v1.MsgRegisterEVMAddress"
+const (
+	URLMsgRegisterEVMAddress       = "/celestia.blob.v1.MsgRegisterEVMAddress"
+	URLMsgSubmitAttestationConfirm = "/celestia.qgb.v1.MsgSubmitAttestationConfirm"
+)
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterEVMAddress{}, URLMsgRegisterEVMAddress, nil)
+	cdc.RegisterConcrete(&MsgSubmitAttestationConfirm{}, URLMsgSubmitAttestationConfirm, nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRegisterEVMAddress{},
+		&MsgSubmitAttestationConfirm{},
 	)
 
 	registry.RegisterInterface(
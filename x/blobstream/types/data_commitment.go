@@ -1,6 +1,13 @@
 package types
 
-import "time"
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
 
 var _ AttestationRequestI = &DataCommitment{}
 
@@ -22,3 +29,21 @@ func NewDataCommitment(
 func (m *DataCommitment) BlockTime() time.Time {
 	return m.Time
 }
+
+// DataCommitmentTupleRootSignBytes produces the bytes that celestia validators
+// are required to sign over when confirming a data commitment. It mimics the
+// 'domainSeparateDataRootTupleRoot' function used by the Blobstream contracts.
+func DataCommitmentTupleRootSignBytes(nonce uint64, dataRootTupleRoot ethcmn.Hash) ethcmn.Hash {
+	bytes, err := InternalBlobstreamABI.Pack(
+		"domainSeparateDataRootTupleRoot",
+		DcDomainSeparator,
+		new(big.Int).SetUint64(nonce),
+		dataRootTupleRoot,
+	)
+	// this should never happen as the inputs are always of the right types.
+	if err != nil {
+		panic(fmt.Sprintf("Error packing data root tuple root! %s/n", err))
+	}
+
+	return crypto.Keccak256Hash(bytes[4:])
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// DataRootSize is the size of a block data root in bytes.
+const DataRootSize = 32
+
+// EncodeDataRootTuple returns the ABI encoding of the (height, dataRoot) tuple,
+// i.e. the height left padded to 32 bytes followed by the data root. It mimics
+// `abi.encode(DataRootTuple)` in the Blobstream contracts and is the leaf
+// committed to by the data root tuple root of a data commitment.
+func EncodeDataRootTuple(height uint64, dataRoot []byte) ([]byte, error) {
+	if len(dataRoot) != DataRootSize {
+		return nil, fmt.Errorf("data root of height %d has size %d, expected %d", height, len(dataRoot), DataRootSize)
+	}
+	encoded := make([]byte, 32+DataRootSize)
+	binary.BigEndian.PutUint64(encoded[24:32], height)
+	copy(encoded[32:], dataRoot)
+	return encoded, nil
+}
+
+// DataRootTupleRoot returns the data root tuple root of the blocks in the end
+// exclusive range [beginBlock, beginBlock+len(dataRoots)). dataRoots[i] must
+// be the data root of the block at height beginBlock+i.
+func DataRootTupleRoot(beginBlock uint64, dataRoots [][]byte) ([]byte, error) {
+	tuples, err := encodeDataRootTuples(beginBlock, dataRoots)
+	if err != nil {
+		return nil, err
+	}
+	return merkle.HashFromByteSlices(tuples), nil
+}
+
+// ProveDataRootTuple returns the Merkle proof of the data root tuple of the
+// provided height against the data root tuple root of the blocks in the end
+// exclusive range [beginBlock, beginBlock+len(dataRoots)).
+func ProveDataRootTuple(beginBlock uint64, dataRoots [][]byte, height uint64) (*merkle.Proof, error) {
+	if height < beginBlock || height-beginBlock >= uint64(len(dataRoots)) {
+		return nil, fmt.Errorf("height %d is not in the range [%d, %d)", height, beginBlock, beginBlock+uint64(len(dataRoots)))
+	}
+	tuples, err := encodeDataRootTuples(beginBlock, dataRoots)
+	if err != nil {
+		return nil, err
+	}
+	_, proofs := merkle.ProofsFromByteSlices(tuples)
+	return proofs[height-beginBlock], nil
+}
+
+func encodeDataRootTuples(beginBlock uint64, dataRoots [][]byte) ([][]byte, error) {
+	tuples := make([][]byte, len(dataRoots))
+	for i, dataRoot := range dataRoots {
+		tuple, err := EncodeDataRootTuple(beginBlock+uint64(i), dataRoot)
+		if err != nil {
+			return nil, err
+		}
+		tuples[i] = tuple
+	}
+	return tuples, nil
+}
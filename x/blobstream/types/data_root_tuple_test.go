@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/rpc/core"
+)
+
+func TestEncodeDataRootTuple(t *testing.T) {
+	dataRoot := sha256.Sum256([]byte("data root"))
+	for _, height := range []uint64{1, 10, 255, 256, 1 << 40} {
+		// the encoding must match the one used by Tendermint to compute the
+		// data commitments.
+		expected, err := core.EncodeDataRootTuple(height, dataRoot)
+		require.NoError(t, err)
+		got, err := types.EncodeDataRootTuple(height, dataRoot[:])
+		require.NoError(t, err)
+		assert.Equal(t, expected, got)
+	}
+
+	_, err := types.EncodeDataRootTuple(1, dataRoot[:31])
+	assert.Error(t, err)
+}
+
+func TestProveDataRootTuple(t *testing.T) {
+	const beginBlock = 5
+	dataRoots := make([][]byte, 7)
+	for i := range dataRoots {
+		hash := sha256.Sum256([]byte{byte(i)})
+		dataRoots[i] = hash[:]
+	}
+	root, err := types.DataRootTupleRoot(beginBlock, dataRoots)
+	require.NoError(t, err)
+
+	for i, dataRoot := range dataRoots {
+		height := uint64(beginBlock + i)
+		proof, err := types.ProveDataRootTuple(beginBlock, dataRoots, height)
+		require.NoError(t, err)
+		tuple, err := types.EncodeDataRootTuple(height, dataRoot)
+		require.NoError(t, err)
+		assert.NoError(t, proof.Verify(root, tuple))
+		assert.Equal(t, int64(len(dataRoots)), proof.Total)
+	}
+
+	_, err = types.ProveDataRootTuple(beginBlock, dataRoots, beginBlock-1)
+	assert.Error(t, err)
+	_, err = types.ProveDataRootTuple(beginBlock, dataRoots, beginBlock+uint64(len(dataRoots)))
+	assert.Error(t, err)
+
+	// a proof for a height can't be used to prove another height.
+	proof, err := types.ProveDataRootTuple(beginBlock, dataRoots, beginBlock)
+	require.NoError(t, err)
+	tuple, err := types.EncodeDataRootTuple(beginBlock+1, dataRoots[0])
+	require.NoError(t, err)
+	assert.Error(t, proof.Verify(root, tuple))
+}
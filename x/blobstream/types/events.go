@@ -2,5 +2,7 @@ package types
 
 const (
 	EventTypeAttestationRequest = "AttestationRequest"
+	EventTypeAttestationConfirm = "AttestationConfirm"
 	AttributeKeyNonce           = "nonce"
+	AttributeKeyValidator       = "validator"
 )
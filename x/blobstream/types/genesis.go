@@ -2,10 +2,12 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
 
 	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -16,17 +18,53 @@ const (
 	// MinimumDataCommitmentWindow is a constant that defines the minimum
 	// allowable window for the Blobstream data commitments.
 	MinimumDataCommitmentWindow = 100
+
+	// DefaultDataCommitmentWindow is the default window for the Blobstream
+	// data commitments.
+	DefaultDataCommitmentWindow = 400
+
+	oneDay  = 24 * time.Hour
+	oneWeek = 7 * oneDay
+	// DefaultAttestationExpiryTime is the default expiration time of an
+	// attestation. When this much time has passed after an attestation has
+	// been published, it will be pruned from state.
+	DefaultAttestationExpiryTime = 3 * oneWeek // 3 weeks
 )
 
-// ParamsStoreKeyDataCommitmentWindow is the key used for the
-// DataCommitmentWindow param.
-var ParamsStoreKeyDataCommitmentWindow = []byte("DataCommitmentWindow")
+var (
+	// ParamsStoreKeyDataCommitmentWindow is the key used for the
+	// DataCommitmentWindow param.
+	ParamsStoreKeyDataCommitmentWindow = []byte("DataCommitmentWindow")
+	// ParamsStoreKeySignificantPowerDifferenceThreshold is the key used for
+	// the SignificantPowerDifferenceThreshold param.
+	ParamsStoreKeySignificantPowerDifferenceThreshold = []byte("SignificantPowerDifferenceThreshold")
+	// ParamsStoreKeyAttestationExpiryTime is the key used for the
+	// AttestationExpiryTime param.
+	ParamsStoreKeyAttestationExpiryTime = []byte("AttestationExpiryTime")
+
+	// DefaultSignificantPowerDifferenceThreshold is the default threshold of
+	// change in the validator set power that would trigger the creation of a
+	// new valset request.
+	DefaultSignificantPowerDifferenceThreshold = sdk.NewDecWithPrec(5, 2) // 0.05
+)
+
+// DefaultParams returns the default Blobstream params.
+func DefaultParams() Params {
+	return Params{
+		DataCommitmentWindow:                DefaultDataCommitmentWindow,
+		SignificantPowerDifferenceThreshold: DefaultSignificantPowerDifferenceThreshold,
+		AttestationExpiryTime:               DefaultAttestationExpiryTime,
+	}
+}
 
-// DefaultGenesis returns the default Capability genesis state
+// DefaultGenesis returns the default Capability genesis state. The params
+// added after the module launched are left unset so that the genesis state,
+// and thus the app hash, of app version 1 chains is unchanged. Their default
+// values are used until they are set via governance.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params: &Params{
-			DataCommitmentWindow: 400,
+			DataCommitmentWindow: DefaultDataCommitmentWindow,
 		},
 	}
 }
@@ -51,9 +89,24 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamsStoreKeyDataCommitmentWindow, &p.DataCommitmentWindow, validateDataCommitmentWindow),
+		paramtypes.NewParamSetPair(ParamsStoreKeySignificantPowerDifferenceThreshold, &p.SignificantPowerDifferenceThreshold, validateSignificantPowerDifferenceThreshold),
+		paramtypes.NewParamSetPair(ParamsStoreKeyAttestationExpiryTime, &p.AttestationExpiryTime, validateAttestationExpiryTime),
 	}
 }
 
+// WithDefaults returns a copy of the params where the params that were added
+// after genesis and are unset are replaced by their default values. A zero
+// threshold is considered unset because an unset sdk.Dec is encoded as zero.
+func (p Params) WithDefaults() Params {
+	if p.SignificantPowerDifferenceThreshold.IsNil() || p.SignificantPowerDifferenceThreshold.IsZero() {
+		p.SignificantPowerDifferenceThreshold = DefaultSignificantPowerDifferenceThreshold
+	}
+	if p.AttestationExpiryTime == 0 {
+		p.AttestationExpiryTime = DefaultAttestationExpiryTime
+	}
+	return p
+}
+
 func validateDataCommitmentWindow(i interface{}) error {
 	val, ok := i.(uint64)
 	if !ok {
@@ -75,10 +128,45 @@ func validateDataCommitmentWindow(i interface{}) error {
 	return nil
 }
 
-// ValidateBasic checks that the parameters have valid values.
+func validateSignificantPowerDifferenceThreshold(i interface{}) error {
+	val, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val.IsNil() || !val.IsPositive() {
+		return errors.Wrapf(ErrInvalidSignificantPowerDifferenceThreshold, "threshold %v must be positive", val)
+	}
+	if val.GT(sdk.OneDec()) {
+		return errors.Wrapf(ErrInvalidSignificantPowerDifferenceThreshold, "threshold %v must be <= 1", val)
+	}
+	return nil
+}
+
+func validateAttestationExpiryTime(i interface{}) error {
+	val, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val <= 0 {
+		return errors.Wrapf(ErrInvalidAttestationExpiryTime, "attestation expiry time %v must be positive", val)
+	}
+	return nil
+}
+
+// ValidateBasic checks that the parameters have valid values. The params that
+// were added after genesis can be unset, in which case they fall back to their
+// default values, so that genesis files written before they were added remain
+// valid.
 func (p Params) ValidateBasic() error {
 	if err := validateDataCommitmentWindow(p.DataCommitmentWindow); err != nil {
 		return errors.Wrap(err, "data commitment window")
 	}
+	p = p.WithDefaults()
+	if err := validateSignificantPowerDifferenceThreshold(p.SignificantPowerDifferenceThreshold); err != nil {
+		return errors.Wrap(err, "significant power difference threshold")
+	}
+	if err := validateAttestationExpiryTime(p.AttestationExpiryTime); err != nil {
+		return errors.Wrap(err, "attestation expiry time")
+	}
 	return nil
 }
@@ -2,11 +2,13 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
 
 	"github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -57,6 +59,52 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			expErr: false,
 		},
+		"valid params: custom significant power difference threshold and attestation expiry time": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:                types.MinimumDataCommitmentWindow,
+					SignificantPowerDifferenceThreshold: sdk.NewDecWithPrec(1, 1),
+					AttestationExpiryTime:               time.Hour,
+				},
+			},
+			expErr: false,
+		},
+		"valid params: zero significant power difference threshold falls back to the default": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:                types.MinimumDataCommitmentWindow,
+					SignificantPowerDifferenceThreshold: sdk.ZeroDec(),
+				},
+			},
+			expErr: false,
+		},
+		"invalid params: negative significant power difference threshold": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:                types.MinimumDataCommitmentWindow,
+					SignificantPowerDifferenceThreshold: sdk.NewDecWithPrec(-1, 1),
+				},
+			},
+			expErr: true,
+		},
+		"invalid params: significant power difference threshold above one": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:                types.MinimumDataCommitmentWindow,
+					SignificantPowerDifferenceThreshold: sdk.NewDecWithPrec(11, 1),
+				},
+			},
+			expErr: true,
+		},
+		"invalid params: negative attestation expiry time": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:  types.MinimumDataCommitmentWindow,
+					AttestationExpiryTime: -time.Hour,
+				},
+			},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
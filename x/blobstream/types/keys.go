@@ -40,6 +40,10 @@ const (
 
 	// EVMAddress indexes evm addresses by validator address
 	EVMAddress = "EVMAddress"
+
+	// AttestationConfirmKey indexes attestation confirms by nonce and
+	// validator address
+	AttestationConfirmKey = "AttestationConfirmKey"
 )
 
 // GetAttestationKey returns the following key format
@@ -60,3 +64,16 @@ func ConvertByteArrToString(value []byte) string {
 func GetEVMKey(valAddress sdk.ValAddress) []byte {
 	return append([]byte(EVMAddress), valAddress...)
 }
+
+// GetAttestationConfirmPrefix returns the prefix of the keys of all the
+// attestation confirms for nonce.
+func GetAttestationConfirmPrefix(nonce uint64) []byte {
+	return append([]byte(AttestationConfirmKey), UInt64Bytes(nonce)...)
+}
+
+// GetAttestationConfirmKey returns the following key format
+// prefix                 nonce              validator address
+// [AttestationConfirmKey][0 0 0 0 0 0 0 1][valAddress]
+func GetAttestationConfirmKey(nonce uint64, valAddress sdk.ValAddress) []byte {
+	return append(GetAttestationConfirmPrefix(nonce), valAddress...)
+}
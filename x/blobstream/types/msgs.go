@@ -2,7 +2,9 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 var _ sdk.Msg = &MsgRegisterEVMAddress{}
@@ -37,3 +39,50 @@ func (msg MsgRegisterEVMAddress) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
 }
+
+var _ sdk.Msg = &MsgSubmitAttestationConfirm{}
+
+// NewMsgSubmitAttestationConfirm returns a confirm of the attestation request
+// at nonce. dataRootTupleRoot must be empty when confirming a valset.
+func NewMsgSubmitAttestationConfirm(valAddress sdk.ValAddress, nonce uint64, dataRootTupleRoot string, signature []byte) *MsgSubmitAttestationConfirm {
+	return &MsgSubmitAttestationConfirm{
+		ValidatorAddress:  valAddress.String(),
+		Nonce:             nonce,
+		DataRootTupleRoot: dataRootTupleRoot,
+		Signature:         hexutil.Encode(signature),
+	}
+}
+
+// ValidateBasic verifies that the validator address, the data root tuple root
+// and the signature are of a valid type
+func (msg MsgSubmitAttestationConfirm) ValidateBasic() error {
+	_, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if msg.Nonce == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("nonce must be positive")
+	}
+
+	if msg.DataRootTupleRoot != "" {
+		if _, err := ParseDataRootTupleRoot(msg.DataRootTupleRoot); err != nil {
+			return err
+		}
+	}
+
+	if _, err := ParseEVMSignature(msg.Signature); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners fulfills the sdk.Msg interface. The signer must be the validator address
+func (msg MsgSubmitAttestationConfirm) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
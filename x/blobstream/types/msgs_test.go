@@ -20,3 +20,19 @@ func TestValidateBasic(t *testing.T) {
 	msg = &MsgRegisterEVMAddress{"invalid validator address", evmAddr.Hex()}
 	require.Error(t, msg.ValidateBasic())
 }
+
+func TestMsgSubmitAttestationConfirmValidateBasic(t *testing.T) {
+	valAddr, err := sdk.ValAddressFromBech32("cosmosvaloper1xcy3els9ua75kdm783c3qu0rfa2eples6eavqq")
+	require.NoError(t, err)
+	root := common.HexToHash("0x01").Hex()
+	signature := make([]byte, 65)
+
+	require.NoError(t, NewMsgSubmitAttestationConfirm(valAddr, 1, "", signature).ValidateBasic())
+	require.NoError(t, NewMsgSubmitAttestationConfirm(valAddr, 1, root, signature).ValidateBasic())
+	require.Error(t, NewMsgSubmitAttestationConfirm(valAddr, 0, root, signature).ValidateBasic())
+	require.Error(t, NewMsgSubmitAttestationConfirm(valAddr, 1, "0x01", signature).ValidateBasic())
+	require.Error(t, NewMsgSubmitAttestationConfirm(valAddr, 1, root, signature[:64]).ValidateBasic())
+	msg := NewMsgSubmitAttestationConfirm(valAddr, 1, root, signature)
+	msg.ValidatorAddress = "invalid validator address"
+	require.Error(t, msg.ValidateBasic())
+}
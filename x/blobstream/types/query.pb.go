@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	crypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -208,6 +209,302 @@ func (m *QueryAttestationRequestByNonceResponse) GetAttestation() *types.Any {
 	return nil
 }
 
+// QueryAttestationRequestsRequest
+type QueryAttestationRequestsRequest struct {
+	// start_nonce is the nonce of the first attestation to send. If it is zero,
+	// the stream starts at the earliest available attestation nonce.
+	StartNonce uint64 `protobuf:"varint,1,opt,name=start_nonce,json=startNonce,proto3" json:"start_nonce,omitempty"`
+}
+
+func (m *QueryAttestationRequestsRequest) Reset()         { *m = QueryAttestationRequestsRequest{} }
+func (m *QueryAttestationRequestsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationRequestsRequest) ProtoMessage()    {}
+func (*QueryAttestationRequestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{4}
+}
+func (m *QueryAttestationRequestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationRequestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationRequestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationRequestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationRequestsRequest.Merge(m, src)
+}
+func (m *QueryAttestationRequestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationRequestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationRequestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationRequestsRequest proto.InternalMessageInfo
+
+func (m *QueryAttestationRequestsRequest) GetStartNonce() uint64 {
+	if m != nil {
+		return m.StartNonce
+	}
+	return 0
+}
+
+// QueryAttestationRequestsResponse
+type QueryAttestationRequestsResponse struct {
+	// AttestationRequestI is either a Data Commitment or a Valset.
+	Attestation *types.Any `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+}
+
+func (m *QueryAttestationRequestsResponse) Reset()         { *m = QueryAttestationRequestsResponse{} }
+func (m *QueryAttestationRequestsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationRequestsResponse) ProtoMessage()    {}
+func (*QueryAttestationRequestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{5}
+}
+func (m *QueryAttestationRequestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationRequestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationRequestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationRequestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationRequestsResponse.Merge(m, src)
+}
+func (m *QueryAttestationRequestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationRequestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationRequestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationRequestsResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationRequestsResponse) GetAttestation() *types.Any {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+// QueryAttestationConfirmsRequest
+type QueryAttestationConfirmsRequest struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *QueryAttestationConfirmsRequest) Reset()         { *m = QueryAttestationConfirmsRequest{} }
+func (m *QueryAttestationConfirmsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationConfirmsRequest) ProtoMessage()    {}
+func (*QueryAttestationConfirmsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{6}
+}
+func (m *QueryAttestationConfirmsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationConfirmsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationConfirmsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationConfirmsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationConfirmsRequest.Merge(m, src)
+}
+func (m *QueryAttestationConfirmsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationConfirmsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationConfirmsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationConfirmsRequest proto.InternalMessageInfo
+
+func (m *QueryAttestationConfirmsRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// QueryAttestationConfirmsResponse
+type QueryAttestationConfirmsResponse struct {
+	Confirms []AttestationConfirm `protobuf:"bytes,1,rep,name=confirms,proto3" json:"confirms"`
+}
+
+func (m *QueryAttestationConfirmsResponse) Reset()         { *m = QueryAttestationConfirmsResponse{} }
+func (m *QueryAttestationConfirmsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationConfirmsResponse) ProtoMessage()    {}
+func (*QueryAttestationConfirmsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{7}
+}
+func (m *QueryAttestationConfirmsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationConfirmsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationConfirmsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationConfirmsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationConfirmsResponse.Merge(m, src)
+}
+func (m *QueryAttestationConfirmsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationConfirmsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationConfirmsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationConfirmsResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationConfirmsResponse) GetConfirms() []AttestationConfirm {
+	if m != nil {
+		return m.Confirms
+	}
+	return nil
+}
+
+// QueryAttestationSignedPowerRequest
+type QueryAttestationSignedPowerRequest struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *QueryAttestationSignedPowerRequest) Reset()         { *m = QueryAttestationSignedPowerRequest{} }
+func (m *QueryAttestationSignedPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationSignedPowerRequest) ProtoMessage()    {}
+func (*QueryAttestationSignedPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{8}
+}
+func (m *QueryAttestationSignedPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationSignedPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationSignedPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationSignedPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationSignedPowerRequest.Merge(m, src)
+}
+func (m *QueryAttestationSignedPowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationSignedPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationSignedPowerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationSignedPowerRequest proto.InternalMessageInfo
+
+func (m *QueryAttestationSignedPowerRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// QueryAttestationSignedPowerResponse
+type QueryAttestationSignedPowerResponse struct {
+	// signed_power is the normalized power of the validators that confirmed the
+	// attestation request. For data commitments, it only includes the
+	// validators that signed data_root_tuple_root.
+	SignedPower uint64 `protobuf:"varint,1,opt,name=signed_power,json=signedPower,proto3" json:"signed_power,omitempty"`
+	// two_thirds_threshold is the power needed by the Blobstream contract to
+	// accept the attestation request.
+	TwoThirdsThreshold uint64 `protobuf:"varint,2,opt,name=two_thirds_threshold,json=twoThirdsThreshold,proto3" json:"two_thirds_threshold,omitempty"`
+	// data_root_tuple_root is the data root tuple root that was signed by the
+	// most power. It is empty for valsets.
+	DataRootTupleRoot string `protobuf:"bytes,3,opt,name=data_root_tuple_root,json=dataRootTupleRoot,proto3" json:"data_root_tuple_root,omitempty"`
+}
+
+func (m *QueryAttestationSignedPowerResponse) Reset()         { *m = QueryAttestationSignedPowerResponse{} }
+func (m *QueryAttestationSignedPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationSignedPowerResponse) ProtoMessage()    {}
+func (*QueryAttestationSignedPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{9}
+}
+func (m *QueryAttestationSignedPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationSignedPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationSignedPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationSignedPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationSignedPowerResponse.Merge(m, src)
+}
+func (m *QueryAttestationSignedPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationSignedPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationSignedPowerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationSignedPowerResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationSignedPowerResponse) GetSignedPower() uint64 {
+	if m != nil {
+		return m.SignedPower
+	}
+	return 0
+}
+
+func (m *QueryAttestationSignedPowerResponse) GetTwoThirdsThreshold() uint64 {
+	if m != nil {
+		return m.TwoThirdsThreshold
+	}
+	return 0
+}
+
+func (m *QueryAttestationSignedPowerResponse) GetDataRootTupleRoot() string {
+	if m != nil {
+		return m.DataRootTupleRoot
+	}
+	return ""
+}
+
 // QueryLatestAttestationNonceRequest latest attestation nonce request
 type QueryLatestAttestationNonceRequest struct {
 }
@@ -216,7 +513,7 @@ func (m *QueryLatestAttestationNonceRequest) Reset()         { *m = QueryLatestA
 func (m *QueryLatestAttestationNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestAttestationNonceRequest) ProtoMessage()    {}
 func (*QueryLatestAttestationNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{4}
+	return fileDescriptor_c8535c57355a2b91, []int{10}
 }
 func (m *QueryLatestAttestationNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,7 +551,7 @@ func (m *QueryLatestAttestationNonceResponse) Reset()         { *m = QueryLatest
 func (m *QueryLatestAttestationNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestAttestationNonceResponse) ProtoMessage()    {}
 func (*QueryLatestAttestationNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{5}
+	return fileDescriptor_c8535c57355a2b91, []int{11}
 }
 func (m *QueryLatestAttestationNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,7 +595,7 @@ func (m *QueryEarliestAttestationNonceRequest) Reset()         { *m = QueryEarli
 func (m *QueryEarliestAttestationNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEarliestAttestationNonceRequest) ProtoMessage()    {}
 func (*QueryEarliestAttestationNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{6}
+	return fileDescriptor_c8535c57355a2b91, []int{12}
 }
 func (m *QueryEarliestAttestationNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +633,7 @@ func (m *QueryEarliestAttestationNonceResponse) Reset()         { *m = QueryEarl
 func (m *QueryEarliestAttestationNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEarliestAttestationNonceResponse) ProtoMessage()    {}
 func (*QueryEarliestAttestationNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{7}
+	return fileDescriptor_c8535c57355a2b91, []int{13}
 }
 func (m *QueryEarliestAttestationNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +683,7 @@ func (m *QueryLatestValsetRequestBeforeNonceRequest) String() string {
 }
 func (*QueryLatestValsetRequestBeforeNonceRequest) ProtoMessage() {}
 func (*QueryLatestValsetRequestBeforeNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{8}
+	return fileDescriptor_c8535c57355a2b91, []int{14}
 }
 func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,7 +733,7 @@ func (m *QueryLatestValsetRequestBeforeNonceResponse) String() string {
 }
 func (*QueryLatestValsetRequestBeforeNonceResponse) ProtoMessage() {}
 func (*QueryLatestValsetRequestBeforeNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{9}
+	return fileDescriptor_c8535c57355a2b91, []int{15}
 }
 func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,7 +777,7 @@ func (m *QueryLatestUnbondingHeightRequest) Reset()         { *m = QueryLatestUn
 func (m *QueryLatestUnbondingHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestUnbondingHeightRequest) ProtoMessage()    {}
 func (*QueryLatestUnbondingHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{10}
+	return fileDescriptor_c8535c57355a2b91, []int{16}
 }
 func (m *QueryLatestUnbondingHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +815,7 @@ func (m *QueryLatestUnbondingHeightResponse) Reset()         { *m = QueryLatestU
 func (m *QueryLatestUnbondingHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestUnbondingHeightResponse) ProtoMessage()    {}
 func (*QueryLatestUnbondingHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{11}
+	return fileDescriptor_c8535c57355a2b91, []int{17}
 }
 func (m *QueryLatestUnbondingHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,7 +859,7 @@ func (m *QueryLatestDataCommitmentRequest) Reset()         { *m = QueryLatestDat
 func (m *QueryLatestDataCommitmentRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestDataCommitmentRequest) ProtoMessage()    {}
 func (*QueryLatestDataCommitmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{12}
+	return fileDescriptor_c8535c57355a2b91, []int{18}
 }
 func (m *QueryLatestDataCommitmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,7 +897,7 @@ func (m *QueryLatestDataCommitmentResponse) Reset()         { *m = QueryLatestDa
 func (m *QueryLatestDataCommitmentResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLatestDataCommitmentResponse) ProtoMessage()    {}
 func (*QueryLatestDataCommitmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{13}
+	return fileDescriptor_c8535c57355a2b91, []int{19}
 }
 func (m *QueryLatestDataCommitmentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -647,7 +944,7 @@ func (m *QueryDataCommitmentRangeForHeightRequest) Reset() {
 func (m *QueryDataCommitmentRangeForHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDataCommitmentRangeForHeightRequest) ProtoMessage()    {}
 func (*QueryDataCommitmentRangeForHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{14}
+	return fileDescriptor_c8535c57355a2b91, []int{20}
 }
 func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,7 +993,7 @@ func (m *QueryDataCommitmentRangeForHeightResponse) String() string {
 }
 func (*QueryDataCommitmentRangeForHeightResponse) ProtoMessage() {}
 func (*QueryDataCommitmentRangeForHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{15}
+	return fileDescriptor_c8535c57355a2b91, []int{21}
 }
 func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,23 +1029,25 @@ func (m *QueryDataCommitmentRangeForHeightResponse) GetDataCommitment() *DataCom
 	return nil
 }
 
-// QueryEVMAddressRequest
-type QueryEVMAddressRequest struct {
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+// QueryDataRootTupleInclusionProofRequest
+type QueryDataRootTupleInclusionProofRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryEVMAddressRequest) Reset()         { *m = QueryEVMAddressRequest{} }
-func (m *QueryEVMAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEVMAddressRequest) ProtoMessage()    {}
-func (*QueryEVMAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{16}
+func (m *QueryDataRootTupleInclusionProofRequest) Reset() {
+	*m = QueryDataRootTupleInclusionProofRequest{}
 }
-func (m *QueryEVMAddressRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDataRootTupleInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataRootTupleInclusionProofRequest) ProtoMessage()    {}
+func (*QueryDataRootTupleInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{22}
+}
+func (m *QueryDataRootTupleInclusionProofRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEVMAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDataRootTupleInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEVMAddressRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDataRootTupleInclusionProofRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -758,42 +1057,54 @@ func (m *QueryEVMAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryEVMAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEVMAddressRequest.Merge(m, src)
+func (m *QueryDataRootTupleInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataRootTupleInclusionProofRequest.Merge(m, src)
 }
-func (m *QueryEVMAddressRequest) XXX_Size() int {
+func (m *QueryDataRootTupleInclusionProofRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEVMAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEVMAddressRequest.DiscardUnknown(m)
+func (m *QueryDataRootTupleInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataRootTupleInclusionProofRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEVMAddressRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDataRootTupleInclusionProofRequest proto.InternalMessageInfo
 
-func (m *QueryEVMAddressRequest) GetValidatorAddress() string {
+func (m *QueryDataRootTupleInclusionProofRequest) GetHeight() uint64 {
 	if m != nil {
-		return m.ValidatorAddress
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-// QueryEVMAddressResponse
-type QueryEVMAddressResponse struct {
-	EvmAddress string `protobuf:"bytes,1,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+// QueryDataRootTupleInclusionProofResponse
+type QueryDataRootTupleInclusionProofResponse struct {
+	// data_commitment is the data commitment whose range includes the height.
+	// Its nonce is the one to use when verifying the proof against the
+	// Blobstream contract.
+	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
+	// height is the height of the data root tuple.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// data_root is the data root of the block at height.
+	DataRoot []byte `protobuf:"bytes,3,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	// proof is the Merkle proof of the (height, data_root) tuple against the
+	// data root tuple root of the data commitment.
+	Proof *crypto.Proof `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (m *QueryEVMAddressResponse) Reset()         { *m = QueryEVMAddressResponse{} }
-func (m *QueryEVMAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEVMAddressResponse) ProtoMessage()    {}
-func (*QueryEVMAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c8535c57355a2b91, []int{17}
+func (m *QueryDataRootTupleInclusionProofResponse) Reset() {
+	*m = QueryDataRootTupleInclusionProofResponse{}
 }
-func (m *QueryEVMAddressResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDataRootTupleInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataRootTupleInclusionProofResponse) ProtoMessage()    {}
+func (*QueryDataRootTupleInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{23}
+}
+func (m *QueryDataRootTupleInclusionProofResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEVMAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDataRootTupleInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEVMAddressResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDataRootTupleInclusionProofResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -803,42 +1114,161 @@ func (m *QueryEVMAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryEVMAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEVMAddressResponse.Merge(m, src)
+func (m *QueryDataRootTupleInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataRootTupleInclusionProofResponse.Merge(m, src)
 }
-func (m *QueryEVMAddressResponse) XXX_Size() int {
+func (m *QueryDataRootTupleInclusionProofResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEVMAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEVMAddressResponse.DiscardUnknown(m)
+func (m *QueryDataRootTupleInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataRootTupleInclusionProofResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEVMAddressResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDataRootTupleInclusionProofResponse proto.InternalMessageInfo
 
-func (m *QueryEVMAddressResponse) GetEvmAddress() string {
+func (m *QueryDataRootTupleInclusionProofResponse) GetDataCommitment() *DataCommitment {
 	if m != nil {
-		return m.EvmAddress
+		return m.DataCommitment
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.qgb.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.qgb.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryAttestationRequestByNonceRequest)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceRequest")
-	proto.RegisterType((*QueryAttestationRequestByNonceResponse)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceResponse")
-	proto.RegisterType((*QueryLatestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceRequest")
-	proto.RegisterType((*QueryLatestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceResponse")
-	proto.RegisterType((*QueryEarliestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceRequest")
-	proto.RegisterType((*QueryEarliestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceResponse")
-	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceRequest)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceRequest")
-	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceResponse)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceResponse")
+func (m *QueryDataRootTupleInclusionProofResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) GetProof() *crypto.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// QueryEVMAddressRequest
+type QueryEVMAddressRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryEVMAddressRequest) Reset()         { *m = QueryEVMAddressRequest{} }
+func (m *QueryEVMAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEVMAddressRequest) ProtoMessage()    {}
+func (*QueryEVMAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{24}
+}
+func (m *QueryEVMAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEVMAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEVMAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEVMAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEVMAddressRequest.Merge(m, src)
+}
+func (m *QueryEVMAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEVMAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEVMAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEVMAddressRequest proto.InternalMessageInfo
+
+func (m *QueryEVMAddressRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryEVMAddressResponse
+type QueryEVMAddressResponse struct {
+	EvmAddress string `protobuf:"bytes,1,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+}
+
+func (m *QueryEVMAddressResponse) Reset()         { *m = QueryEVMAddressResponse{} }
+func (m *QueryEVMAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEVMAddressResponse) ProtoMessage()    {}
+func (*QueryEVMAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{25}
+}
+func (m *QueryEVMAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEVMAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEVMAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEVMAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEVMAddressResponse.Merge(m, src)
+}
+func (m *QueryEVMAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEVMAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEVMAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEVMAddressResponse proto.InternalMessageInfo
+
+func (m *QueryEVMAddressResponse) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.qgb.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.qgb.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAttestationRequestByNonceRequest)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceRequest")
+	proto.RegisterType((*QueryAttestationRequestByNonceResponse)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceResponse")
+	proto.RegisterType((*QueryAttestationRequestsRequest)(nil), "celestia.qgb.v1.QueryAttestationRequestsRequest")
+	proto.RegisterType((*QueryAttestationRequestsResponse)(nil), "celestia.qgb.v1.QueryAttestationRequestsResponse")
+	proto.RegisterType((*QueryAttestationConfirmsRequest)(nil), "celestia.qgb.v1.QueryAttestationConfirmsRequest")
+	proto.RegisterType((*QueryAttestationConfirmsResponse)(nil), "celestia.qgb.v1.QueryAttestationConfirmsResponse")
+	proto.RegisterType((*QueryAttestationSignedPowerRequest)(nil), "celestia.qgb.v1.QueryAttestationSignedPowerRequest")
+	proto.RegisterType((*QueryAttestationSignedPowerResponse)(nil), "celestia.qgb.v1.QueryAttestationSignedPowerResponse")
+	proto.RegisterType((*QueryLatestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceRequest")
+	proto.RegisterType((*QueryLatestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceResponse")
+	proto.RegisterType((*QueryEarliestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceRequest")
+	proto.RegisterType((*QueryEarliestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceResponse")
+	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceRequest)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceRequest")
+	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceResponse)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceResponse")
 	proto.RegisterType((*QueryLatestUnbondingHeightRequest)(nil), "celestia.qgb.v1.QueryLatestUnbondingHeightRequest")
 	proto.RegisterType((*QueryLatestUnbondingHeightResponse)(nil), "celestia.qgb.v1.QueryLatestUnbondingHeightResponse")
 	proto.RegisterType((*QueryLatestDataCommitmentRequest)(nil), "celestia.qgb.v1.QueryLatestDataCommitmentRequest")
 	proto.RegisterType((*QueryLatestDataCommitmentResponse)(nil), "celestia.qgb.v1.QueryLatestDataCommitmentResponse")
 	proto.RegisterType((*QueryDataCommitmentRangeForHeightRequest)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightRequest")
 	proto.RegisterType((*QueryDataCommitmentRangeForHeightResponse)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightResponse")
+	proto.RegisterType((*QueryDataRootTupleInclusionProofRequest)(nil), "celestia.qgb.v1.QueryDataRootTupleInclusionProofRequest")
+	proto.RegisterType((*QueryDataRootTupleInclusionProofResponse)(nil), "celestia.qgb.v1.QueryDataRootTupleInclusionProofResponse")
 	proto.RegisterType((*QueryEVMAddressRequest)(nil), "celestia.qgb.v1.QueryEVMAddressRequest")
 	proto.RegisterType((*QueryEVMAddressResponse)(nil), "celestia.qgb.v1.QueryEVMAddressResponse")
 }
@@ -846,64 +1276,87 @@ func init() {
 func init() { proto.RegisterFile("celestia/qgb/v1/query.proto", fileDescriptor_c8535c57355a2b91) }
 
 var fileDescriptor_c8535c57355a2b91 = []byte{
-	// 910 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0x63, 0xd4, 0x46, 0xe2, 0x45, 0xea, 0x8f, 0x49, 0x9a, 0xed, 0xba, 0xc5, 0xbb, 0x75,
-	0xb2, 0xe9, 0x96, 0x65, 0x3d, 0xda, 0x2c, 0x5d, 0x44, 0x5b, 0x0e, 0x1b, 0x28, 0x2a, 0x52, 0x81,
-	0x12, 0x89, 0x1e, 0x38, 0xb0, 0x1a, 0x27, 0x53, 0xaf, 0x45, 0xec, 0x49, 0x6c, 0x27, 0x22, 0x02,
-	0x2e, 0xfc, 0x05, 0x48, 0x1c, 0x39, 0x73, 0xe5, 0x84, 0xb8, 0x70, 0xe4, 0x52, 0xf5, 0x54, 0x89,
-	0x0b, 0x27, 0x84, 0x76, 0xf9, 0x0f, 0xf8, 0x07, 0x50, 0xe6, 0x47, 0xd6, 0x71, 0x6c, 0x27, 0x59,
-	0xf5, 0xe6, 0x99, 0xf7, 0xde, 0xf7, 0x7d, 0x9e, 0x33, 0xfe, 0x4e, 0xe0, 0x46, 0x87, 0xf6, 0x68,
-	0x18, 0xb9, 0x04, 0x0f, 0x1c, 0x1b, 0x8f, 0xf6, 0xf0, 0x60, 0x48, 0x83, 0xb1, 0xd5, 0x0f, 0x58,
-	0xc4, 0xd0, 0x65, 0x15, 0xb4, 0x06, 0x8e, 0x6d, 0x8d, 0xf6, 0xf4, 0x37, 0x92, 0xd9, 0x0e, 0xf5,
-	0x69, 0xe8, 0x86, 0x22, 0x5f, 0x9f, 0x13, 0x8b, 0xc6, 0x7d, 0xaa, 0x82, 0x37, 0x1d, 0xc6, 0x9c,
-	0x1e, 0xc5, 0xa4, 0xef, 0x62, 0xe2, 0xfb, 0x2c, 0x22, 0x91, 0xcb, 0x7c, 0x15, 0xad, 0x38, 0xcc,
-	0x61, 0xfc, 0x11, 0x4f, 0x9e, 0xe4, 0xee, 0x7a, 0x87, 0x85, 0x1e, 0x0b, 0x8f, 0x44, 0x40, 0x2c,
-	0x54, 0x48, 0xca, 0xf1, 0x95, 0x3d, 0x7c, 0x86, 0x89, 0x2f, 0xb1, 0xcd, 0x0a, 0xa0, 0xcf, 0x26,
-	0x53, 0x3c, 0x21, 0x01, 0xf1, 0xc2, 0x36, 0x1d, 0x0c, 0x69, 0x18, 0x99, 0x8f, 0xa1, 0x3c, 0xb3,
-	0x1b, 0xf6, 0x99, 0x1f, 0x52, 0x74, 0x17, 0x8a, 0x7d, 0xbe, 0x73, 0x5d, 0xdb, 0xd4, 0xb6, 0x4b,
-	0xcd, 0x35, 0x2b, 0x31, 0xb4, 0x25, 0x0a, 0x5a, 0x17, 0x9e, 0xff, 0xbd, 0x51, 0x68, 0xcb, 0x64,
-	0xf3, 0x3d, 0xd8, 0xe2, 0x6a, 0x87, 0x51, 0x44, 0x43, 0x31, 0x8a, 0x6c, 0xd4, 0x1a, 0x7f, 0xc2,
-	0xfc, 0x0e, 0x95, 0x2b, 0x54, 0x81, 0x8b, 0xfe, 0x64, 0xcd, 0xe5, 0x2f, 0xb4, 0xc5, 0xc2, 0x1c,
-	0x43, 0x63, 0x51, 0xb9, 0xe4, 0xfb, 0x14, 0x4a, 0xe4, 0x2c, 0x49, 0x42, 0x56, 0x2c, 0x31, 0xbd,
-	0xa5, 0xa6, 0xb7, 0x0e, 0xfd, 0x71, 0x6b, 0xed, 0xc5, 0xaf, 0xbb, 0xe5, 0x79, 0xc5, 0x8f, 0xda,
-	0x71, 0x05, 0xb3, 0x0e, 0x26, 0x6f, 0xfd, 0x98, 0x4c, 0xf6, 0x62, 0xe9, 0x71, 0x6c, 0xf3, 0x3e,
-	0xd4, 0x72, 0xb3, 0x24, 0x5d, 0xfa, 0x74, 0x0d, 0xa8, 0xf3, 0xe2, 0x87, 0x24, 0xe8, 0xb9, 0x39,
-	0x4d, 0xd4, 0x4b, 0xcc, 0xce, 0xcb, 0x6d, 0xd3, 0x82, 0x37, 0x63, 0x8c, 0x4f, 0x49, 0x2f, 0xa4,
-	0x91, 0x7a, 0x8b, 0xf4, 0x19, 0x0b, 0xe8, 0x12, 0x3f, 0xc4, 0x97, 0xb0, 0xb3, 0x94, 0x86, 0x04,
-	0xc1, 0x50, 0x1c, 0xf1, 0x9c, 0xcc, 0xd3, 0x22, 0x25, 0x64, 0x9a, 0x59, 0x83, 0x5b, 0x31, 0xfd,
-	0xcf, 0x7d, 0x9b, 0xf9, 0x5d, 0xd7, 0x77, 0x1e, 0x51, 0xd7, 0x39, 0x56, 0x8d, 0xcc, 0x07, 0x33,
-	0x3f, 0xc9, 0x5c, 0x92, 0xec, 0x5d, 0x85, 0xe2, 0x31, 0xdf, 0x91, 0x13, 0xc8, 0x95, 0x69, 0xc2,
-	0x66, 0xac, 0xfa, 0x03, 0x12, 0x91, 0xf7, 0x99, 0xe7, 0xb9, 0x91, 0x47, 0xfd, 0x69, 0x07, 0x6f,
-	0x06, 0x23, 0x99, 0x23, 0x1b, 0x3c, 0x82, 0xcb, 0x5d, 0x12, 0x91, 0xa3, 0xce, 0x34, 0x24, 0xa7,
-	0xdc, 0x98, 0x9b, 0x32, 0xa1, 0x70, 0xa9, 0x3b, 0xb3, 0x36, 0x5b, 0xb0, 0xcd, 0xdb, 0x25, 0xd2,
-	0x88, 0xef, 0xd0, 0x0f, 0x59, 0x30, 0x33, 0x7c, 0xe6, 0x58, 0x43, 0xb8, 0xb3, 0x84, 0xc6, 0x2b,
-	0x47, 0x7f, 0x08, 0x55, 0x71, 0x26, 0x9f, 0x7e, 0x7c, 0xd8, 0xed, 0x06, 0x34, 0x54, 0x06, 0x82,
-	0x76, 0xe0, 0xea, 0x88, 0xf4, 0xdc, 0x2e, 0x89, 0x58, 0x70, 0x44, 0x44, 0x8c, 0x77, 0x79, 0xbd,
-	0x7d, 0x65, 0x1a, 0x90, 0x35, 0xe6, 0x3d, 0x58, 0x9b, 0x93, 0x91, 0xac, 0x1b, 0x50, 0xa2, 0x23,
-	0x2f, 0xa1, 0x00, 0x74, 0xe4, 0xc9, 0xc4, 0xe6, 0x7f, 0x25, 0xb8, 0xc8, 0x8b, 0xd1, 0x57, 0x50,
-	0x14, 0xee, 0x83, 0x6a, 0x73, 0x73, 0xcc, 0x5b, 0x9c, 0x5e, 0xcf, 0x4f, 0x12, 0xfd, 0xcd, 0xea,
-	0xf7, 0x7f, 0xfe, 0xfb, 0xe3, 0x6b, 0x57, 0xd0, 0x25, 0xe5, 0xd2, 0xc2, 0xd2, 0xd0, 0xef, 0x1a,
-	0xac, 0x67, 0xfa, 0x11, 0x3a, 0x48, 0xd7, 0x5e, 0xe4, 0x7f, 0xfa, 0x3b, 0x2b, 0xd7, 0x49, 0xcc,
-	0x5d, 0x8e, 0x79, 0x1b, 0x6d, 0x29, 0xcc, 0x98, 0x89, 0x85, 0x38, 0x10, 0x45, 0x21, 0xfe, 0x86,
-	0x7f, 0xc7, 0xdf, 0xa1, 0x5f, 0x34, 0xa8, 0xa6, 0x9b, 0x15, 0xda, 0x4f, 0x47, 0xc8, 0x35, 0x40,
-	0xfd, 0xed, 0xd5, 0x8a, 0x24, 0xf4, 0x1d, 0x0e, 0x5d, 0x43, 0xb7, 0x52, 0xa1, 0x39, 0x2a, 0xee,
-	0x71, 0x09, 0xf4, 0x9b, 0x06, 0xd7, 0xb3, 0x8c, 0x0f, 0xdd, 0x4d, 0xef, 0xbe, 0xc0, 0x50, 0xf5,
-	0x83, 0x55, 0xcb, 0x24, 0xf6, 0x0e, 0xc7, 0xde, 0x42, 0xb5, 0x1c, 0x6c, 0x2a, 0x45, 0xd0, 0x0b,
-	0x0d, 0x8c, 0x7c, 0xbb, 0x44, 0xf7, 0xf3, 0x5e, 0xde, 0x02, 0xa3, 0xd6, 0x1f, 0x9c, 0xaf, 0x38,
-	0xeb, 0xd8, 0x08, 0x23, 0x56, 0x07, 0x06, 0xdb, 0xbc, 0x66, 0x7a, 0x6c, 0x7e, 0xd2, 0xe0, 0x5a,
-	0xaa, 0xed, 0xa2, 0x66, 0x1e, 0x46, 0xba, 0x91, 0xeb, 0xfb, 0x2b, 0xd5, 0x48, 0xe2, 0x75, 0x4e,
-	0x5c, 0x46, 0x57, 0x15, 0xf1, 0x50, 0x25, 0xa2, 0x3f, 0x34, 0xb8, 0x99, 0xe7, 0x7f, 0xe8, 0xdd,
-	0xf4, 0x86, 0x4b, 0xf8, 0xae, 0x7e, 0xef, 0x3c, 0xa5, 0x12, 0xf9, 0x2d, 0x8e, 0xdc, 0x40, 0x75,
-	0x85, 0x9c, 0x30, 0x5f, 0x1c, 0x4c, 0xea, 0xb0, 0x70, 0x72, 0xf4, 0xb3, 0x06, 0x95, 0xb4, 0x8b,
-	0x07, 0xed, 0xe5, 0xbd, 0xae, 0xd4, 0x8b, 0x4c, 0x6f, 0xae, 0x52, 0x22, 0x69, 0x1b, 0x9c, 0x76,
-	0x13, 0x19, 0x59, 0xb4, 0xf2, 0x8b, 0xfc, 0x16, 0xe0, 0xcc, 0xae, 0xd1, 0xed, 0x8c, 0x6f, 0x29,
-	0x79, 0x2f, 0xe8, 0xdb, 0x8b, 0x13, 0x25, 0xc8, 0x0d, 0x0e, 0x72, 0x0d, 0x95, 0x15, 0x48, 0xec,
-	0x1e, 0x68, 0x3d, 0x79, 0x7e, 0x62, 0x68, 0x2f, 0x4f, 0x0c, 0xed, 0x9f, 0x13, 0x43, 0xfb, 0xe1,
-	0xd4, 0x28, 0xbc, 0x3c, 0x35, 0x0a, 0x7f, 0x9d, 0x1a, 0x85, 0x2f, 0x0e, 0x1c, 0x37, 0x3a, 0x1e,
-	0xda, 0x56, 0x87, 0x79, 0x58, 0xb5, 0x62, 0x81, 0x33, 0x7d, 0xde, 0x25, 0xfd, 0x3e, 0xfe, 0x1a,
-	0xdb, 0x3d, 0x66, 0x87, 0x51, 0x40, 0x89, 0x27, 0xfe, 0x77, 0xdb, 0x45, 0xfe, 0xef, 0x70, 0xff,
-	0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x42, 0x6c, 0x12, 0x89, 0xe4, 0x0b, 0x00, 0x00,
+	// 1277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0xb3, 0x6d, 0x13, 0x35, 0x8f, 0xab, 0xa6, 0x99, 0xb8, 0x89, 0xb3, 0x69, 0x1d, 0x67,
+	0x9d, 0x3f, 0x6e, 0xf3, 0xcb, 0x6e, 0xe2, 0xb4, 0xe9, 0xaf, 0x69, 0x39, 0xc4, 0x25, 0xa8, 0x95,
+	0x0a, 0x04, 0x13, 0x7a, 0xe0, 0x80, 0xb5, 0xb6, 0x27, 0xeb, 0x15, 0xde, 0x1d, 0x67, 0x67, 0xec,
+	0x60, 0x51, 0x2e, 0xbc, 0x02, 0x24, 0x8e, 0x9c, 0x39, 0x20, 0x24, 0xb8, 0x20, 0x2e, 0x5c, 0x90,
+	0xb8, 0x54, 0xbd, 0xb4, 0x12, 0x17, 0xb8, 0x20, 0x94, 0xf0, 0x42, 0x90, 0x67, 0x67, 0x37, 0x1b,
+	0x7b, 0x77, 0x6d, 0x47, 0xe5, 0xe6, 0x99, 0xe7, 0xf9, 0x3e, 0xcf, 0x67, 0x66, 0x67, 0xe6, 0x79,
+	0x12, 0x98, 0xab, 0xe0, 0x3a, 0xa6, 0xcc, 0xd4, 0xb5, 0x43, 0xa3, 0xac, 0xb5, 0x36, 0xb4, 0xc3,
+	0x26, 0x76, 0xda, 0x6a, 0xc3, 0x21, 0x8c, 0xa0, 0x09, 0xcf, 0xa8, 0x1e, 0x1a, 0x65, 0xb5, 0xb5,
+	0x21, 0xdf, 0xec, 0xf6, 0x36, 0xb0, 0x8d, 0xa9, 0x49, 0x5d, 0x7f, 0xb9, 0x27, 0x18, 0x6b, 0x37,
+	0xb0, 0x67, 0xbc, 0x61, 0x10, 0x62, 0xd4, 0xb1, 0xa6, 0x37, 0x4c, 0x4d, 0xb7, 0x6d, 0xc2, 0x74,
+	0x66, 0x12, 0xdb, 0xb3, 0x26, 0x0d, 0x62, 0x10, 0xfe, 0x53, 0xeb, 0xfc, 0x12, 0xb3, 0xb3, 0x15,
+	0x42, 0x2d, 0x42, 0x4b, 0xae, 0xc1, 0x1d, 0x78, 0x26, 0x11, 0x8e, 0x8f, 0xca, 0xcd, 0x03, 0x4d,
+	0xb7, 0x05, 0xb6, 0x7c, 0x93, 0x61, 0xbb, 0x8a, 0x1d, 0xcb, 0xb4, 0x99, 0x56, 0x71, 0xda, 0x0d,
+	0x46, 0x3a, 0x5e, 0xe4, 0xc0, 0x35, 0x2b, 0x49, 0x40, 0x1f, 0x74, 0x16, 0xb9, 0xa7, 0x3b, 0xba,
+	0x45, 0x8b, 0xf8, 0xb0, 0x89, 0x29, 0x53, 0x9e, 0xc2, 0xd4, 0x99, 0x59, 0xda, 0x20, 0x36, 0xc5,
+	0xe8, 0x2e, 0x8c, 0x35, 0xf8, 0x4c, 0x4a, 0xca, 0x48, 0xb9, 0x44, 0x7e, 0x46, 0xed, 0xda, 0x13,
+	0xd5, 0x15, 0x14, 0x2e, 0xbd, 0xf8, 0x6b, 0x7e, 0xa4, 0x28, 0x9c, 0x95, 0xb7, 0x60, 0x89, 0x47,
+	0xdb, 0x61, 0x0c, 0x53, 0x77, 0xa5, 0x22, 0x51, 0xa1, 0xfd, 0x1e, 0xb1, 0x2b, 0x58, 0x8c, 0x50,
+	0x12, 0x46, 0xed, 0xce, 0x98, 0x87, 0xbf, 0x54, 0x74, 0x07, 0x4a, 0x1b, 0x96, 0xfb, 0xc9, 0x05,
+	0xdf, 0xfb, 0x90, 0xd0, 0x4f, 0x9d, 0x04, 0x64, 0x52, 0x75, 0x37, 0x47, 0xf5, 0x36, 0x47, 0xdd,
+	0xb1, 0xdb, 0x85, 0x99, 0x97, 0x3f, 0xad, 0x4d, 0xf5, 0x46, 0x7c, 0x52, 0x0c, 0x46, 0x50, 0x0a,
+	0x30, 0x1f, 0x91, 0xda, 0xdb, 0x2a, 0x34, 0x0f, 0x09, 0xca, 0x74, 0x87, 0x95, 0x82, 0xe4, 0xc0,
+	0xa7, 0x38, 0x9c, 0x42, 0x21, 0x13, 0x1d, 0xe3, 0xbf, 0x02, 0xbf, 0xd7, 0x0b, 0xfe, 0x88, 0xd8,
+	0x07, 0xa6, 0x63, 0xd1, 0xf8, 0xcd, 0x36, 0x21, 0x13, 0x2d, 0x14, 0xb4, 0xbb, 0x70, 0xb9, 0x22,
+	0xe6, 0x52, 0x52, 0xe6, 0x62, 0x2e, 0x91, 0xcf, 0xf6, 0x1c, 0x84, 0x5e, 0xbd, 0x38, 0x14, 0xbe,
+	0x54, 0xd9, 0x06, 0xa5, 0x3b, 0xd5, 0x87, 0xa6, 0x61, 0xe3, 0xea, 0x1e, 0x39, 0xc2, 0x4e, 0x3c,
+	0xe6, 0xf7, 0x12, 0x64, 0x63, 0xc5, 0x02, 0x75, 0x01, 0xae, 0x50, 0x3e, 0x5d, 0x6a, 0x74, 0xe6,
+	0x45, 0x90, 0x04, 0x3d, 0x75, 0x45, 0xeb, 0x90, 0x64, 0x47, 0xa4, 0xc4, 0x6a, 0xa6, 0x53, 0xa5,
+	0x25, 0x56, 0x73, 0x30, 0xad, 0x91, 0x7a, 0x35, 0x75, 0x81, 0xbb, 0x22, 0x76, 0x44, 0xf6, 0xb9,
+	0x69, 0xdf, 0xb3, 0x20, 0x0d, 0x92, 0x55, 0x9d, 0xe9, 0x25, 0x87, 0x10, 0x56, 0x62, 0xcd, 0x46,
+	0x1d, 0xf3, 0x9f, 0xa9, 0x8b, 0x19, 0x29, 0x37, 0x5e, 0x9c, 0xec, 0xd8, 0x8a, 0x84, 0xb0, 0xfd,
+	0x8e, 0xa5, 0xf3, 0x43, 0x59, 0x14, 0x2b, 0x7d, 0xaa, 0x33, 0x4c, 0x59, 0x00, 0x39, 0x78, 0xfa,
+	0x95, 0x07, 0x90, 0x8d, 0xf5, 0x12, 0x4b, 0x0a, 0xdf, 0x90, 0x65, 0x58, 0xe4, 0xe2, 0x5d, 0xdd,
+	0xa9, 0x9b, 0x31, 0x49, 0xbc, 0xbb, 0x18, 0xed, 0x17, 0x9b, 0xa6, 0x00, 0xb7, 0x03, 0x8c, 0xcf,
+	0xf4, 0x3a, 0xc5, 0xcc, 0xbb, 0x8c, 0xf8, 0x80, 0x38, 0x78, 0x80, 0xfb, 0xfc, 0x09, 0xac, 0x0e,
+	0x14, 0x43, 0x80, 0x68, 0x30, 0xd6, 0xe2, 0x3e, 0x91, 0x8f, 0x8e, 0x08, 0x21, 0xdc, 0x94, 0x2c,
+	0x2c, 0x04, 0xe2, 0x7f, 0x64, 0x97, 0x89, 0x5d, 0x35, 0x6d, 0xe3, 0x31, 0x36, 0x8d, 0x9a, 0x97,
+	0x48, 0x79, 0x08, 0x4a, 0x9c, 0x93, 0xc8, 0x3d, 0x0d, 0x63, 0x35, 0x3e, 0x23, 0x56, 0x20, 0x46,
+	0x8a, 0x02, 0x99, 0x80, 0xfa, 0x6d, 0x9d, 0xe9, 0x8f, 0x88, 0x65, 0x99, 0xcc, 0xc2, 0xb6, 0x9f,
+	0xc1, 0x82, 0x85, 0x18, 0x1f, 0x91, 0xe0, 0x31, 0x4c, 0xf0, 0xa3, 0x54, 0xf1, 0x4d, 0x62, 0x95,
+	0xf3, 0x3d, 0xab, 0xec, 0x8a, 0x70, 0xb5, 0x7a, 0x66, 0xac, 0x14, 0x20, 0xc7, 0xd3, 0x75, 0xb9,
+	0xe9, 0xb6, 0x81, 0xdf, 0x21, 0xce, 0x99, 0xc5, 0x47, 0x2e, 0xab, 0x09, 0xb7, 0x06, 0x88, 0xf1,
+	0xc6, 0xd1, 0x77, 0x60, 0xc5, 0x4f, 0xeb, 0x5f, 0x9c, 0x27, 0x76, 0xa5, 0xde, 0xa4, 0x26, 0xb1,
+	0xf7, 0x3a, 0xd5, 0xaa, 0x1f, 0xf9, 0x9f, 0x12, 0xe4, 0xfa, 0xc7, 0x78, 0xd3, 0xe4, 0x01, 0x9c,
+	0x0b, 0x41, 0x1c, 0x34, 0x07, 0xe3, 0xfe, 0x0b, 0xc1, 0x9f, 0x85, 0x2b, 0xc5, 0xcb, 0xde, 0xb3,
+	0x80, 0x54, 0x18, 0xe5, 0x15, 0x38, 0x75, 0x89, 0x27, 0x4d, 0xa9, 0xa7, 0x15, 0x5a, 0x75, 0x2b,
+	0xb4, 0xea, 0xf2, 0xba, 0x6e, 0xca, 0x2e, 0x4c, 0xbb, 0x57, 0xf6, 0xd9, 0xbb, 0x3b, 0xd5, 0xaa,
+	0x83, 0xa9, 0xff, 0x84, 0xaf, 0xc2, 0x64, 0x4b, 0xaf, 0x9b, 0x55, 0x9d, 0x11, 0xa7, 0xa4, 0xbb,
+	0x36, 0xbe, 0x94, 0xf1, 0xe2, 0x35, 0xdf, 0x20, 0x34, 0xca, 0x36, 0xcc, 0xf4, 0x84, 0x11, 0x1b,
+	0x32, 0x0f, 0x09, 0xdc, 0xb2, 0xba, 0x22, 0x00, 0x6e, 0x59, 0xc2, 0x31, 0xff, 0x6a, 0x02, 0x46,
+	0xb9, 0x18, 0x7d, 0x0a, 0x63, 0x6e, 0x8d, 0x47, 0xbd, 0x6f, 0x7e, 0x6f, 0x23, 0x21, 0x2f, 0xc6,
+	0x3b, 0xb9, 0xf9, 0x95, 0xe9, 0x2f, 0x7f, 0xff, 0xe7, 0xeb, 0x0b, 0xd7, 0xd0, 0x55, 0xaf, 0x55,
+	0x72, 0x1b, 0x07, 0xf4, 0x8b, 0x04, 0xb3, 0x91, 0x55, 0x1f, 0x6d, 0x85, 0xc7, 0xee, 0xd7, 0x65,
+	0xc8, 0xf7, 0x86, 0xd6, 0x09, 0xcc, 0x35, 0x8e, 0xb9, 0x82, 0x96, 0x3c, 0xcc, 0x40, 0xc5, 0xa5,
+	0x9a, 0xe3, 0x8a, 0xa8, 0xf6, 0x39, 0x7f, 0xe6, 0xbe, 0x40, 0x3f, 0x48, 0x30, 0x1d, 0xfe, 0x96,
+	0xa3, 0xcd, 0x70, 0x84, 0xd8, 0xfa, 0x20, 0xdf, 0x19, 0x4e, 0x24, 0xa0, 0x6f, 0x71, 0xe8, 0x2c,
+	0x5a, 0x08, 0x85, 0xe6, 0xa8, 0x5a, 0x9d, 0x87, 0x40, 0x3f, 0x4b, 0x90, 0x8a, 0xaa, 0x0b, 0xe8,
+	0x6e, 0x78, 0xf6, 0x3e, 0xf5, 0x46, 0xde, 0x1a, 0x56, 0x26, 0xb0, 0x57, 0x39, 0xf6, 0x12, 0xca,
+	0xc6, 0x60, 0x63, 0x11, 0x04, 0x3d, 0x87, 0x90, 0x8e, 0x88, 0xa2, 0xf5, 0x41, 0x3f, 0xb4, 0x7f,
+	0x5c, 0x37, 0x86, 0x50, 0xb8, 0xa0, 0xeb, 0x12, 0x7a, 0x29, 0x41, 0x3a, 0xbe, 0x96, 0xa1, 0x07,
+	0x71, 0x9f, 0xae, 0x4f, 0x15, 0x95, 0x1f, 0x9e, 0x4f, 0x1c, 0x75, 0x68, 0xdd, 0x2a, 0xe9, 0x1d,
+	0x57, 0xad, 0xcc, 0x35, 0xfe, 0xa1, 0xfd, 0x4e, 0x82, 0xa9, 0xde, 0xde, 0x6d, 0x90, 0xbd, 0xec,
+	0xea, 0x2f, 0xe5, 0x8d, 0x21, 0x14, 0x03, 0x5d, 0x30, 0xaf, 0x71, 0xf4, 0x59, 0x7f, 0x94, 0x60,
+	0x3a, 0xbc, 0xff, 0x8b, 0xba, 0x60, 0xb1, 0xad, 0xa6, 0x7c, 0x67, 0x38, 0x91, 0x80, 0xbe, 0xcd,
+	0xa1, 0x17, 0x91, 0x12, 0x0a, 0xcd, 0xdb, 0x4e, 0x9f, 0xf8, 0x1b, 0x09, 0xae, 0x87, 0x76, 0x1c,
+	0x28, 0x1f, 0xf7, 0x91, 0xc3, 0x7b, 0x18, 0x79, 0x73, 0x28, 0x8d, 0xc0, 0x9d, 0xe5, 0xb8, 0x53,
+	0x68, 0xd2, 0xc3, 0x6d, 0x7a, 0x8e, 0xe8, 0x37, 0x09, 0x6e, 0xc4, 0x95, 0x7e, 0x74, 0x3f, 0x3c,
+	0xe1, 0x00, 0x2d, 0x87, 0xbc, 0x7d, 0x1e, 0xa9, 0x40, 0xfe, 0x1f, 0x47, 0x5e, 0x46, 0x8b, 0x1e,
+	0x72, 0x57, 0xf5, 0xd6, 0x9c, 0x8e, 0x4e, 0x13, 0xb5, 0xf7, 0x5b, 0x09, 0x92, 0x61, 0x3d, 0x17,
+	0xda, 0x88, 0xdb, 0xae, 0xd0, 0x1e, 0x4e, 0xce, 0x0f, 0x23, 0x11, 0xb4, 0xcb, 0x9c, 0x36, 0x83,
+	0xd2, 0x51, 0xb4, 0xe2, 0xb5, 0x7d, 0x0e, 0x70, 0x5a, 0x8a, 0xd1, 0x4a, 0xc4, 0x3b, 0xd9, 0x5d,
+	0xf3, 0xe5, 0x5c, 0x7f, 0x47, 0x01, 0x32, 0xc7, 0x41, 0xae, 0xa3, 0x29, 0x0f, 0x24, 0x50, 0xe3,
+	0xf3, 0xaf, 0x24, 0x00, 0xde, 0x65, 0xb8, 0x65, 0xfd, 0x57, 0x09, 0xe6, 0x62, 0x5a, 0x27, 0xf4,
+	0xff, 0xe8, 0xcf, 0x17, 0xdf, 0xb1, 0xc9, 0xf7, 0xcf, 0xa1, 0x1c, 0xf4, 0xbb, 0xf3, 0xfe, 0x48,
+	0x7c, 0xf7, 0xc2, 0xde, 0x8b, 0xe3, 0xb4, 0xf4, 0xfa, 0x38, 0x2d, 0xfd, 0x7d, 0x9c, 0x96, 0xbe,
+	0x3a, 0x49, 0x8f, 0xbc, 0x3e, 0x49, 0x8f, 0xfc, 0x71, 0x92, 0x1e, 0xf9, 0x78, 0xcb, 0x30, 0x59,
+	0xad, 0x59, 0x56, 0x2b, 0xc4, 0xd2, 0x3c, 0x18, 0xe2, 0x18, 0xfe, 0xef, 0x35, 0xbd, 0xd1, 0xd0,
+	0x3e, 0xd3, 0xca, 0x75, 0x52, 0xa6, 0xcc, 0xc1, 0xba, 0xe5, 0xfe, 0xab, 0xa6, 0x3c, 0xc6, 0xff,
+	0xf0, 0xde, 0xfc, 0x77, 0x00, 0x59, 0xb5, 0x98, 0xe3, 0x17, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -927,12 +1380,25 @@ type QueryClient interface {
 	LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error)
 	// EarliestAttestationNonce queries the earliest attestation nonce.
 	EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error)
+	// AttestationRequests streams attestation requests in increasing nonce
+	// order. It starts by sending the attestations already in store, from the
+	// provided start nonce or from the earliest available attestation nonce if
+	// none is provided, and then sends every new valset and data commitment as
+	// soon as the EndBlocker creates it.
+	AttestationRequests(ctx context.Context, in *QueryAttestationRequestsRequest, opts ...grpc.CallOption) (Query_AttestationRequestsClient, error)
 	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
 	// And, even if the current nonce is a valset, it will return the previous
 	// one.
 	// If the provided nonce is 1, it will return an error, because, there is
 	// no valset before nonce 1.
 	LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error)
+	// AttestationConfirms queries the confirms submitted for an attestation
+	// request.
+	AttestationConfirms(ctx context.Context, in *QueryAttestationConfirmsRequest, opts ...grpc.CallOption) (*QueryAttestationConfirmsResponse, error)
+	// AttestationSignedPower queries the power of the validators that confirmed
+	// an attestation request along with the power needed for the Blobstream
+	// contract to accept it.
+	AttestationSignedPower(ctx context.Context, in *QueryAttestationSignedPowerRequest, opts ...grpc.CallOption) (*QueryAttestationSignedPowerResponse, error)
 	// LatestUnbondingHeight returns the latest unbonding height
 	LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error)
 	// DataCommitmentRangeForHeight returns the data commitment window
@@ -989,6 +1455,38 @@ func (c *queryClient) EarliestAttestationNonce(ctx context.Context, in *QueryEar
 	return out, nil
 }
 
+func (c *queryClient) AttestationRequests(ctx context.Context, in *QueryAttestationRequestsRequest, opts ...grpc.CallOption) (Query_AttestationRequestsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/celestia.qgb.v1.Query/AttestationRequests", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryAttestationRequestsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_AttestationRequestsClient interface {
+	Recv() (*QueryAttestationRequestsResponse, error)
+	grpc.ClientStream
+}
+
+type queryAttestationRequestsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryAttestationRequestsClient) Recv() (*QueryAttestationRequestsResponse, error) {
+	m := new(QueryAttestationRequestsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
 	out := new(QueryLatestValsetRequestBeforeNonceResponse)
 	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestValsetRequestBeforeNonce", in, out, opts...)
@@ -998,6 +1496,24 @@ func (c *queryClient) LatestValsetRequestBeforeNonce(ctx context.Context, in *Qu
 	return out, nil
 }
 
+func (c *queryClient) AttestationConfirms(ctx context.Context, in *QueryAttestationConfirmsRequest, opts ...grpc.CallOption) (*QueryAttestationConfirmsResponse, error) {
+	out := new(QueryAttestationConfirmsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/AttestationConfirms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationSignedPower(ctx context.Context, in *QueryAttestationSignedPowerRequest, opts ...grpc.CallOption) (*QueryAttestationSignedPowerResponse, error) {
+	out := new(QueryAttestationSignedPowerResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/AttestationSignedPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error) {
 	out := new(QueryLatestUnbondingHeightResponse)
 	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestUnbondingHeight", in, out, opts...)
@@ -1045,12 +1561,25 @@ type QueryServer interface {
 	LatestAttestationNonce(context.Context, *QueryLatestAttestationNonceRequest) (*QueryLatestAttestationNonceResponse, error)
 	// EarliestAttestationNonce queries the earliest attestation nonce.
 	EarliestAttestationNonce(context.Context, *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error)
+	// AttestationRequests streams attestation requests in increasing nonce
+	// order. It starts by sending the attestations already in store, from the
+	// provided start nonce or from the earliest available attestation nonce if
+	// none is provided, and then sends every new valset and data commitment as
+	// soon as the EndBlocker creates it.
+	AttestationRequests(*QueryAttestationRequestsRequest, Query_AttestationRequestsServer) error
 	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
 	// And, even if the current nonce is a valset, it will return the previous
 	// one.
 	// If the provided nonce is 1, it will return an error, because, there is
 	// no valset before nonce 1.
 	LatestValsetRequestBeforeNonce(context.Context, *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error)
+	// AttestationConfirms queries the confirms submitted for an attestation
+	// request.
+	AttestationConfirms(context.Context, *QueryAttestationConfirmsRequest) (*QueryAttestationConfirmsResponse, error)
+	// AttestationSignedPower queries the power of the validators that confirmed
+	// an attestation request along with the power needed for the Blobstream
+	// contract to accept it.
+	AttestationSignedPower(context.Context, *QueryAttestationSignedPowerRequest) (*QueryAttestationSignedPowerResponse, error)
 	// LatestUnbondingHeight returns the latest unbonding height
 	LatestUnbondingHeight(context.Context, *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error)
 	// DataCommitmentRangeForHeight returns the data commitment window
@@ -1079,9 +1608,18 @@ func (*UnimplementedQueryServer) LatestAttestationNonce(ctx context.Context, req
 func (*UnimplementedQueryServer) EarliestAttestationNonce(ctx context.Context, req *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EarliestAttestationNonce not implemented")
 }
+func (*UnimplementedQueryServer) AttestationRequests(req *QueryAttestationRequestsRequest, srv Query_AttestationRequestsServer) error {
+	return status.Errorf(codes.Unimplemented, "method AttestationRequests not implemented")
+}
 func (*UnimplementedQueryServer) LatestValsetRequestBeforeNonce(ctx context.Context, req *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestValsetRequestBeforeNonce not implemented")
 }
+func (*UnimplementedQueryServer) AttestationConfirms(ctx context.Context, req *QueryAttestationConfirmsRequest) (*QueryAttestationConfirmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationConfirms not implemented")
+}
+func (*UnimplementedQueryServer) AttestationSignedPower(ctx context.Context, req *QueryAttestationSignedPowerRequest) (*QueryAttestationSignedPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationSignedPower not implemented")
+}
 func (*UnimplementedQueryServer) LatestUnbondingHeight(ctx context.Context, req *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestUnbondingHeight not implemented")
 }
@@ -1171,6 +1709,27 @@ func _Query_EarliestAttestationNonce_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AttestationRequests_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryAttestationRequestsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).AttestationRequests(m, &queryAttestationRequestsServer{stream})
+}
+
+type Query_AttestationRequestsServer interface {
+	Send(*QueryAttestationRequestsResponse) error
+	grpc.ServerStream
+}
+
+type queryAttestationRequestsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryAttestationRequestsServer) Send(m *QueryAttestationRequestsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Query_LatestValsetRequestBeforeNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLatestValsetRequestBeforeNonceRequest)
 	if err := dec(in); err != nil {
@@ -1189,6 +1748,42 @@ func _Query_LatestValsetRequestBeforeNonce_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AttestationConfirms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationConfirmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationConfirms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/AttestationConfirms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationConfirms(ctx, req.(*QueryAttestationConfirmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationSignedPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationSignedPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationSignedPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/AttestationSignedPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationSignedPower(ctx, req.(*QueryAttestationSignedPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LatestUnbondingHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLatestUnbondingHeightRequest)
 	if err := dec(in); err != nil {
@@ -1285,6 +1880,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LatestValsetRequestBeforeNonce",
 			Handler:    _Query_LatestValsetRequestBeforeNonce_Handler,
 		},
+		{
+			MethodName: "AttestationConfirms",
+			Handler:    _Query_AttestationConfirms_Handler,
+		},
+		{
+			MethodName: "AttestationSignedPower",
+			Handler:    _Query_AttestationSignedPower_Handler,
+		},
 		{
 			MethodName: "LatestUnbondingHeight",
 			Handler:    _Query_LatestUnbondingHeight_Handler,
@@ -1302,6 +1905,92 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Query_EVMAddress_Handler,
 		},
 	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AttestationRequests",
+			Handler:       _Query_AttestationRequests_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "celestia/qgb/v1/query.proto",
+}
+
+// ProofQueryClient is the client API for ProofQuery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProofQueryClient interface {
+	// DataRootTupleInclusionProof returns the data commitment that includes the
+	// provided height along with the data root tuple of that height and a
+	// Merkle proof of the tuple against the data root tuple root of the data
+	// commitment. The proof can be verified by the Blobstream contract.
+	DataRootTupleInclusionProof(ctx context.Context, in *QueryDataRootTupleInclusionProofRequest, opts ...grpc.CallOption) (*QueryDataRootTupleInclusionProofResponse, error)
+}
+
+type proofQueryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewProofQueryClient(cc grpc1.ClientConn) ProofQueryClient {
+	return &proofQueryClient{cc}
+}
+
+func (c *proofQueryClient) DataRootTupleInclusionProof(ctx context.Context, in *QueryDataRootTupleInclusionProofRequest, opts ...grpc.CallOption) (*QueryDataRootTupleInclusionProofResponse, error) {
+	out := new(QueryDataRootTupleInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.ProofQuery/DataRootTupleInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProofQueryServer is the server API for ProofQuery service.
+type ProofQueryServer interface {
+	// DataRootTupleInclusionProof returns the data commitment that includes the
+	// provided height along with the data root tuple of that height and a
+	// Merkle proof of the tuple against the data root tuple root of the data
+	// commitment. The proof can be verified by the Blobstream contract.
+	DataRootTupleInclusionProof(context.Context, *QueryDataRootTupleInclusionProofRequest) (*QueryDataRootTupleInclusionProofResponse, error)
+}
+
+// UnimplementedProofQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedProofQueryServer struct {
+}
+
+func (*UnimplementedProofQueryServer) DataRootTupleInclusionProof(ctx context.Context, req *QueryDataRootTupleInclusionProofRequest) (*QueryDataRootTupleInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataRootTupleInclusionProof not implemented")
+}
+
+func RegisterProofQueryServer(s grpc1.Server, srv ProofQueryServer) {
+	s.RegisterService(&_ProofQuery_serviceDesc, srv)
+}
+
+func _ProofQuery_DataRootTupleInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataRootTupleInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProofQueryServer).DataRootTupleInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.ProofQuery/DataRootTupleInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProofQueryServer).DataRootTupleInclusionProof(ctx, req.(*QueryDataRootTupleInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProofQuery_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.qgb.v1.ProofQuery",
+	HandlerType: (*ProofQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DataRootTupleInclusionProof",
+			Handler:    _ProofQuery_DataRootTupleInclusionProof_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/qgb/v1/query.proto",
 }
@@ -1425,7 +2114,7 @@ func (m *QueryAttestationRequestByNonceResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAttestationRequestsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1435,20 +2124,25 @@ func (m *QueryLatestAttestationNonceRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryLatestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAttestationRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLatestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAttestationRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.StartNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartNonce))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLatestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAttestationRequestsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1458,25 +2152,32 @@ func (m *QueryLatestAttestationNonceResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryLatestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAttestationRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLatestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAttestationRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEarliestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAttestationConfirmsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1486,7 +2187,191 @@ func (m *QueryEarliestAttestationNonceRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryEarliestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAttestationConfirmsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationConfirmsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationConfirmsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationConfirmsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationConfirmsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Confirms) > 0 {
+		for iNdEx := len(m.Confirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationSignedPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationSignedPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationSignedPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationSignedPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationSignedPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationSignedPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataRootTupleRoot) > 0 {
+		i -= len(m.DataRootTupleRoot)
+		copy(dAtA[i:], m.DataRootTupleRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRootTupleRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TwoThirdsThreshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TwoThirdsThreshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SignedPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEarliestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEarliestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -1762,6 +2647,93 @@ func (m *QueryDataCommitmentRangeForHeightResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDataRootTupleInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataRootTupleInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataRootTupleInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DataCommitment != nil {
+		{
+			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryEVMAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1878,49 +2850,59 @@ func (m *QueryAttestationRequestByNonceResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryLatestAttestationNonceRequest) Size() (n int) {
+func (m *QueryAttestationRequestsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.StartNonce != 0 {
+		n += 1 + sovQuery(uint64(m.StartNonce))
+	}
 	return n
 }
 
-func (m *QueryLatestAttestationNonceResponse) Size() (n int) {
+func (m *QueryAttestationRequestsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryEarliestAttestationNonceRequest) Size() (n int) {
+func (m *QueryAttestationConfirmsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
 	return n
 }
 
-func (m *QueryEarliestAttestationNonceResponse) Size() (n int) {
+func (m *QueryAttestationConfirmsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if len(m.Confirms) > 0 {
+		for _, e := range m.Confirms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) Size() (n int) {
+func (m *QueryAttestationSignedPowerRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1932,20 +2914,26 @@ func (m *QueryLatestValsetRequestBeforeNonceRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) Size() (n int) {
+func (m *QueryAttestationSignedPowerResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Valset != nil {
-		l = m.Valset.Size()
+	if m.SignedPower != 0 {
+		n += 1 + sovQuery(uint64(m.SignedPower))
+	}
+	if m.TwoThirdsThreshold != 0 {
+		n += 1 + sovQuery(uint64(m.TwoThirdsThreshold))
+	}
+	l = len(m.DataRootTupleRoot)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryLatestUnbondingHeightRequest) Size() (n int) {
+func (m *QueryLatestAttestationNonceRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1954,15 +2942,82 @@ func (m *QueryLatestUnbondingHeightRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryLatestUnbondingHeightResponse) Size() (n int) {
+func (m *QueryLatestAttestationNonceResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryEarliestAttestationNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEarliestAttestationNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valset != nil {
+		l = m.Valset.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestUnbondingHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestUnbondingHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
 	return n
 }
 
@@ -2013,6 +3068,42 @@ func (m *QueryDataCommitmentRangeForHeightResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDataRootTupleInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		l = m.DataCommitment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryEVMAddressRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2266,21 +3357,522 @@ func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &types.Any{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartNonce", wireType)
+			}
+			m.StartNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &types.Any{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationConfirmsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationConfirmsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationConfirmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationConfirmsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationConfirmsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationConfirmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirms = append(m.Confirms, AttestationConfirm{})
+			if err := m.Confirms[len(m.Confirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationSignedPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationSignedPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationSignedPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationSignedPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationSignedPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationSignedPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedPower", wireType)
+			}
+			m.SignedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwoThirdsThreshold", wireType)
+			}
+			m.TwoThirdsThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwoThirdsThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRootTupleRoot", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2290,27 +3882,23 @@ func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attestation == nil {
-				m.Attestation = &types.Any{}
-			}
-			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DataRootTupleRoot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3136,6 +4724,250 @@ func (m *QueryDataCommitmentRangeForHeightResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *QueryDataRootTupleInclusionProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataRootTupleInclusionProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataRootTupleInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDataRootTupleInclusionProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataRootTupleInclusionProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataRootTupleInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataCommitment == nil {
+				m.DataCommitment = &DataCommitment{}
+			}
+			if err := m.DataCommitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryEVMAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
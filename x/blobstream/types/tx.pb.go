@@ -122,36 +122,158 @@ func (m *MsgRegisterEVMAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRegisterEVMAddressResponse proto.InternalMessageInfo
 
+// MsgSubmitAttestationConfirm submits the signature of a validator over an
+// attestation request.
+type MsgSubmitAttestationConfirm struct {
+	// The operating address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// The nonce of the signed attestation request.
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// The HEX encoded data root tuple root signed over. It must be set when
+	// confirming a data commitment and empty when confirming a valset.
+	DataRootTupleRoot string `protobuf:"bytes,3,opt,name=data_root_tuple_root,json=dataRootTupleRoot,proto3" json:"data_root_tuple_root,omitempty"`
+	// The HEX encoded EVM signature over the sign bytes of the attestation.
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgSubmitAttestationConfirm) Reset()         { *m = MsgSubmitAttestationConfirm{} }
+func (m *MsgSubmitAttestationConfirm) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAttestationConfirm) ProtoMessage()    {}
+func (*MsgSubmitAttestationConfirm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85ed1095628e2204, []int{2}
+}
+func (m *MsgSubmitAttestationConfirm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitAttestationConfirm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAttestationConfirm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitAttestationConfirm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAttestationConfirm.Merge(m, src)
+}
+func (m *MsgSubmitAttestationConfirm) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitAttestationConfirm) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAttestationConfirm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitAttestationConfirm proto.InternalMessageInfo
+
+func (m *MsgSubmitAttestationConfirm) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSubmitAttestationConfirm) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *MsgSubmitAttestationConfirm) GetDataRootTupleRoot() string {
+	if m != nil {
+		return m.DataRootTupleRoot
+	}
+	return ""
+}
+
+func (m *MsgSubmitAttestationConfirm) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+// MsgSubmitAttestationConfirmResponse is the response to submitting an
+// attestation confirm.
+type MsgSubmitAttestationConfirmResponse struct {
+}
+
+func (m *MsgSubmitAttestationConfirmResponse) Reset()         { *m = MsgSubmitAttestationConfirmResponse{} }
+func (m *MsgSubmitAttestationConfirmResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAttestationConfirmResponse) ProtoMessage()    {}
+func (*MsgSubmitAttestationConfirmResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85ed1095628e2204, []int{3}
+}
+func (m *MsgSubmitAttestationConfirmResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitAttestationConfirmResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAttestationConfirmResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitAttestationConfirmResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAttestationConfirmResponse.Merge(m, src)
+}
+func (m *MsgSubmitAttestationConfirmResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitAttestationConfirmResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAttestationConfirmResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitAttestationConfirmResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterEVMAddress)(nil), "celestia.qgb.v1.MsgRegisterEVMAddress")
 	proto.RegisterType((*MsgRegisterEVMAddressResponse)(nil), "celestia.qgb.v1.MsgRegisterEVMAddressResponse")
+	proto.RegisterType((*MsgSubmitAttestationConfirm)(nil), "celestia.qgb.v1.MsgSubmitAttestationConfirm")
+	proto.RegisterType((*MsgSubmitAttestationConfirmResponse)(nil), "celestia.qgb.v1.MsgSubmitAttestationConfirmResponse")
 }
 
 func init() { proto.RegisterFile("celestia/qgb/v1/tx.proto", fileDescriptor_85ed1095628e2204) }
 
 var fileDescriptor_85ed1095628e2204 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4a, 0x03, 0x31,
-	0x10, 0x86, 0x9b, 0x0a, 0x82, 0xf1, 0xa0, 0x2e, 0x15, 0x6a, 0xa9, 0xa9, 0x14, 0x11, 0x2f, 0x4d,
-	0xa8, 0x82, 0x77, 0x0b, 0x3d, 0x16, 0x64, 0x05, 0x0f, 0x5e, 0x4a, 0xb6, 0x0d, 0x31, 0xb0, 0xbb,
-	0xb3, 0xcd, 0xc4, 0xa5, 0x9e, 0x04, 0x9f, 0x40, 0xf4, 0xe6, 0x73, 0xf8, 0x10, 0x1e, 0x8b, 0x5e,
-	0x3c, 0x4a, 0xeb, 0x83, 0x48, 0xbb, 0xbb, 0x45, 0xa4, 0x07, 0x6f, 0x93, 0xfc, 0x5f, 0xfe, 0x7f,
-	0x32, 0x43, 0xab, 0x03, 0x15, 0x2a, 0x74, 0x46, 0x8a, 0x91, 0x0e, 0x44, 0xda, 0x16, 0x6e, 0xcc,
-	0x13, 0x0b, 0x0e, 0xbc, 0xad, 0x42, 0xe1, 0x23, 0x1d, 0xf0, 0xb4, 0x5d, 0xab, 0x68, 0xd0, 0xb0,
-	0xd0, 0xc4, 0xbc, 0xca, 0xb0, 0xda, 0xde, 0x00, 0x30, 0x02, 0xec, 0x67, 0x42, 0x76, 0xc8, 0xa5,
-	0xba, 0x06, 0xd0, 0xa1, 0x12, 0x32, 0x31, 0x42, 0xc6, 0x31, 0x38, 0xe9, 0x0c, 0xc4, 0xb9, 0xda,
-	0xbc, 0xa7, 0xbb, 0x3d, 0xd4, 0xbe, 0xd2, 0x06, 0x9d, 0xb2, 0xdd, 0xab, 0xde, 0xf9, 0x70, 0x68,
-	0x15, 0xa2, 0xd7, 0xa5, 0x3b, 0xa9, 0x0c, 0xcd, 0x50, 0x3a, 0xb0, 0x7d, 0x99, 0x5d, 0x56, 0xc9,
-	0x01, 0x39, 0xde, 0xe8, 0x54, 0xdf, 0x5f, 0x5b, 0x95, 0x3c, 0x23, 0xc7, 0x2f, 0x9d, 0x35, 0xb1,
-	0xf6, 0xb7, 0x97, 0x4f, 0x0a, 0x9b, 0x06, 0xdd, 0x54, 0x69, 0xb4, 0x34, 0x28, 0xcf, 0x0d, 0x7c,
-	0xaa, 0xd2, 0x28, 0x07, 0x9a, 0x0d, 0xba, 0xbf, 0xb2, 0x01, 0x5f, 0x61, 0x02, 0x31, 0xaa, 0x93,
-	0x17, 0x42, 0xd7, 0x7a, 0xa8, 0xbd, 0x27, 0x42, 0xbd, 0x15, 0x7d, 0x1e, 0xf1, 0x3f, 0x13, 0xe2,
-	0x2b, 0xed, 0x6a, 0xfc, 0x7f, 0x5c, 0x11, 0xdb, 0x3c, 0x7c, 0xf8, 0xf8, 0x7e, 0x2e, 0x33, 0xaf,
-	0x5e, 0xac, 0xc4, 0xe6, 0x6c, 0xff, 0xd7, 0x7f, 0x3a, 0x17, 0x6f, 0x53, 0x46, 0x26, 0x53, 0x46,
-	0xbe, 0xa6, 0x8c, 0x3c, 0xce, 0x58, 0x69, 0x32, 0x63, 0xa5, 0xcf, 0x19, 0x2b, 0x5d, 0x9f, 0x69,
-	0xe3, 0x6e, 0x6e, 0x03, 0x3e, 0x80, 0x48, 0x14, 0xc9, 0x60, 0xf5, 0xb2, 0x6e, 0xc9, 0x24, 0x11,
-	0x63, 0x11, 0x84, 0x10, 0xa0, 0xb3, 0x4a, 0x46, 0xc2, 0xdd, 0x25, 0x0a, 0x83, 0xf5, 0xc5, 0x5e,
-	0x4e, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x18, 0x1a, 0xed, 0x13, 0x02, 0x00, 0x00,
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6a, 0x14, 0x41,
+	0x10, 0xc6, 0x77, 0x36, 0x51, 0x48, 0x7b, 0xd0, 0x34, 0x2b, 0x8c, 0xeb, 0x3a, 0x91, 0xf5, 0x0f,
+	0x1e, 0xcc, 0x34, 0x51, 0xf1, 0x9e, 0x48, 0x8e, 0x0b, 0x32, 0x11, 0x0f, 0x5e, 0x86, 0x9e, 0xdd,
+	0xb2, 0x6d, 0x98, 0xe9, 0x9a, 0x74, 0xd7, 0x0e, 0xf1, 0xa2, 0xe0, 0x13, 0x88, 0xde, 0x7c, 0x0e,
+	0x1f, 0x42, 0x6f, 0x41, 0x2f, 0x1e, 0x65, 0xd7, 0x07, 0x91, 0xf9, 0xb7, 0x8a, 0xcc, 0x8a, 0x42,
+	0x6e, 0xd5, 0xfd, 0xfd, 0xa6, 0xfa, 0xfb, 0xba, 0x7a, 0x98, 0x3f, 0x85, 0x14, 0x1c, 0x69, 0x29,
+	0x8e, 0x55, 0x22, 0x8a, 0x3d, 0x41, 0x27, 0x61, 0x6e, 0x91, 0x90, 0x5f, 0x6c, 0x95, 0xf0, 0x58,
+	0x25, 0x61, 0xb1, 0x37, 0x1c, 0x28, 0x54, 0x58, 0x69, 0xa2, 0xac, 0x6a, 0x6c, 0x78, 0x65, 0x8a,
+	0x2e, 0x43, 0x17, 0xd7, 0x42, 0xbd, 0x68, 0xa4, 0x91, 0x42, 0x54, 0x29, 0x08, 0x99, 0x6b, 0x21,
+	0x8d, 0x41, 0x92, 0xa4, 0xd1, 0x34, 0xea, 0xf8, 0x35, 0xbb, 0x3c, 0x71, 0x2a, 0x02, 0xa5, 0x1d,
+	0x81, 0x3d, 0x7c, 0x3a, 0xd9, 0x9f, 0xcd, 0x2c, 0x38, 0xc7, 0x0f, 0xd9, 0x76, 0x21, 0x53, 0x3d,
+	0x93, 0x84, 0x36, 0x96, 0xf5, 0xa6, 0xef, 0x5d, 0xf7, 0xee, 0x6c, 0x1d, 0xf8, 0x5f, 0x3e, 0xee,
+	0x0e, 0x9a, 0x33, 0x1a, 0xfc, 0x88, 0xac, 0x36, 0x2a, 0xba, 0xb4, 0xfa, 0xa4, 0x6d, 0xb3, 0xc3,
+	0x2e, 0x40, 0x91, 0xad, 0x1a, 0xf4, 0xcb, 0x06, 0x11, 0x83, 0x22, 0x6b, 0x80, 0xf1, 0x0e, 0xbb,
+	0xd6, 0x69, 0x20, 0x02, 0x97, 0xa3, 0x71, 0x30, 0xfe, 0xec, 0xb1, 0xab, 0x13, 0xa7, 0x8e, 0xe6,
+	0x49, 0xa6, 0x69, 0x9f, 0x08, 0x5c, 0x9d, 0xe0, 0x11, 0x9a, 0xe7, 0xda, 0x66, 0x67, 0x65, 0x74,
+	0xc0, 0xce, 0x19, 0x34, 0x53, 0xa8, 0x2c, 0x6e, 0x46, 0xf5, 0x82, 0x0b, 0x36, 0x98, 0x49, 0x92,
+	0xb1, 0x45, 0xa4, 0x98, 0xe6, 0x79, 0x0a, 0x55, 0xe9, 0x6f, 0x54, 0x39, 0xb6, 0x4b, 0x2d, 0x42,
+	0xa4, 0x27, 0xa5, 0x52, 0x16, 0x7c, 0xc4, 0xb6, 0x9c, 0x56, 0x46, 0xd2, 0xdc, 0x82, 0xbf, 0x59,
+	0x51, 0xbf, 0x36, 0xc6, 0xb7, 0xd8, 0x8d, 0xbf, 0x44, 0x69, 0x23, 0xdf, 0xfb, 0xd0, 0x67, 0x1b,
+	0x13, 0xa7, 0xf8, 0x3b, 0x8f, 0xf1, 0x8e, 0xd1, 0xdc, 0x0e, 0xff, 0x78, 0x14, 0x61, 0xe7, 0x0d,
+	0x0e, 0xc3, 0x7f, 0xe3, 0x56, 0x37, 0x7d, 0xf3, 0xcd, 0xd7, 0x1f, 0xef, 0xfb, 0x01, 0x1f, 0xb5,
+	0xaf, 0xd0, 0x36, 0x6c, 0xfc, 0xdb, 0x08, 0xf9, 0x2b, 0xe6, 0xaf, 0x9d, 0xc5, 0xdd, 0xae, 0x13,
+	0xd7, 0xd1, 0xc3, 0x07, 0xff, 0x43, 0xb7, 0x2e, 0x0f, 0x1e, 0x7f, 0x5a, 0x04, 0xde, 0xe9, 0x22,
+	0xf0, 0xbe, 0x2f, 0x02, 0xef, 0xed, 0x32, 0xe8, 0x9d, 0x2e, 0x83, 0xde, 0xb7, 0x65, 0xd0, 0x7b,
+	0xf6, 0x50, 0x69, 0x7a, 0x31, 0x4f, 0xc2, 0x29, 0x66, 0xa2, 0xed, 0x8c, 0x56, 0xad, 0xea, 0x5d,
+	0x99, 0xe7, 0xe2, 0x44, 0x24, 0x29, 0x26, 0x8e, 0x2c, 0xc8, 0x4c, 0xd0, 0xcb, 0x1c, 0x5c, 0x72,
+	0xbe, 0xfa, 0x15, 0xee, 0xff, 0x1c, 0x00, 0x59, 0x02, 0x6c, 0x22, 0x86, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -172,6 +294,10 @@ type MsgClient interface {
 	// There are no validity checks of the EVM addresses existence on the Ethereum
 	// state machine.
 	RegisterEVMAddress(ctx context.Context, in *MsgRegisterEVMAddress, opts ...grpc.CallOption) (*MsgRegisterEVMAddressResponse, error)
+	// SubmitAttestationConfirm records the signature of a validator over an
+	// attestation request. The signature is verified against the EVM address
+	// registered for the validator.
+	SubmitAttestationConfirm(ctx context.Context, in *MsgSubmitAttestationConfirm, opts ...grpc.CallOption) (*MsgSubmitAttestationConfirmResponse, error)
 }
 
 type msgClient struct {
@@ -191,6 +317,15 @@ func (c *msgClient) RegisterEVMAddress(ctx context.Context, in *MsgRegisterEVMAd
 	return out, nil
 }
 
+func (c *msgClient) SubmitAttestationConfirm(ctx context.Context, in *MsgSubmitAttestationConfirm, opts ...grpc.CallOption) (*MsgSubmitAttestationConfirmResponse, error) {
+	out := new(MsgSubmitAttestationConfirmResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Msg/SubmitAttestationConfirm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterEVMAddress records an evm address for the validator which is used
@@ -199,6 +334,10 @@ type MsgServer interface {
 	// There are no validity checks of the EVM addresses existence on the Ethereum
 	// state machine.
 	RegisterEVMAddress(context.Context, *MsgRegisterEVMAddress) (*MsgRegisterEVMAddressResponse, error)
+	// SubmitAttestationConfirm records the signature of a validator over an
+	// attestation request. The signature is verified against the EVM address
+	// registered for the validator.
+	SubmitAttestationConfirm(context.Context, *MsgSubmitAttestationConfirm) (*MsgSubmitAttestationConfirmResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -208,6 +347,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) RegisterEVMAddress(ctx context.Context, req *MsgRegisterEVMAddress) (*MsgRegisterEVMAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterEVMAddress not implemented")
 }
+func (*UnimplementedMsgServer) SubmitAttestationConfirm(ctx context.Context, req *MsgSubmitAttestationConfirm) (*MsgSubmitAttestationConfirmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAttestationConfirm not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -231,6 +373,24 @@ func _Msg_RegisterEVMAddress_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitAttestationConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitAttestationConfirm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitAttestationConfirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Msg/SubmitAttestationConfirm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitAttestationConfirm(ctx, req.(*MsgSubmitAttestationConfirm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.qgb.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -239,6 +399,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterEVMAddress",
 			Handler:    _Msg_RegisterEVMAddress_Handler,
 		},
+		{
+			MethodName: "SubmitAttestationConfirm",
+			Handler:    _Msg_SubmitAttestationConfirm_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/qgb/v1/tx.proto",
@@ -304,6 +468,78 @@ func (m *MsgRegisterEVMAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitAttestationConfirm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAttestationConfirm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAttestationConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DataRootTupleRoot) > 0 {
+		i -= len(m.DataRootTupleRoot)
+		copy(dAtA[i:], m.DataRootTupleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DataRootTupleRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAttestationConfirmResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAttestationConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAttestationConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -341,6 +577,39 @@ func (m *MsgRegisterEVMAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitAttestationConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.DataRootTupleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitAttestationConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -511,6 +780,221 @@ func (m *MsgRegisterEVMAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitAttestationConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitAttestationConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitAttestationConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRootTupleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRootTupleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitAttestationConfirmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitAttestationConfirmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitAttestationConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -240,43 +240,133 @@ func (m *DataCommitment) GetTime() time.Time {
 	return time.Time{}
 }
 
+// AttestationConfirm is the signature of a validator over an attestation
+// request.
+type AttestationConfirm struct {
+	// Universal nonce of the signed attestation request.
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// The operating address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// EVM address that was used to sign the attestation request.
+	EvmAddress string `protobuf:"bytes,3,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+	// HEX encoded data root tuple root signed over. It is empty for valsets.
+	DataRootTupleRoot string `protobuf:"bytes,4,opt,name=data_root_tuple_root,json=dataRootTupleRoot,proto3" json:"data_root_tuple_root,omitempty"`
+	// HEX encoded EVM signature over the sign bytes of the attestation request.
+	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *AttestationConfirm) Reset()         { *m = AttestationConfirm{} }
+func (m *AttestationConfirm) String() string { return proto.CompactTextString(m) }
+func (*AttestationConfirm) ProtoMessage()    {}
+func (*AttestationConfirm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5db0e6d49b998544, []int{3}
+}
+func (m *AttestationConfirm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationConfirm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationConfirm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationConfirm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationConfirm.Merge(m, src)
+}
+func (m *AttestationConfirm) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationConfirm) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationConfirm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationConfirm proto.InternalMessageInfo
+
+func (m *AttestationConfirm) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *AttestationConfirm) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *AttestationConfirm) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
+	}
+	return ""
+}
+
+func (m *AttestationConfirm) GetDataRootTupleRoot() string {
+	if m != nil {
+		return m.DataRootTupleRoot
+	}
+	return ""
+}
+
+func (m *AttestationConfirm) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BridgeValidator)(nil), "celestia.qgb.v1.BridgeValidator")
 	proto.RegisterType((*Valset)(nil), "celestia.qgb.v1.Valset")
 	proto.RegisterType((*DataCommitment)(nil), "celestia.qgb.v1.DataCommitment")
+	proto.RegisterType((*AttestationConfirm)(nil), "celestia.qgb.v1.AttestationConfirm")
 }
 
 func init() { proto.RegisterFile("celestia/qgb/v1/types.proto", fileDescriptor_5db0e6d49b998544) }
 
 var fileDescriptor_5db0e6d49b998544 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x86, 0x6b, 0x16, 0xca, 0xe6, 0x4a, 0x4c, 0x0a, 0x13, 0x94, 0x4e, 0x4a, 0xaa, 0x9e, 0x7a,
-	0x99, 0xad, 0x0d, 0x09, 0x21, 0x4e, 0x2c, 0x70, 0x80, 0x1b, 0x8a, 0xd0, 0x0e, 0x5c, 0x2a, 0x3b,
-	0xf9, 0x70, 0x2d, 0xe2, 0x7c, 0x69, 0xec, 0x06, 0xf8, 0x17, 0xfb, 0x31, 0x48, 0xfc, 0x85, 0x09,
-	0x2e, 0x3b, 0x72, 0x02, 0xd4, 0xfe, 0x11, 0x94, 0xb8, 0xe1, 0x30, 0x89, 0xdb, 0x6e, 0x7e, 0xbf,
-	0xc7, 0xdf, 0xab, 0xf7, 0xb5, 0x4c, 0x8f, 0x33, 0x28, 0xc0, 0x3a, 0x2d, 0xf8, 0x4a, 0x49, 0xde,
-	0x9c, 0x72, 0xf7, 0xa5, 0x02, 0xcb, 0xaa, 0x1a, 0x1d, 0x86, 0x87, 0x3d, 0x64, 0x2b, 0x25, 0x59,
-	0x73, 0x3a, 0x39, 0x52, 0xa8, 0xb0, 0x63, 0xbc, 0x3d, 0xf9, 0x6b, 0x93, 0xc7, 0x19, 0x5a, 0x83,
-	0x76, 0xe1, 0x81, 0x17, 0x3b, 0x14, 0x2b, 0x44, 0x55, 0x00, 0xef, 0x94, 0x5c, 0x7f, 0xe0, 0x4e,
-	0x1b, 0xb0, 0x4e, 0x98, 0xca, 0x5f, 0x98, 0xbd, 0xa6, 0x87, 0x49, 0xad, 0x73, 0x05, 0x17, 0xa2,
-	0xd0, 0xb9, 0x70, 0x58, 0x87, 0x47, 0xf4, 0x6e, 0x85, 0x9f, 0xa0, 0x1e, 0x93, 0x29, 0x99, 0x07,
-	0xa9, 0x17, 0x61, 0x4c, 0x47, 0xd0, 0x98, 0x85, 0xc8, 0xf3, 0x1a, 0xac, 0x1d, 0xdf, 0x99, 0x92,
-	0xf9, 0x41, 0x4a, 0xa1, 0x31, 0xe7, 0x7e, 0x32, 0xfb, 0x41, 0xe8, 0xf0, 0x42, 0x14, 0x16, 0x5c,
-	0xeb, 0x50, 0x62, 0x99, 0x41, 0xef, 0xd0, 0x89, 0xf0, 0x05, 0xbd, 0x67, 0xc0, 0x48, 0xa8, 0xdb,
-	0xed, 0xbd, 0xf9, 0xe8, 0x6c, 0xca, 0x6e, 0xf4, 0x63, 0x37, 0xa2, 0x24, 0xc1, 0xd5, 0xaf, 0x78,
-	0x90, 0xf6, 0x6b, 0xe1, 0x43, 0x3a, 0x5c, 0x82, 0x56, 0x4b, 0x37, 0xde, 0xeb, 0x8c, 0x77, 0x2a,
-	0x7c, 0x46, 0x83, 0xb6, 0xd7, 0x38, 0x98, 0x92, 0xf9, 0xe8, 0x6c, 0xc2, 0x7c, 0x69, 0xd6, 0x97,
-	0x66, 0xef, 0xfa, 0xd2, 0xc9, 0x7e, 0x6b, 0x78, 0xf9, 0x3b, 0x26, 0x69, 0xb7, 0xf1, 0xfc, 0xd1,
-	0xf7, 0xaf, 0x27, 0x0f, 0xce, 0x9d, 0x6b, 0xb1, 0xd3, 0x58, 0xa6, 0xb0, 0x5a, 0x83, 0x75, 0x6f,
-	0x66, 0xdf, 0x08, 0xbd, 0xff, 0x4a, 0x38, 0xf1, 0x12, 0x8d, 0xd1, 0xce, 0x40, 0xf9, 0xbf, 0x56,
-	0x31, 0x1d, 0x49, 0x50, 0xba, 0x5c, 0xc8, 0x02, 0xb3, 0x8f, 0xdd, 0xbb, 0x04, 0x29, 0xed, 0x46,
-	0x49, 0x3b, 0x09, 0x8f, 0xe9, 0x01, 0x94, 0xf9, 0x0e, 0xfb, 0xdc, 0xfb, 0x50, 0xe6, 0x1e, 0xde,
-	0x7e, 0xf2, 0xe4, 0xed, 0xd5, 0x26, 0x22, 0xd7, 0x9b, 0x88, 0xfc, 0xd9, 0x44, 0xe4, 0x72, 0x1b,
-	0x0d, 0xae, 0xb7, 0xd1, 0xe0, 0xe7, 0x36, 0x1a, 0xbc, 0x7f, 0xaa, 0xb4, 0x5b, 0xae, 0x25, 0xcb,
-	0xd0, 0xf0, 0xfe, 0xe5, 0xb1, 0x56, 0xff, 0xce, 0x27, 0xa2, 0xaa, 0xf8, 0x67, 0x2e, 0x0b, 0x94,
-	0xd6, 0xd5, 0x20, 0x8c, 0xff, 0x8c, 0x72, 0xd8, 0xc5, 0x79, 0xf2, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0x43, 0xb6, 0x7e, 0xfe, 0xac, 0x02, 0x00, 0x00,
+	// 515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0x8e, 0x7f, 0x4d, 0xf3, 0x6b, 0x36, 0x12, 0xa5, 0x26, 0x02, 0x93, 0x22, 0x27, 0xca, 0x29,
+	0x97, 0xd8, 0x6a, 0x91, 0x10, 0xe2, 0x44, 0x5d, 0x90, 0xe0, 0x86, 0x4c, 0xd5, 0x03, 0x97, 0x68,
+	0x1d, 0x4f, 0x37, 0x2b, 0xbc, 0x1e, 0x67, 0x3d, 0x09, 0xf0, 0x16, 0x7d, 0x98, 0x4a, 0xbc, 0x42,
+	0x05, 0x97, 0x8a, 0x13, 0x27, 0xfe, 0x24, 0x2f, 0x82, 0xbc, 0xb6, 0x0b, 0x44, 0xea, 0x91, 0xdb,
+	0x7c, 0xdf, 0x37, 0x33, 0x9a, 0x6f, 0x76, 0x96, 0xed, 0x4f, 0x21, 0x81, 0x9c, 0x24, 0xf7, 0xe7,
+	0x22, 0xf2, 0x97, 0x07, 0x3e, 0x7d, 0xc8, 0x20, 0xf7, 0x32, 0x8d, 0x84, 0xf6, 0x6e, 0x2d, 0x7a,
+	0x73, 0x11, 0x79, 0xcb, 0x83, 0x5e, 0x57, 0xa0, 0x40, 0xa3, 0xf9, 0x45, 0x54, 0xa6, 0xf5, 0xee,
+	0x4f, 0x31, 0x57, 0x98, 0x4f, 0x4a, 0xa1, 0x04, 0x95, 0xd4, 0x17, 0x88, 0x22, 0x01, 0xdf, 0xa0,
+	0x68, 0x71, 0xe6, 0x93, 0x54, 0x90, 0x13, 0x57, 0x59, 0x99, 0x30, 0x7c, 0xc1, 0x76, 0x03, 0x2d,
+	0x63, 0x01, 0xa7, 0x3c, 0x91, 0x31, 0x27, 0xd4, 0x76, 0x97, 0x6d, 0x67, 0xf8, 0x0e, 0xb4, 0x63,
+	0x0d, 0xac, 0x51, 0x33, 0x2c, 0x81, 0xdd, 0x67, 0x1d, 0x58, 0xaa, 0x09, 0x8f, 0x63, 0x0d, 0x79,
+	0xee, 0xfc, 0x37, 0xb0, 0x46, 0xed, 0x90, 0xc1, 0x52, 0x1d, 0x95, 0xcc, 0xf0, 0xb3, 0xc5, 0x5a,
+	0xa7, 0x3c, 0xc9, 0x81, 0x8a, 0x0e, 0x29, 0xa6, 0x53, 0xa8, 0x3b, 0x18, 0x60, 0x3f, 0x65, 0xff,
+	0x2b, 0x50, 0x11, 0xe8, 0xa2, 0x7a, 0x6b, 0xd4, 0x39, 0x1c, 0x78, 0x1b, 0xfe, 0xbc, 0x8d, 0x51,
+	0x82, 0xe6, 0xe5, 0xb7, 0x7e, 0x23, 0xac, 0xcb, 0xec, 0xbb, 0xac, 0x35, 0x03, 0x29, 0x66, 0xe4,
+	0x6c, 0x99, 0xc6, 0x15, 0xb2, 0x1f, 0xb3, 0x66, 0xe1, 0xcb, 0x69, 0x0e, 0xac, 0x51, 0xe7, 0xb0,
+	0xe7, 0x95, 0xa6, 0xbd, 0xda, 0xb4, 0x77, 0x52, 0x9b, 0x0e, 0x76, 0x8a, 0x86, 0xe7, 0xdf, 0xfb,
+	0x56, 0x68, 0x2a, 0x9e, 0xdc, 0xfb, 0x74, 0x31, 0xbe, 0x73, 0x44, 0x54, 0xc8, 0x24, 0x31, 0x0d,
+	0x61, 0xbe, 0x80, 0x9c, 0x5e, 0x0e, 0x3f, 0x5a, 0xec, 0xd6, 0x33, 0x4e, 0xfc, 0x18, 0x95, 0x92,
+	0xa4, 0x20, 0xbd, 0xc9, 0x55, 0x9f, 0x75, 0x22, 0x10, 0x32, 0x9d, 0x44, 0x09, 0x4e, 0xdf, 0x9a,
+	0xbd, 0x34, 0x43, 0x66, 0xa8, 0xa0, 0x60, 0xec, 0x7d, 0xd6, 0x86, 0x34, 0xae, 0xe4, 0x72, 0xee,
+	0x1d, 0x48, 0xe3, 0x52, 0xfc, 0x07, 0x93, 0xff, 0xb4, 0x98, 0xfd, 0x07, 0x7f, 0x8c, 0xe9, 0x99,
+	0xd4, 0xea, 0x86, 0xe9, 0x9f, 0xb3, 0xbd, 0x65, 0xbd, 0xed, 0xbf, 0xdf, 0x36, 0x70, 0xbe, 0x5c,
+	0x8c, 0xbb, 0xd5, 0x31, 0x55, 0x6f, 0xfc, 0x9a, 0xb4, 0x4c, 0x45, 0x78, 0xfb, 0xba, 0xa4, 0xe2,
+	0x37, 0x8f, 0x63, 0x6b, 0xf3, 0x38, 0x6c, 0x9f, 0x75, 0x63, 0x4e, 0x7c, 0xa2, 0x11, 0x69, 0x42,
+	0x8b, 0x2c, 0x01, 0x13, 0x1a, 0xdf, 0xed, 0x70, 0xaf, 0xd0, 0x42, 0x44, 0x3a, 0x29, 0x94, 0x22,
+	0xb0, 0x1f, 0xb0, 0x76, 0x2e, 0x45, 0xca, 0x69, 0xa1, 0xc1, 0xd9, 0x36, 0x59, 0xbf, 0x89, 0xe0,
+	0xd5, 0xe5, 0xca, 0xb5, 0xae, 0x56, 0xae, 0xf5, 0x63, 0xe5, 0x5a, 0xe7, 0x6b, 0xb7, 0x71, 0xb5,
+	0x76, 0x1b, 0x5f, 0xd7, 0x6e, 0xe3, 0xcd, 0x23, 0x21, 0x69, 0xb6, 0x88, 0xbc, 0x29, 0x2a, 0xbf,
+	0xbe, 0x2e, 0xd4, 0xe2, 0x3a, 0x1e, 0xf3, 0x2c, 0xf3, 0xdf, 0xfb, 0x51, 0x82, 0x51, 0x4e, 0x1a,
+	0xb8, 0x2a, 0x3f, 0x5c, 0xd4, 0x32, 0x2b, 0x7f, 0xf8, 0x6b, 0x00, 0x08, 0x4f, 0xe9, 0xb4, 0x90,
+	0x03, 0x00, 0x00,
 }
 
 func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
@@ -415,6 +505,62 @@ func (m *DataCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttestationConfirm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationConfirm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DataRootTupleRoot) > 0 {
+		i -= len(m.DataRootTupleRoot)
+		copy(dAtA[i:], m.DataRootTupleRoot)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DataRootTupleRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -485,6 +631,34 @@ func (m *DataCommitment) Size() (n int) {
 	return n
 }
 
+func (m *AttestationConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.DataRootTupleRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -887,6 +1061,203 @@ func (m *DataCommitment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AttestationConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRootTupleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRootTupleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
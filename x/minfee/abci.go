@@ -0,0 +1,11 @@
+package minfee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker adjusts the network base gas price for the next block based on
+// the blob utilization of the data square of the current block.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.UpdateNetworkBaseGasPrice(ctx)
+}
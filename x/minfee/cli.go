@@ -0,0 +1,104 @@
+package minfee
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the CLI query commands for the minfee module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryNetworkMinGasPrice())
+	cmd.AddCommand(CmdQueryNetworkBaseGasPrice())
+	return cmd
+}
+
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query for the parameters of the minfee module",
+		Args:    cobra.NoArgs,
+		Example: "params",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := NewQueryClient(clientCtx)
+			resp, err := queryClient.Params(cmd.Context(), &QueryParams{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&resp.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryNetworkMinGasPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "network-min-gas-price",
+		Short:   "Query for the network minimum gas price",
+		Args:    cobra.NoArgs,
+		Example: "network-min-gas-price",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := NewQueryClient(clientCtx)
+			resp, err := queryClient.NetworkMinGasPrice(cmd.Context(), &QueryNetworkMinGasPrice{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryNetworkBaseGasPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "network-base-gas-price",
+		Short:   "Query for the minimum gas price currently enforced by the network",
+		Long:    "Query for the minimum gas price currently enforced by the network. It is the dynamic network base gas price if enabled and the network minimum gas price otherwise.",
+		Args:    cobra.NoArgs,
+		Example: "network-base-gas-price",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := NewQueryClient(clientCtx)
+			resp, err := queryClient.NetworkBaseGasPrice(cmd.Context(), &QueryNetworkBaseGasPrice{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
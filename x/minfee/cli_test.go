@@ -0,0 +1,68 @@
+package minfee_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/test/util/testnode"
+	"github.com/celestiaorg/celestia-app/v2/x/minfee"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestCLITestSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping minfee CLI test in short mode")
+	}
+	suite.Run(t, new(CLITestSuite))
+}
+
+type CLITestSuite struct {
+	suite.Suite
+
+	ctx testnode.Context
+}
+
+func (s *CLITestSuite) SetupSuite() {
+	s.T().Log("setting up minfee CLI test suite")
+	ctx, _, _ := testnode.NewNetwork(s.T(), testnode.DefaultConfig())
+	s.ctx = ctx
+	_, err := s.ctx.WaitForHeight(1)
+	s.Require().NoError(err)
+}
+
+func (s *CLITestSuite) TestCmdQueryParams() {
+	output, err := clitestutil.ExecTestCLICmd(s.ctx.Context, minfee.CmdQueryParams(), []string{})
+	s.Require().NoError(err)
+	s.Require().Contains(output.String(), "network_min_gas_price")
+	s.Require().Contains(output.String(), "dynamic_base_gas_price_enabled")
+	s.Require().Contains(output.String(), "target_blob_square_utilization")
+}
+
+func (s *CLITestSuite) TestCmdQueryNetworkMinGasPrice() {
+	output, err := clitestutil.ExecTestCLICmd(s.ctx.Context, minfee.CmdQueryNetworkMinGasPrice(), []string{})
+	s.Require().NoError(err)
+	s.Require().Contains(output.String(), minfee.DefaultNetworkMinGasPrice.String())
+}
+
+func (s *CLITestSuite) TestCmdQueryNetworkBaseGasPrice() {
+	output, err := clitestutil.ExecTestCLICmd(s.ctx.Context, minfee.CmdQueryNetworkBaseGasPrice(), []string{})
+	s.Require().NoError(err)
+	s.Require().Contains(output.String(), "network_base_gas_price")
+	s.Require().Contains(output.String(), minfee.DefaultNetworkMinGasPrice.String())
+}
+
+func (s *CLITestSuite) TestQueryParamsREST() {
+	baseURL := strings.Replace(s.ctx.APIAddress(), "tcp", "http", 1)
+	resp, err := testutil.GetRequestWithHeaders(fmt.Sprintf("%s/celestia/minfee/v1/params", baseURL), map[string]string{})
+	s.Require().NoError(err)
+
+	var params minfee.QueryParamsResponse
+	s.Require().NoError(s.ctx.Codec.UnmarshalJSON(resp, &params))
+	// the empty params lists are decoded from JSON as empty slices rather
+	// than nil, so the params are compared by their text encoding.
+	want := minfee.DefaultParams()
+	s.Require().Equal(want.String(), params.Params.String())
+}
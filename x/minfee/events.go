@@ -0,0 +1,7 @@
+package minfee
+
+const (
+	EventTypeUpdateNetworkBaseGasPrice = "update_network_base_gas_price"
+	AttributeKeyNetworkBaseGasPrice    = "network_base_gas_price"
+	AttributeKeyBlobSquareUtilization  = "blob_square_utilization"
+)
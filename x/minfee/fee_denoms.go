@@ -0,0 +1,31 @@
+package minfee
+
+import (
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeDenomRate returns the conversion rate to utia of denom and whether denom
+// is accepted as tx fees.
+func (p Params) FeeDenomRate(denom string) (sdk.Dec, bool) {
+	for _, rate := range p.FeeDenomRates {
+		if rate.Denom == denom {
+			return rate.Rate, true
+		}
+	}
+	return sdk.Dec{}, false
+}
+
+// FeeInUtia returns the value in utia of a tx fee. It is the utia of the fee
+// plus the amounts of the whitelisted IBC denoms of the fee converted to utia,
+// truncated to an integer amount. Other denoms of the fee are ignored.
+func FeeInUtia(fee sdk.Coins, rates []FeeDenomRate) math.Int {
+	value := sdk.NewDecFromInt(fee.AmountOf(appconsts.BondDenom))
+	for _, rate := range rates {
+		if amount := fee.AmountOf(rate.Denom); amount.IsPositive() {
+			value = value.Add(rate.Rate.MulInt(amount))
+		}
+	}
+	return value.TruncateInt()
+}
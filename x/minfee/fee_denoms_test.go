@@ -0,0 +1,109 @@
+package minfee_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v2/x/minfee"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const testFeeDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+func TestValidateFeeDenomRates(t *testing.T) {
+	testCases := []struct {
+		name    string
+		rates   []minfee.FeeDenomRate
+		wantErr bool
+	}{
+		{
+			name:  "no fee denoms",
+			rates: []minfee.FeeDenomRate{},
+		},
+		{
+			name:  "ibc denom",
+			rates: []minfee.FeeDenomRate{{Denom: testFeeDenom, Rate: sdk.NewDecWithPrec(25, 2)}},
+		},
+		{
+			name:    "utia",
+			rates:   []minfee.FeeDenomRate{{Denom: appconsts.BondDenom, Rate: sdk.OneDec()}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid ibc denom hash",
+			rates:   []minfee.FeeDenomRate{{Denom: "ibc/XYZ", Rate: sdk.OneDec()}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate denom",
+			rates: []minfee.FeeDenomRate{
+				{Denom: testFeeDenom, Rate: sdk.OneDec()},
+				{Denom: testFeeDenom, Rate: sdk.NewDec(2)},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "negative rate",
+			rates:   []minfee.FeeDenomRate{{Denom: testFeeDenom, Rate: sdk.NewDec(-1)}},
+			wantErr: true,
+		},
+		{
+			name:    "nil rate",
+			rates:   []minfee.FeeDenomRate{{Denom: testFeeDenom}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := minfee.DefaultParams()
+			params.FeeDenomRates = tc.rates
+			err := params.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFeeInUtia(t *testing.T) {
+	rates := []minfee.FeeDenomRate{{Denom: testFeeDenom, Rate: sdk.NewDecWithPrec(25, 2)}}
+	testCases := []struct {
+		name string
+		fee  sdk.Coins
+		want int64
+	}{
+		{
+			name: "utia",
+			fee:  sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 100)),
+			want: 100,
+		},
+		{
+			name: "whitelisted denom",
+			fee:  sdk.NewCoins(sdk.NewInt64Coin(testFeeDenom, 400)),
+			want: 100,
+		},
+		{
+			name: "converted amount is truncated",
+			fee:  sdk.NewCoins(sdk.NewInt64Coin(testFeeDenom, 403)),
+			want: 100,
+		},
+		{
+			name: "utia and whitelisted denom",
+			fee:  sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1), sdk.NewInt64Coin(testFeeDenom, 403)),
+			want: 101,
+		},
+		{
+			name: "denom that isn't whitelisted",
+			fee:  sdk.NewCoins(sdk.NewInt64Coin("ibc/0000000000000000000000000000000000000000000000000000000000000000", 400)),
+			want: 0,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, sdk.NewInt(tc.want), minfee.FeeInUtia(tc.fee, rates))
+		})
+	}
+}
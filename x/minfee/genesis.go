@@ -4,35 +4,78 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	params "github.com/cosmos/cosmos-sdk/x/params/keeper"
 )
 
 // DefaultGenesis returns the default genesis state.
 func DefaultGenesis() *GenesisState {
+	params := DefaultParams()
 	return &GenesisState{
-		NetworkMinGasPrice: DefaultNetworkMinGasPrice,
+		NetworkMinGasPrice:          params.NetworkMinGasPrice,
+		DynamicBaseGasPriceEnabled:  params.DynamicBaseGasPriceEnabled,
+		MaxNetworkBaseGasPrice:      params.MaxNetworkBaseGasPrice,
+		BaseGasPriceChangeRate:      params.BaseGasPriceChangeRate,
+		TargetBlobSquareUtilization: params.TargetBlobSquareUtilization,
+		NetworkBaseGasPrice:         sdk.ZeroDec(),
 	}
 }
 
 // ValidateGenesis performs basic validation of genesis data returning an error for any failed validation criteria.
 func ValidateGenesis(genesis *GenesisState) error {
-	if genesis.NetworkMinGasPrice.IsNegative() || genesis.NetworkMinGasPrice.IsZero() {
-		return fmt.Errorf("network min gas price cannot be negative or zero: %g", genesis.NetworkMinGasPrice)
+	if genesis.NetworkMinGasPrice.IsNil() || genesis.NetworkMinGasPrice.IsNegative() || genesis.NetworkMinGasPrice.IsZero() {
+		return fmt.Errorf("network min gas price cannot be negative or zero: %v", genesis.NetworkMinGasPrice)
+	}
+	if err := genesis.params().Validate(); err != nil {
+		return err
+	}
+	if !genesis.NetworkBaseGasPrice.IsNil() && genesis.NetworkBaseGasPrice.IsNegative() {
+		return fmt.Errorf("network base gas price cannot be negative: %v", genesis.NetworkBaseGasPrice)
 	}
 
 	return nil
 }
 
-// ExportGenesis returns the minfee module's exported genesis.
-func ExportGenesis(ctx sdk.Context, k params.Keeper) *GenesisState {
-	subspace, exists := k.GetSubspace(ModuleName)
-	if !exists {
-		panic("minfee subspace not set")
-	}
-	subspace = RegisterMinFeeParamTable(subspace)
+// params returns the params of the genesis state. The dynamic base gas price
+// params that are missing from the genesis state are set to their default.
+func (gs GenesisState) params() Params {
+	return Params{
+		NetworkMinGasPrice:          gs.NetworkMinGasPrice,
+		DynamicBaseGasPriceEnabled:  gs.DynamicBaseGasPriceEnabled,
+		MaxNetworkBaseGasPrice:      gs.MaxNetworkBaseGasPrice,
+		BaseGasPriceChangeRate:      gs.BaseGasPriceChangeRate,
+		TargetBlobSquareUtilization: gs.TargetBlobSquareUtilization,
+		FeeDenomRates:               gs.FeeDenomRates,
+		MsgGasPriceMultipliers:      gs.MsgGasPriceMultipliers,
+	}.withDefaults()
+}
 
-	var networkMinGasPrice sdk.Dec
-	subspace.Get(ctx, KeyNetworkMinGasPrice, &networkMinGasPrice)
+// InitGenesis sets the params and the network base gas price of the genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, genesis GenesisState) {
+	k.SetParams(ctx, genesis.params())
+	if !genesis.NetworkBaseGasPrice.IsNil() && genesis.NetworkBaseGasPrice.IsPositive() {
+		k.SetNetworkBaseGasPrice(ctx, genesis.NetworkBaseGasPrice)
+	}
+}
 
-	return &GenesisState{NetworkMinGasPrice: networkMinGasPrice}
+// ExportGenesis returns the minfee module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) *GenesisState {
+	params := k.GetParams(ctx)
+	genesis := &GenesisState{
+		NetworkMinGasPrice:          params.NetworkMinGasPrice,
+		DynamicBaseGasPriceEnabled:  params.DynamicBaseGasPriceEnabled,
+		MaxNetworkBaseGasPrice:      params.MaxNetworkBaseGasPrice,
+		BaseGasPriceChangeRate:      params.BaseGasPriceChangeRate,
+		TargetBlobSquareUtilization: params.TargetBlobSquareUtilization,
+		NetworkBaseGasPrice:         sdk.ZeroDec(),
+	}
+	if len(params.FeeDenomRates) > 0 {
+		genesis.FeeDenomRates = params.FeeDenomRates
+	}
+	if len(params.MsgGasPriceMultipliers) > 0 {
+		genesis.MsgGasPriceMultipliers = params.MsgGasPriceMultipliers
+	}
+	if k.Subspace().Has(ctx, KeyNetworkBaseGasPrice) {
+		genesis.NetworkBaseGasPrice, _ = k.GetNetworkBaseGasPrice(ctx)
+	}
+	return genesis
 }
@@ -28,6 +28,27 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the minfee module's genesis state.
 type GenesisState struct {
 	NetworkMinGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=network_min_gas_price,json=networkMinGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"network_min_gas_price"`
+	// dynamic_base_gas_price_enabled enables the network base gas price, which
+	// is adjusted every block based on the blob utilization of the data square.
+	DynamicBaseGasPriceEnabled bool `protobuf:"varint,2,opt,name=dynamic_base_gas_price_enabled,json=dynamicBaseGasPriceEnabled,proto3" json:"dynamic_base_gas_price_enabled,omitempty"`
+	// max_network_base_gas_price is the upper bound of the network base gas
+	// price.
+	MaxNetworkBaseGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_network_base_gas_price,json=maxNetworkBaseGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_network_base_gas_price"`
+	// base_gas_price_change_rate is the max fraction by which the network base
+	// gas price can change from one block to the next.
+	BaseGasPriceChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=base_gas_price_change_rate,json=baseGasPriceChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_gas_price_change_rate"`
+	// target_blob_square_utilization is the fraction of the data square
+	// occupied by blobs that keeps the network base gas price unchanged.
+	TargetBlobSquareUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=target_blob_square_utilization,json=targetBlobSquareUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_blob_square_utilization"`
+	// network_base_gas_price is the current network base gas price. It is unset
+	// until the dynamic base gas price is first adjusted.
+	NetworkBaseGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=network_base_gas_price,json=networkBaseGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"network_base_gas_price"`
+	// fee_denom_rates are the IBC denoms that are accepted as tx fees and their
+	// conversion rate to utia.
+	FeeDenomRates []FeeDenomRate `protobuf:"bytes,7,rep,name=fee_denom_rates,json=feeDenomRates,proto3" json:"fee_denom_rates"`
+	// msg_gas_price_multipliers are the multipliers of the network minimum gas
+	// price per message type.
+	MsgGasPriceMultipliers []MsgGasPriceMultiplier `protobuf:"bytes,8,rep,name=msg_gas_price_multipliers,json=msgGasPriceMultipliers,proto3" json:"msg_gas_price_multipliers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -63,6 +84,27 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetDynamicBaseGasPriceEnabled() bool {
+	if m != nil {
+		return m.DynamicBaseGasPriceEnabled
+	}
+	return false
+}
+
+func (m *GenesisState) GetFeeDenomRates() []FeeDenomRate {
+	if m != nil {
+		return m.FeeDenomRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMsgGasPriceMultipliers() []MsgGasPriceMultiplier {
+	if m != nil {
+		return m.MsgGasPriceMultipliers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "celestia.minfee.v1.GenesisState")
 }
@@ -70,23 +112,38 @@ func init() {
 func init() { proto.RegisterFile("celestia/minfee/v1/genesis.proto", fileDescriptor_40506204178306cf) }
 
 var fileDescriptor_40506204178306cf = []byte{
-	// 250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0xcf, 0xcd, 0xcc, 0x4b, 0x4b, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa9,
-	0xd0, 0x83, 0xa8, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83,
-	0x58, 0x10, 0x95, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07,
-	0x22, 0xa5, 0x54, 0xcf, 0xc5, 0xe3, 0x0e, 0x31, 0x35, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0x28, 0x9f,
-	0x4b, 0x34, 0x2f, 0xb5, 0xa4, 0x3c, 0xbf, 0x28, 0x3b, 0x3e, 0x37, 0x33, 0x2f, 0x3e, 0x3d, 0x11,
-	0xa4, 0x2b, 0x33, 0x39, 0x55, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0xc9, 0xe6, 0xc4, 0x3d, 0x79,
-	0x86, 0x5b, 0xf7, 0xe4, 0xd5, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xa1,
-	0xe6, 0x41, 0x29, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0x62, 0x3d, 0x97, 0xd4,
-	0xe4, 0x4b, 0x5b, 0x74, 0xb9, 0xa0, 0xd6, 0xb9, 0xa4, 0x26, 0x07, 0x09, 0x41, 0x8d, 0xf6, 0xcd,
-	0xcc, 0x73, 0x4f, 0x2c, 0x0e, 0x00, 0x99, 0xeb, 0xe4, 0x76, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
-	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
-	0xc7, 0x72, 0x0c, 0x51, 0x3a, 0xc8, 0x76, 0x40, 0xbd, 0x9a, 0x5f, 0x94, 0x0e, 0x67, 0xeb, 0x26,
-	0x16, 0x14, 0xe8, 0x57, 0x40, 0x83, 0x27, 0x89, 0x0d, 0xec, 0x1f, 0x63, 0x40, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xc1, 0xc2, 0x03, 0x17, 0x38, 0x01, 0x00, 0x00,
+	// 488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0x86, 0x63, 0x5a, 0x4a, 0x39, 0x40, 0x48, 0x06, 0x22, 0x37, 0x48, 0x8e, 0xc5, 0x80, 0x82,
+	0x44, 0x6c, 0x15, 0x56, 0x26, 0x13, 0xda, 0x29, 0x15, 0x4a, 0xc5, 0xc2, 0x72, 0xfa, 0xec, 0x7c,
+	0xb9, 0x1e, 0xf5, 0xdd, 0xb9, 0xbe, 0x4b, 0x49, 0xd9, 0xf8, 0x07, 0xfc, 0x18, 0x7e, 0x44, 0xc7,
+	0x8a, 0x09, 0x31, 0x54, 0x28, 0xd9, 0xf8, 0x15, 0xc8, 0xf6, 0xa5, 0x35, 0x10, 0xb6, 0x4c, 0xb9,
+	0xf8, 0x7b, 0xef, 0x7d, 0x5e, 0xbd, 0xa7, 0x8f, 0x04, 0x29, 0x66, 0xa8, 0x0d, 0x87, 0x48, 0x70,
+	0x39, 0x41, 0x8c, 0x4e, 0x77, 0x23, 0x86, 0x12, 0x35, 0xd7, 0x61, 0x5e, 0x28, 0xa3, 0x5c, 0x77,
+	0xa9, 0x08, 0x6b, 0x45, 0x78, 0xba, 0xdb, 0x79, 0xc8, 0x14, 0x53, 0xd5, 0x38, 0x2a, 0x4f, 0xb5,
+	0xb2, 0xb3, 0x93, 0x2a, 0x2d, 0x94, 0xa6, 0xf5, 0xa0, 0xfe, 0x63, 0x47, 0xdd, 0x15, 0x98, 0x1c,
+	0x0a, 0x10, 0x56, 0xf0, 0xe4, 0xd7, 0x16, 0xb9, 0xbb, 0x5f, 0x73, 0x0f, 0x0d, 0x18, 0x74, 0x15,
+	0x79, 0x24, 0xd1, 0x7c, 0x54, 0xc5, 0x31, 0x15, 0x5c, 0x52, 0x06, 0xa5, 0x2f, 0x4f, 0xd1, 0x73,
+	0x02, 0xa7, 0x77, 0x3b, 0x7e, 0x75, 0x7e, 0xd9, 0x6d, 0xfd, 0xb8, 0xec, 0x3e, 0x65, 0xdc, 0x1c,
+	0x4d, 0x93, 0x30, 0x55, 0xc2, 0x12, 0xed, 0x4f, 0x5f, 0x8f, 0x8f, 0x23, 0x73, 0x96, 0xa3, 0x0e,
+	0x07, 0x98, 0x7e, 0xfb, 0xda, 0x27, 0x36, 0xd0, 0x00, 0xd3, 0x91, 0x6b, 0xad, 0x87, 0x5c, 0xee,
+	0x83, 0x7e, 0x5b, 0xfa, 0xba, 0x31, 0xf1, 0xc7, 0x67, 0x12, 0x04, 0x4f, 0x69, 0x02, 0x1a, 0xaf,
+	0x89, 0x14, 0x25, 0x24, 0x19, 0x8e, 0xbd, 0x1b, 0x81, 0xd3, 0xdb, 0x1e, 0x75, 0xac, 0x2a, 0x06,
+	0x8d, 0xcb, 0xcb, 0x6f, 0x6a, 0x85, 0x3b, 0x23, 0x1d, 0x01, 0x33, 0xba, 0x0c, 0xfe, 0xa7, 0x8f,
+	0xb7, 0xb1, 0x86, 0xe4, 0x6d, 0x01, 0xb3, 0x83, 0xda, 0xbe, 0x19, 0xa0, 0x24, 0xff, 0x95, 0x3a,
+	0x3d, 0x02, 0xc9, 0x90, 0x16, 0x60, 0xd0, 0xdb, 0x5c, 0x07, 0x39, 0x69, 0xf0, 0x5e, 0x57, 0xe6,
+	0xa3, 0xf2, 0xa1, 0x3e, 0x3b, 0xc4, 0x37, 0x50, 0x30, 0x34, 0x34, 0xc9, 0x54, 0x42, 0xf5, 0xc9,
+	0x14, 0x0a, 0xa4, 0x53, 0xc3, 0x33, 0xfe, 0x09, 0x0c, 0x57, 0xd2, 0xbb, 0xb9, 0x06, 0xfc, 0xe3,
+	0x9a, 0x11, 0x67, 0x2a, 0x39, 0xac, 0x08, 0xef, 0xae, 0x01, 0xee, 0x09, 0x69, 0xff, 0xa7, 0xf3,
+	0xad, 0x35, 0xa0, 0x1f, 0xc8, 0x15, 0x85, 0x1f, 0x90, 0xfb, 0x13, 0x44, 0x3a, 0x46, 0xa9, 0x44,
+	0x55, 0xb2, 0xf6, 0x6e, 0x05, 0x1b, 0xbd, 0x3b, 0x2f, 0x82, 0xf0, 0xdf, 0x85, 0x09, 0xf7, 0x10,
+	0x07, 0xa5, 0xb2, 0x6c, 0x2c, 0xde, 0x2c, 0xd3, 0x8c, 0xee, 0x4d, 0x1a, 0xdf, 0xb4, 0xfb, 0x81,
+	0xec, 0x08, 0xcd, 0x1a, 0xef, 0x27, 0xa6, 0x99, 0xe1, 0x79, 0xc6, 0xb1, 0xd0, 0xde, 0x76, 0xe5,
+	0xfc, 0x6c, 0x95, 0xf3, 0x50, 0xb3, 0x65, 0xa6, 0xe1, 0xd5, 0x0d, 0x8b, 0x68, 0x8b, 0x55, 0x43,
+	0x1d, 0xef, 0x9d, 0xcf, 0x7d, 0xe7, 0x62, 0xee, 0x3b, 0x3f, 0xe7, 0xbe, 0xf3, 0x65, 0xe1, 0xb7,
+	0x2e, 0x16, 0x7e, 0xeb, 0xfb, 0xc2, 0x6f, 0xbd, 0x7f, 0xde, 0x2c, 0xc8, 0xc2, 0x54, 0xc1, 0xae,
+	0xce, 0x7d, 0xc8, 0xf3, 0x68, 0x66, 0x97, 0x38, 0xd9, 0xaa, 0x76, 0xf7, 0xe5, 0xef, 0x01, 0x00,
+	0xac, 0x2e, 0xce, 0x7d, 0x45, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -109,6 +166,84 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MsgGasPriceMultipliers) > 0 {
+		for iNdEx := len(m.MsgGasPriceMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgGasPriceMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.FeeDenomRates) > 0 {
+		for iNdEx := len(m.FeeDenomRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenomRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.NetworkBaseGasPrice.Size()
+		i -= size
+		if _, err := m.NetworkBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TargetBlobSquareUtilization.Size()
+		i -= size
+		if _, err := m.TargetBlobSquareUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BaseGasPriceChangeRate.Size()
+		i -= size
+		if _, err := m.BaseGasPriceChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxNetworkBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MaxNetworkBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DynamicBaseGasPriceEnabled {
+		i--
+		if m.DynamicBaseGasPriceEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.NetworkMinGasPrice.Size()
 		i -= size
@@ -141,6 +276,29 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.NetworkMinGasPrice.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.DynamicBaseGasPriceEnabled {
+		n += 2
+	}
+	l = m.MaxNetworkBaseGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseGasPriceChangeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TargetBlobSquareUtilization.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.NetworkBaseGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeeDenomRates) > 0 {
+		for _, e := range m.FeeDenomRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MsgGasPriceMultipliers) > 0 {
+		for _, e := range m.MsgGasPriceMultipliers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -213,6 +371,230 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicBaseGasPriceEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicBaseGasPriceEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNetworkBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxNetworkBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPriceChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPriceChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlobSquareUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBlobSquareUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetworkBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenomRates = append(m.FeeDenomRates, FeeDenomRate{})
+			if err := m.FeeDenomRates[len(m.FeeDenomRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgGasPriceMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgGasPriceMultipliers = append(m.MsgGasPriceMultipliers, MsgGasPriceMultiplier{})
+			if err := m.MsgGasPriceMultipliers[len(m.MsgGasPriceMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,131 @@
+package minfee_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
+	"github.com/celestiaorg/celestia-app/v2/x/minfee"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestGenesis(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(false, tmproto.Header{Height: 1})
+	k := testApp.MinFeeKeeper
+
+	t.Run("exports the default genesis", func(t *testing.T) {
+		assert.Equal(t, minfee.DefaultGenesis(), minfee.ExportGenesis(ctx, k))
+	})
+
+	t.Run("round trips the dynamic base gas price", func(t *testing.T) {
+		genesis := minfee.GenesisState{
+			NetworkMinGasPrice:          sdk.NewDecWithPrec(1, 3),
+			DynamicBaseGasPriceEnabled:  true,
+			MaxNetworkBaseGasPrice:      sdk.NewDec(1),
+			BaseGasPriceChangeRate:      sdk.NewDecWithPrec(1, 1),
+			TargetBlobSquareUtilization: sdk.NewDecWithPrec(3, 1),
+			NetworkBaseGasPrice:         sdk.NewDecWithPrec(2, 2),
+			FeeDenomRates:               []minfee.FeeDenomRate{{Denom: testFeeDenom, Rate: sdk.NewDecWithPrec(5, 1)}},
+			MsgGasPriceMultipliers:      []minfee.MsgGasPriceMultiplier{{MsgTypeUrl: "/celestia.blob.v1.MsgPayForBlobs", Multiplier: sdk.NewDec(2)}},
+		}
+		require.NoError(t, minfee.ValidateGenesis(&genesis))
+
+		ctx, _ := ctx.CacheContext()
+		minfee.InitGenesis(ctx, k, genesis)
+		assert.Equal(t, &genesis, minfee.ExportGenesis(ctx, k))
+	})
+
+	t.Run("defaults the params missing from the genesis", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		minfee.InitGenesis(ctx, k, minfee.GenesisState{NetworkMinGasPrice: sdk.NewDecWithPrec(1, 3)})
+
+		want := minfee.DefaultGenesis()
+		want.NetworkMinGasPrice = sdk.NewDecWithPrec(1, 3)
+		assert.Equal(t, want, minfee.ExportGenesis(ctx, k))
+	})
+}
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := []struct {
+		name    string
+		modify  func(*minfee.GenesisState)
+		wantErr bool
+	}{
+		{
+			name:   "default genesis",
+			modify: func(*minfee.GenesisState) {},
+		},
+		{
+			name: "only the network min gas price",
+			modify: func(gs *minfee.GenesisState) {
+				*gs = minfee.GenesisState{NetworkMinGasPrice: gs.NetworkMinGasPrice}
+			},
+		},
+		{
+			name:    "zero network min gas price",
+			modify:  func(gs *minfee.GenesisState) { gs.NetworkMinGasPrice = sdk.ZeroDec() },
+			wantErr: true,
+		},
+		{
+			name:    "max network base gas price below the network min gas price",
+			modify:  func(gs *minfee.GenesisState) { gs.MaxNetworkBaseGasPrice = gs.NetworkMinGasPrice.QuoInt64(2) },
+			wantErr: true,
+		},
+		{
+			name:    "zero base gas price change rate",
+			modify:  func(gs *minfee.GenesisState) { gs.BaseGasPriceChangeRate = sdk.ZeroDec() },
+			wantErr: true,
+		},
+		{
+			name:    "base gas price change rate above one",
+			modify:  func(gs *minfee.GenesisState) { gs.BaseGasPriceChangeRate = sdk.NewDecWithPrec(11, 1) },
+			wantErr: true,
+		},
+		{
+			name:    "target blob square utilization of one",
+			modify:  func(gs *minfee.GenesisState) { gs.TargetBlobSquareUtilization = sdk.OneDec() },
+			wantErr: true,
+		},
+		{
+			name: "fee denom rates",
+			modify: func(gs *minfee.GenesisState) {
+				gs.FeeDenomRates = []minfee.FeeDenomRate{{Denom: testFeeDenom, Rate: sdk.NewDecWithPrec(5, 1)}}
+			},
+		},
+		{
+			name: "native fee denom",
+			modify: func(gs *minfee.GenesisState) {
+				gs.FeeDenomRates = []minfee.FeeDenomRate{{Denom: "stake", Rate: sdk.OneDec()}}
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero fee denom rate",
+			modify: func(gs *minfee.GenesisState) {
+				gs.FeeDenomRates = []minfee.FeeDenomRate{{Denom: testFeeDenom, Rate: sdk.ZeroDec()}}
+			},
+			wantErr: true,
+		},
+		{
+			name:    "negative network base gas price",
+			modify:  func(gs *minfee.GenesisState) { gs.NetworkBaseGasPrice = sdk.NewDec(-1) },
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genesis := minfee.DefaultGenesis()
+			tc.modify(genesis)
+			err := minfee.ValidateGenesis(genesis)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
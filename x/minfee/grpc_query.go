@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -21,14 +22,47 @@ func NewQueryServerImpl(paramsKeeper keeper.Keeper) *QueryServerImpl {
 	return &QueryServerImpl{paramsKeeper: paramsKeeper}
 }
 
+// Params returns the params of the minfee module.
+func (q *QueryServerImpl) Params(ctx context.Context, _ *QueryParams) (*QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	subspace, err := q.subspace(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryParamsResponse{Params: GetParams(sdkCtx, subspace)}, nil
+}
+
 // NetworkMinGasPrice returns the network minimum gas price.
 func (q *QueryServerImpl) NetworkMinGasPrice(ctx context.Context, _ *QueryNetworkMinGasPrice) (*QueryNetworkMinGasPriceResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	var params Params
-	subspace, found := q.paramsKeeper.GetSubspace(ModuleName)
-	if !found {
-		return nil, status.Errorf(codes.NotFound, "subspace not found for minfee. Minfee is only active in app version 2 and onwards")
+	subspace, err := q.subspace(sdkCtx)
+	if err != nil {
+		return nil, err
 	}
-	subspace.GetParamSet(sdkCtx, &params)
+	params := GetParams(sdkCtx, subspace)
 	return &QueryNetworkMinGasPriceResponse{NetworkMinGasPrice: params.NetworkMinGasPrice}, nil
 }
+
+// NetworkBaseGasPrice returns the minimum gas price enforced by the network.
+func (q *QueryServerImpl) NetworkBaseGasPrice(ctx context.Context, _ *QueryNetworkBaseGasPrice) (*QueryNetworkBaseGasPriceResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	subspace, err := q.subspace(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	price, enabled := GetNetworkBaseGasPrice(sdkCtx, subspace)
+	if !enabled {
+		price = GetParams(sdkCtx, subspace).NetworkMinGasPrice
+	}
+	return &QueryNetworkBaseGasPriceResponse{NetworkBaseGasPrice: price, DynamicBaseGasPriceEnabled: enabled}, nil
+}
+
+// subspace returns the minfee subspace. It returns a NotFound error if the
+// minfee params aren't set, i.e. before app version 2.
+func (q *QueryServerImpl) subspace(ctx sdk.Context) (paramtypes.Subspace, error) {
+	subspace, found := q.paramsKeeper.GetSubspace(ModuleName)
+	if !found || !subspace.Has(ctx, KeyNetworkMinGasPrice) {
+		return paramtypes.Subspace{}, status.Errorf(codes.NotFound, "subspace not found for minfee. Minfee is only active in app version 2 and onwards")
+	}
+	return subspace, nil
+}
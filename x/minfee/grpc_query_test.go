@@ -26,3 +26,25 @@ func TestQueryNetworkMinGasPrice(t *testing.T) {
 	// Check the response
 	require.Equal(t, v2.NetworkMinGasPrice, resp.NetworkMinGasPrice.MustFloat64())
 }
+
+func TestQueryNetworkBaseGasPrice(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	queryServer := minfee.NewQueryServerImpl(testApp.ParamsKeeper)
+	sdkCtx := testApp.NewContext(false, tmproto.Header{Height: 1})
+
+	resp, err := queryServer.NetworkBaseGasPrice(sdk.WrapSDKContext(sdkCtx), &minfee.QueryNetworkBaseGasPrice{})
+	require.NoError(t, err)
+	require.False(t, resp.DynamicBaseGasPriceEnabled)
+	require.Equal(t, minfee.DefaultNetworkMinGasPrice, resp.NetworkBaseGasPrice)
+
+	params := testApp.MinFeeKeeper.GetParams(sdkCtx)
+	params.DynamicBaseGasPriceEnabled = true
+	testApp.MinFeeKeeper.SetParams(sdkCtx, params)
+	basePrice := minfee.DefaultNetworkMinGasPrice.MulInt64(3)
+	testApp.MinFeeKeeper.SetNetworkBaseGasPrice(sdkCtx, basePrice)
+
+	resp, err = queryServer.NetworkBaseGasPrice(sdk.WrapSDKContext(sdkCtx), &minfee.QueryNetworkBaseGasPrice{})
+	require.NoError(t, err)
+	require.True(t, resp.DynamicBaseGasPriceEnabled)
+	require.Equal(t, basePrice, resp.NetworkBaseGasPrice)
+}
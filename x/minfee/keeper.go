@@ -0,0 +1,148 @@
+package minfee
+
+import (
+	"encoding/binary"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// TStoreKey is the key of the transient store used to count the blob shares
+// of the current block.
+const TStoreKey = "transient_" + ModuleName
+
+// blobSharesKey is the key under which the number of shares occupied by the
+// blobs of the current block is stored in the transient store.
+var blobSharesKey = []byte{0x01}
+
+// BlobKeeper is used to get the governance max square size, which bounds the
+// number of shares available to blobs.
+type BlobKeeper interface {
+	GovMaxSquareSize(ctx sdk.Context) uint64
+}
+
+// Keeper adjusts the network base gas price based on the blob utilization of
+// the data square. The params and the network base gas price are stored in the
+// minfee params subspace.
+type Keeper struct {
+	paramsKeeper params.Keeper
+	// tStoreKey is the key of the transient store that counts the blob shares
+	// of the current block.
+	tStoreKey  storetypes.StoreKey
+	blobKeeper BlobKeeper
+}
+
+// NewKeeper returns a minfee keeper.
+func NewKeeper(paramsKeeper params.Keeper, tStoreKey storetypes.StoreKey, blobKeeper BlobKeeper) Keeper {
+	return Keeper{
+		paramsKeeper: paramsKeeper,
+		tStoreKey:    tStoreKey,
+		blobKeeper:   blobKeeper,
+	}
+}
+
+// Subspace returns the minfee params subspace with its key table registered.
+func (k Keeper) Subspace() paramtypes.Subspace {
+	subspace, exists := k.paramsKeeper.GetSubspace(ModuleName)
+	if !exists {
+		panic("minfee subspace not set")
+	}
+	return RegisterMinFeeParamTable(subspace)
+}
+
+// GetParams returns the minfee params.
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	return GetParams(ctx, k.Subspace())
+}
+
+// SetParams sets the minfee params.
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.Subspace().SetParamSet(ctx, &params)
+}
+
+// GetNetworkBaseGasPrice returns the network base gas price and whether it is
+// enforced instead of the network min gas price.
+func (k Keeper) GetNetworkBaseGasPrice(ctx sdk.Context) (sdk.Dec, bool) {
+	return GetNetworkBaseGasPrice(ctx, k.Subspace())
+}
+
+// SetNetworkBaseGasPrice sets the network base gas price.
+func (k Keeper) SetNetworkBaseGasPrice(ctx sdk.Context, price sdk.Dec) {
+	k.Subspace().Set(ctx, KeyNetworkBaseGasPrice, price)
+}
+
+// AddBlobShares adds shares to the number of shares occupied by the blobs of
+// the current block.
+func (k Keeper) AddBlobShares(ctx sdk.Context, shares uint64) {
+	k.transientStore(ctx).Set(blobSharesKey, sdk.Uint64ToBigEndian(k.GetBlobShares(ctx)+shares))
+}
+
+// GetBlobShares returns the number of shares occupied by the blobs of the
+// current block.
+func (k Keeper) GetBlobShares(ctx sdk.Context) uint64 {
+	bz := k.transientStore(ctx).Get(blobSharesKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// transientStore returns the transient store without gas metering so that
+// counting the blob shares doesn't change the gas used by PFB txs.
+func (k Keeper) transientStore(ctx sdk.Context) sdk.KVStore {
+	return ctx.MultiStore().GetKVStore(k.tStoreKey)
+}
+
+// GetBlobSquareUtilization returns the fraction of the max data square
+// occupied by the blobs of the current block.
+func (k Keeper) GetBlobSquareUtilization(ctx sdk.Context) sdk.Dec {
+	squareSize := min(k.blobKeeper.GovMaxSquareSize(ctx), uint64(appconsts.SquareSizeUpperBound(ctx.BlockHeader().Version.App)))
+	maxShares := squareSize * squareSize
+	if maxShares == 0 {
+		return sdk.ZeroDec()
+	}
+	utilization := sdk.NewDecFromInt(sdk.NewIntFromUint64(k.GetBlobShares(ctx))).QuoInt(sdk.NewIntFromUint64(maxShares))
+	return sdk.MinDec(utilization, sdk.OneDec())
+}
+
+// UpdateNetworkBaseGasPrice adjusts the network base gas price based on the
+// blob utilization of the current block. It is a no-op if the dynamic base gas
+// price is disabled.
+func (k Keeper) UpdateNetworkBaseGasPrice(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.DynamicBaseGasPriceEnabled {
+		return
+	}
+
+	price, _ := k.GetNetworkBaseGasPrice(ctx)
+	utilization := k.GetBlobSquareUtilization(ctx)
+	price = NextNetworkBaseGasPrice(price, utilization, params)
+	k.SetNetworkBaseGasPrice(ctx, price)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeUpdateNetworkBaseGasPrice,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyNetworkBaseGasPrice, price.String()),
+		sdk.NewAttribute(AttributeKeyBlobSquareUtilization, utilization.String()),
+	))
+}
+
+// NextNetworkBaseGasPrice returns the network base gas price that follows
+// price given the blob utilization of the data square. The price goes up if
+// the utilization is above the target and down if it is below, by at most the
+// base gas price change rate when the square is full or empty. The result is
+// bounded by the network min gas price and the max network base gas price.
+func NextNetworkBaseGasPrice(price, utilization sdk.Dec, params Params) sdk.Dec {
+	target := params.TargetBlobSquareUtilization
+	var deviation sdk.Dec
+	if utilization.GT(target) {
+		deviation = utilization.Sub(target).Quo(sdk.OneDec().Sub(target))
+	} else {
+		deviation = utilization.Sub(target).Quo(target)
+	}
+	change := sdk.OneDec().Add(deviation.Mul(params.BaseGasPriceChangeRate))
+	return clampBaseGasPrice(price.Mul(change), params)
+}
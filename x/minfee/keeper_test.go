@@ -0,0 +1,137 @@
+package minfee_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
+	"github.com/celestiaorg/celestia-app/v2/x/minfee"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestNextNetworkBaseGasPrice(t *testing.T) {
+	params := minfee.DefaultParams()
+	params.NetworkMinGasPrice = sdk.NewDecWithPrec(1, 2)
+	params.MaxNetworkBaseGasPrice = sdk.NewDec(1)
+
+	price := sdk.NewDecWithPrec(1, 1)
+	testCases := []struct {
+		name        string
+		price       sdk.Dec
+		utilization sdk.Dec
+		want        sdk.Dec
+	}{
+		{
+			name:        "unchanged at the target utilization",
+			price:       price,
+			utilization: params.TargetBlobSquareUtilization,
+			want:        price,
+		},
+		{
+			name:        "increases by the change rate for a full square",
+			price:       price,
+			utilization: sdk.OneDec(),
+			want:        sdk.MustNewDecFromStr("0.1125"),
+		},
+		{
+			name:        "decreases by the change rate for an empty square",
+			price:       price,
+			utilization: sdk.ZeroDec(),
+			want:        sdk.MustNewDecFromStr("0.0875"),
+		},
+		{
+			name:        "increases by a fraction of the change rate above the target",
+			price:       price,
+			utilization: sdk.MustNewDecFromStr("0.75"),
+			want:        sdk.MustNewDecFromStr("0.10625"),
+		},
+		{
+			name:        "decreases by a fraction of the change rate below the target",
+			price:       price,
+			utilization: sdk.MustNewDecFromStr("0.25"),
+			want:        sdk.MustNewDecFromStr("0.09375"),
+		},
+		{
+			name:        "bounded by the network min gas price",
+			price:       sdk.MustNewDecFromStr("0.0101"),
+			utilization: sdk.ZeroDec(),
+			want:        params.NetworkMinGasPrice,
+		},
+		{
+			name:        "bounded by the max network base gas price",
+			price:       sdk.MustNewDecFromStr("0.99"),
+			utilization: sdk.OneDec(),
+			want:        params.MaxNetworkBaseGasPrice,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := minfee.NextNetworkBaseGasPrice(tc.price, tc.utilization, params)
+			assert.Equal(t, tc.want.String(), got.String())
+		})
+	}
+}
+
+func TestUpdateNetworkBaseGasPrice(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(false, tmproto.Header{Height: 2, Version: version.Consensus{App: 2}})
+	k := testApp.MinFeeKeeper
+	maxShares := uint64(appconsts.DefaultGovMaxSquareSize * appconsts.DefaultGovMaxSquareSize)
+
+	t.Run("does nothing if the dynamic base gas price is disabled", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		k.AddBlobShares(ctx, maxShares)
+		minfee.EndBlocker(ctx, k)
+
+		price, enabled := k.GetNetworkBaseGasPrice(ctx)
+		assert.False(t, enabled)
+		assert.Equal(t, minfee.DefaultNetworkMinGasPrice, price)
+		assert.False(t, k.Subspace().Has(ctx, minfee.KeyNetworkBaseGasPrice))
+		assert.Empty(t, ctx.EventManager().Events())
+	})
+
+	params := k.GetParams(ctx)
+	params.DynamicBaseGasPriceEnabled = true
+	k.SetParams(ctx, params)
+
+	t.Run("increases when the square is full", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		k.AddBlobShares(ctx, maxShares/2)
+		k.AddBlobShares(ctx, maxShares/2)
+		require.Equal(t, maxShares, k.GetBlobShares(ctx))
+		require.Equal(t, sdk.OneDec(), k.GetBlobSquareUtilization(ctx))
+		minfee.EndBlocker(ctx, k)
+
+		price, enabled := k.GetNetworkBaseGasPrice(ctx)
+		assert.True(t, enabled)
+		want := minfee.DefaultNetworkMinGasPrice.Mul(sdk.OneDec().Add(params.BaseGasPriceChangeRate))
+		assert.Equal(t, want, price)
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		assert.Equal(t, minfee.EventTypeUpdateNetworkBaseGasPrice, events[0].Type)
+	})
+
+	t.Run("stays at the network min gas price when the square is empty", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		minfee.EndBlocker(ctx, k)
+
+		price, _ := k.GetNetworkBaseGasPrice(ctx)
+		assert.Equal(t, minfee.DefaultNetworkMinGasPrice, price)
+	})
+
+	t.Run("decreases when the square is below the target", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		start := minfee.DefaultNetworkMinGasPrice.MulInt64(2)
+		k.SetNetworkBaseGasPrice(ctx, start)
+		minfee.EndBlocker(ctx, k)
+
+		price, _ := k.GetNetworkBaseGasPrice(ctx)
+		assert.Equal(t, start.Mul(sdk.OneDec().Sub(params.BaseGasPriceChangeRate)), price)
+	})
+}
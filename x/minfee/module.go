@@ -1,6 +1,7 @@
 package minfee
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -15,7 +16,6 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
-	params "github.com/cosmos/cosmos-sdk/x/params/keeper"
 )
 
 var (
@@ -55,7 +55,11 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingCo
 func (AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {}
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {}
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
 
 // GetTxCmd returns the minfee module's root tx command.
 func (a AppModuleBasic) GetTxCmd() *cobra.Command {
@@ -63,30 +67,25 @@ func (a AppModuleBasic) GetTxCmd() *cobra.Command {
 	return &cobra.Command{}
 }
 
-// GetQueryCmd returns the a dummy command.
+// GetQueryCmd returns the CLI query commands for the minfee module.
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
-	// Return a dummy command
-	return &cobra.Command{}
+	return GetQueryCmd()
 }
 
 // AppModule implements an application module for the minfee module.
 type AppModule struct {
 	AppModuleBasic
-	paramsKeeper params.Keeper
+	keeper Keeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(k params.Keeper) AppModule {
+func NewAppModule(k Keeper) AppModule {
 	// Register the parameter key table in its associated subspace.
-	subspace, exists := k.GetSubspace(ModuleName)
-	if !exists {
-		panic("minfee subspace not set")
-	}
-	RegisterMinFeeParamTable(subspace)
+	k.Subspace()
 
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
-		paramsKeeper:   k,
+		keeper:         k,
 	}
 }
 
@@ -110,7 +109,7 @@ func (am AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg sdkmodule.Configurator) {
-	RegisterQueryServer(cfg.QueryServer(), NewQueryServerImpl(am.paramsKeeper))
+	RegisterQueryServer(cfg.QueryServer(), NewQueryServerImpl(am.keeper.paramsKeeper))
 }
 
 // InitGenesis performs genesis initialization for the minfee module. It returns no validator updates.
@@ -118,35 +117,24 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.Ra
 	var genesisState GenesisState
 	cdc.MustUnmarshalJSON(gs, &genesisState)
 
-	subspace, exists := am.paramsKeeper.GetSubspace(ModuleName)
-	if !exists {
-		panic("minfee subspace not set")
-	}
-
-	subspace = RegisterMinFeeParamTable(subspace)
-
-	// Set the network min gas price initial value
-	networkMinGasPriceDec, err := sdk.NewDecFromStr(fmt.Sprintf("%f", genesisState.NetworkMinGasPrice))
-	if err != nil {
-		panic("failed to convert NetworkMinGasPrice to sdk.Dec")
-	}
-
-	subspace.SetParamSet(ctx, &Params{NetworkMinGasPrice: networkMinGasPriceDec})
+	InitGenesis(ctx, am.keeper, genesisState)
 
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the minfee module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	gs := ExportGenesis(ctx, am.paramsKeeper)
+	gs := ExportGenesis(ctx, am.keeper)
 	return cdc.MustMarshalJSON(gs)
 }
 
 // BeginBlock returns the begin blocker for the minfee module.
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock returns the end blocker for the minfee module. It returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock returns the end blocker for the minfee module. It adjusts the
+// network base gas price if it is enabled and returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
 	return []abci.ValidatorUpdate{}
 }
 
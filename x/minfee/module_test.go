@@ -33,7 +33,7 @@ func TestNewModuleInitializesKeyTable(t *testing.T) {
 	subspace := paramsKeeper.Subspace(minfee.ModuleName)
 
 	// Initialize the minfee module which registers the key table
-	minfee.NewAppModule(paramsKeeper)
+	minfee.NewAppModule(minfee.NewKeeper(paramsKeeper, nil, nil))
 
 	// Require key table to be initialized
 	hasKeyTable := subspace.HasKeyTable()
@@ -0,0 +1,46 @@
+package minfee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// TxGasPriceMultiplier returns the multiplier of the network minimum gas price
+// for a tx with msgs. It is the highest multiplier of the message types of the
+// tx, including the messages nested in authz MsgExec, so that a discounted
+// message can't lower the gas price of the other messages of the tx. Message
+// types without a multiplier have a multiplier of one.
+func TxGasPriceMultiplier(msgs []sdk.Msg, multipliers []MsgGasPriceMultiplier) sdk.Dec {
+	if len(multipliers) == 0 || len(msgs) == 0 {
+		return sdk.OneDec()
+	}
+	byTypeURL := make(map[string]sdk.Dec, len(multipliers))
+	for _, m := range multipliers {
+		byTypeURL[m.MsgTypeUrl] = m.Multiplier
+	}
+	var multiplier sdk.Dec
+	visitMsgs(msgs, func(msg sdk.Msg) {
+		m, ok := byTypeURL[sdk.MsgTypeURL(msg)]
+		if !ok {
+			m = sdk.OneDec()
+		}
+		if multiplier.IsNil() || m.GT(multiplier) {
+			multiplier = m
+		}
+	})
+	return multiplier
+}
+
+// visitMsgs calls visit for msgs and the messages nested in the authz MsgExec
+// of msgs.
+func visitMsgs(msgs []sdk.Msg, visit func(sdk.Msg)) {
+	for _, msg := range msgs {
+		visit(msg)
+		if execMsg, ok := msg.(*authz.MsgExec); ok {
+			// invalid nested messages are rejected by the msg gatekeeper
+			if nestedMsgs, err := execMsg.GetMessages(); err == nil {
+				visitMsgs(nestedMsgs, visit)
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package minfee_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v2/x/minfee"
+	signaltypes "github.com/celestiaorg/celestia-app/v2/x/signal/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMsgGasPriceMultipliers(t *testing.T) {
+	pfbTypeURL := sdk.MsgTypeURL(&blobtypes.MsgPayForBlobs{})
+	testCases := []struct {
+		name        string
+		multipliers []minfee.MsgGasPriceMultiplier
+		wantErr     bool
+	}{
+		{
+			name:        "no multipliers",
+			multipliers: []minfee.MsgGasPriceMultiplier{},
+		},
+		{
+			name: "valid multipliers",
+			multipliers: []minfee.MsgGasPriceMultiplier{
+				{MsgTypeUrl: pfbTypeURL, Multiplier: sdk.NewDec(2)},
+				{MsgTypeUrl: sdk.MsgTypeURL(&signaltypes.MsgSignalVersion{}), Multiplier: sdk.NewDecWithPrec(1, 1)},
+			},
+		},
+		{
+			name:        "missing leading slash",
+			multipliers: []minfee.MsgGasPriceMultiplier{{MsgTypeUrl: pfbTypeURL[1:], Multiplier: sdk.NewDec(2)}},
+			wantErr:     true,
+		},
+		{
+			name: "duplicate msg type URL",
+			multipliers: []minfee.MsgGasPriceMultiplier{
+				{MsgTypeUrl: pfbTypeURL, Multiplier: sdk.NewDec(2)},
+				{MsgTypeUrl: pfbTypeURL, Multiplier: sdk.NewDec(3)},
+			},
+			wantErr: true,
+		},
+		{
+			name:        "zero multiplier",
+			multipliers: []minfee.MsgGasPriceMultiplier{{MsgTypeUrl: pfbTypeURL, Multiplier: sdk.ZeroDec()}},
+			wantErr:     true,
+		},
+		{
+			name:        "multiplier above the max",
+			multipliers: []minfee.MsgGasPriceMultiplier{{MsgTypeUrl: pfbTypeURL, Multiplier: minfee.MaxMsgGasPriceMultiplier.Add(sdk.OneDec())}},
+			wantErr:     true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := minfee.DefaultParams()
+			params.MsgGasPriceMultipliers = tc.multipliers
+			err := params.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTxGasPriceMultiplier(t *testing.T) {
+	addr := sdk.AccAddress("addr")
+	pfb := &blobtypes.MsgPayForBlobs{}
+	signal := &signaltypes.MsgSignalVersion{}
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1)))
+	exec := authz.NewMsgExec(addr, []sdk.Msg{pfb})
+	multipliers := []minfee.MsgGasPriceMultiplier{
+		{MsgTypeUrl: sdk.MsgTypeURL(pfb), Multiplier: sdk.NewDec(2)},
+		{MsgTypeUrl: sdk.MsgTypeURL(signal), Multiplier: sdk.NewDecWithPrec(1, 1)},
+	}
+
+	testCases := []struct {
+		name        string
+		msgs        []sdk.Msg
+		multipliers []minfee.MsgGasPriceMultiplier
+		want        sdk.Dec
+	}{
+		{
+			name: "no multipliers",
+			msgs: []sdk.Msg{pfb},
+			want: sdk.OneDec(),
+		},
+		{
+			name:        "msg type without a multiplier",
+			msgs:        []sdk.Msg{send},
+			multipliers: multipliers,
+			want:        sdk.OneDec(),
+		},
+		{
+			name:        "surcharge",
+			msgs:        []sdk.Msg{pfb},
+			multipliers: multipliers,
+			want:        sdk.NewDec(2),
+		},
+		{
+			name:        "discount",
+			msgs:        []sdk.Msg{signal},
+			multipliers: multipliers,
+			want:        sdk.NewDecWithPrec(1, 1),
+		},
+		{
+			name:        "discount doesn't apply to the other msgs of the tx",
+			msgs:        []sdk.Msg{signal, send},
+			multipliers: multipliers,
+			want:        sdk.OneDec(),
+		},
+		{
+			name:        "highest multiplier of the msgs of the tx",
+			msgs:        []sdk.Msg{signal, pfb},
+			multipliers: multipliers,
+			want:        sdk.NewDec(2),
+		},
+		{
+			name:        "msg nested in authz exec",
+			msgs:        []sdk.Msg{&exec},
+			multipliers: multipliers,
+			want:        sdk.NewDec(2),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := minfee.TxGasPriceMultiplier(tc.msgs, tc.multipliers)
+			require.True(t, tc.want.Equal(got), "want %v got %v", tc.want, got)
+		})
+	}
+}
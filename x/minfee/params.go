@@ -2,10 +2,12 @@ package minfee
 
 import (
 	"fmt"
+	"strings"
 
 	v2 "github.com/celestiaorg/celestia-app/v2/pkg/appconsts/v2"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 )
 
 const ModuleName = "minfee"
@@ -15,6 +17,45 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 var (
 	KeyNetworkMinGasPrice     = []byte("NetworkMinGasPrice")
 	DefaultNetworkMinGasPrice sdk.Dec
+
+	// KeyDynamicBaseGasPriceEnabled is the key of the param that toggles the
+	// dynamic network base gas price.
+	KeyDynamicBaseGasPriceEnabled     = []byte("DynamicBaseGasPriceEnabled")
+	DefaultDynamicBaseGasPriceEnabled = false
+
+	// KeyMaxNetworkBaseGasPrice is the key of the param that caps the dynamic
+	// network base gas price. NetworkMinGasPrice is its floor.
+	KeyMaxNetworkBaseGasPrice     = []byte("MaxNetworkBaseGasPrice")
+	DefaultMaxNetworkBaseGasPrice = sdk.NewDecWithPrec(1, 1) // 0.1 utia
+
+	// KeyBaseGasPriceChangeRate is the key of the param that sets the max
+	// fraction by which the network base gas price can change in one block.
+	KeyBaseGasPriceChangeRate     = []byte("BaseGasPriceChangeRate")
+	DefaultBaseGasPriceChangeRate = sdk.NewDecWithPrec(125, 3) // 12.5%
+
+	// KeyTargetBlobSquareUtilization is the key of the param that sets the
+	// fraction of the data square occupied by blobs at which the network base
+	// gas price stays the same.
+	KeyTargetBlobSquareUtilization     = []byte("TargetBlobSquareUtilization")
+	DefaultTargetBlobSquareUtilization = sdk.NewDecWithPrec(5, 1) // 50%
+
+	// KeyFeeDenomRates is the key of the param that whitelists the IBC denoms
+	// that are accepted as tx fees and sets their conversion rate to utia.
+	KeyFeeDenomRates     = []byte("FeeDenomRates")
+	DefaultFeeDenomRates []FeeDenomRate
+
+	// KeyMsgGasPriceMultipliers is the key of the param that sets the
+	// multipliers of the network minimum gas price per message type.
+	KeyMsgGasPriceMultipliers     = []byte("MsgGasPriceMultipliers")
+	DefaultMsgGasPriceMultipliers []MsgGasPriceMultiplier
+	// MaxMsgGasPriceMultiplier is the maximum multiplier of the network
+	// minimum gas price of a message type.
+	MaxMsgGasPriceMultiplier = sdk.NewDec(100)
+
+	// KeyNetworkBaseGasPrice is the key under which the current network base
+	// gas price is stored in the minfee subspace. It isn't part of Params
+	// because it is updated by the EndBlocker rather than by governance.
+	KeyNetworkBaseGasPrice = []byte("NetworkBaseGasPrice")
 )
 
 func init() {
@@ -25,8 +66,17 @@ func init() {
 	DefaultNetworkMinGasPrice = DefaultNetworkMinGasPriceDec
 }
 
-type Params struct {
-	NetworkMinGasPrice sdk.Dec
+// DefaultParams returns the default params of the minfee module.
+func DefaultParams() Params {
+	return Params{
+		NetworkMinGasPrice:          DefaultNetworkMinGasPrice,
+		DynamicBaseGasPriceEnabled:  DefaultDynamicBaseGasPriceEnabled,
+		MaxNetworkBaseGasPrice:      DefaultMaxNetworkBaseGasPrice,
+		BaseGasPriceChangeRate:      DefaultBaseGasPriceChangeRate,
+		TargetBlobSquareUtilization: DefaultTargetBlobSquareUtilization,
+		FeeDenomRates:               DefaultFeeDenomRates,
+		MsgGasPriceMultipliers:      DefaultMsgGasPriceMultipliers,
+	}
 }
 
 // RegisterMinFeeParamTable returns a subspace with a key table attached.
@@ -39,14 +89,111 @@ func RegisterMinFeeParamTable(subspace paramtypes.Subspace) paramtypes.Subspace
 
 // ParamKeyTable returns the param key table for the minfee module.
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+	return paramtypes.NewKeyTable().
+		RegisterParamSet(&Params{}).
+		RegisterType(paramtypes.NewParamSetPair(KeyNetworkBaseGasPrice, sdk.Dec{}, ValidateMinGasPrice))
 }
 
 // ParamSetPairs gets the param key-value pair
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyNetworkMinGasPrice, &p.NetworkMinGasPrice, ValidateMinGasPrice),
+		paramtypes.NewParamSetPair(KeyDynamicBaseGasPriceEnabled, &p.DynamicBaseGasPriceEnabled, validateDynamicBaseGasPriceEnabled),
+		paramtypes.NewParamSetPair(KeyMaxNetworkBaseGasPrice, &p.MaxNetworkBaseGasPrice, validateMaxNetworkBaseGasPrice),
+		paramtypes.NewParamSetPair(KeyBaseGasPriceChangeRate, &p.BaseGasPriceChangeRate, validateBaseGasPriceChangeRate),
+		paramtypes.NewParamSetPair(KeyTargetBlobSquareUtilization, &p.TargetBlobSquareUtilization, validateTargetBlobSquareUtilization),
+		paramtypes.NewParamSetPair(KeyFeeDenomRates, &p.FeeDenomRates, validateFeeDenomRates),
+		paramtypes.NewParamSetPair(KeyMsgGasPriceMultipliers, &p.MsgGasPriceMultipliers, validateMsgGasPriceMultipliers),
+	}
+}
+
+// Validate returns an error if any of the params is invalid or if the max
+// network base gas price is lower than the network min gas price.
+func (p Params) Validate() error {
+	if p.NetworkMinGasPrice.IsNil() || !p.NetworkMinGasPrice.IsPositive() {
+		return fmt.Errorf("network min gas price must be positive: %v", p.NetworkMinGasPrice)
+	}
+	if err := validateMaxNetworkBaseGasPrice(p.MaxNetworkBaseGasPrice); err != nil {
+		return err
+	}
+	if err := validateBaseGasPriceChangeRate(p.BaseGasPriceChangeRate); err != nil {
+		return err
+	}
+	if err := validateTargetBlobSquareUtilization(p.TargetBlobSquareUtilization); err != nil {
+		return err
+	}
+	if err := validateFeeDenomRates(p.FeeDenomRates); err != nil {
+		return err
+	}
+	if err := validateMsgGasPriceMultipliers(p.MsgGasPriceMultipliers); err != nil {
+		return err
+	}
+	if p.MaxNetworkBaseGasPrice.LT(p.NetworkMinGasPrice) {
+		return fmt.Errorf("max network base gas price %v is lower than the network min gas price %v", p.MaxNetworkBaseGasPrice, p.NetworkMinGasPrice)
+	}
+	return nil
+}
+
+// withDefaults returns the params with the unset values, i.e. those of
+// params that were added after the minfee params were initialized, replaced by
+// their default.
+func (p Params) withDefaults() Params {
+	defaults := DefaultParams()
+	if p.NetworkMinGasPrice.IsNil() {
+		p.NetworkMinGasPrice = defaults.NetworkMinGasPrice
 	}
+	if p.MaxNetworkBaseGasPrice.IsNil() {
+		p.MaxNetworkBaseGasPrice = defaults.MaxNetworkBaseGasPrice
+	}
+	if p.BaseGasPriceChangeRate.IsNil() {
+		p.BaseGasPriceChangeRate = defaults.BaseGasPriceChangeRate
+	}
+	if p.TargetBlobSquareUtilization.IsNil() {
+		p.TargetBlobSquareUtilization = defaults.TargetBlobSquareUtilization
+	}
+	// fee denom rates and msg gas price multipliers that were reset are
+	// decoded as an empty slice
+	if len(p.FeeDenomRates) == 0 {
+		p.FeeDenomRates = defaults.FeeDenomRates
+	}
+	if len(p.MsgGasPriceMultipliers) == 0 {
+		p.MsgGasPriceMultipliers = defaults.MsgGasPriceMultipliers
+	}
+	return p
+}
+
+// GetParams returns the minfee params stored in the subspace. Params that
+// aren't stored are set to their default.
+func GetParams(ctx sdk.Context, subspace paramtypes.Subspace) Params {
+	var params Params
+	RegisterMinFeeParamTable(subspace).GetParamSetIfExists(ctx, &params)
+	return params.withDefaults()
+}
+
+// GetNetworkBaseGasPrice returns the network base gas price stored in the
+// subspace and whether it is enforced. It is enforced if the dynamic base gas
+// price is enabled. The returned price is never lower than the network min
+// gas price nor higher than the max network base gas price.
+func GetNetworkBaseGasPrice(ctx sdk.Context, subspace paramtypes.Subspace) (sdk.Dec, bool) {
+	subspace = RegisterMinFeeParamTable(subspace)
+	params := GetParams(ctx, subspace)
+	price := params.NetworkMinGasPrice
+	if subspace.Has(ctx, KeyNetworkBaseGasPrice) {
+		subspace.Get(ctx, KeyNetworkBaseGasPrice, &price)
+	}
+	return clampBaseGasPrice(price, params), params.DynamicBaseGasPriceEnabled
+}
+
+// clampBaseGasPrice returns price bounded by the network min gas price and
+// the max network base gas price.
+func clampBaseGasPrice(price sdk.Dec, params Params) sdk.Dec {
+	if price.LT(params.NetworkMinGasPrice) {
+		return params.NetworkMinGasPrice
+	}
+	if price.GT(params.MaxNetworkBaseGasPrice) {
+		return params.MaxNetworkBaseGasPrice
+	}
+	return price
 }
 
 // Validate validates the param type
@@ -58,3 +205,104 @@ func ValidateMinGasPrice(i interface{}) error {
 
 	return nil
 }
+
+func validateDynamicBaseGasPriceEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxNetworkBaseGasPrice(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("max network base gas price must be positive: %v", v)
+	}
+	return nil
+}
+
+func validateBaseGasPriceChangeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("base gas price change rate must be in (0, 1]: %v", v)
+	}
+	return nil
+}
+
+func validateTargetBlobSquareUtilization(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || !v.IsPositive() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("target blob square utilization must be in (0, 1): %v", v)
+	}
+	return nil
+}
+
+func validateFeeDenomRates(i interface{}) error {
+	rates, ok := i.([]FeeDenomRate)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	denoms := make(map[string]struct{}, len(rates))
+	for _, rate := range rates {
+		if err := validateFeeDenom(rate.Denom); err != nil {
+			return err
+		}
+		if _, ok := denoms[rate.Denom]; ok {
+			return fmt.Errorf("duplicate fee denom: %s", rate.Denom)
+		}
+		denoms[rate.Denom] = struct{}{}
+		if err := validateFeeDenomRate(rate.Rate); err != nil {
+			return fmt.Errorf("fee denom %s: %w", rate.Denom, err)
+		}
+	}
+	return nil
+}
+
+// validateFeeDenom returns an error if denom isn't an IBC denom of the form
+// ibc/{hash}. Native denoms other than utia can't be used to pay fees.
+func validateFeeDenom(denom string) error {
+	if !strings.HasPrefix(denom, transfertypes.DenomPrefix+"/") {
+		return fmt.Errorf("fee denom must be an IBC denom: %s", denom)
+	}
+	if err := transfertypes.ValidateIBCDenom(denom); err != nil {
+		return fmt.Errorf("invalid fee denom %s: %w", denom, err)
+	}
+	return nil
+}
+
+func validateFeeDenomRate(rate sdk.Dec) error {
+	if rate.IsNil() || !rate.IsPositive() {
+		return fmt.Errorf("fee denom rate must be positive: %v", rate)
+	}
+	return nil
+}
+
+func validateMsgGasPriceMultipliers(i interface{}) error {
+	multipliers, ok := i.([]MsgGasPriceMultiplier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	typeURLs := make(map[string]struct{}, len(multipliers))
+	for _, m := range multipliers {
+		if len(m.MsgTypeUrl) < 2 || !strings.HasPrefix(m.MsgTypeUrl, "/") {
+			return fmt.Errorf("invalid msg type URL: %q", m.MsgTypeUrl)
+		}
+		if _, ok := typeURLs[m.MsgTypeUrl]; ok {
+			return fmt.Errorf("duplicate msg type URL: %s", m.MsgTypeUrl)
+		}
+		typeURLs[m.MsgTypeUrl] = struct{}{}
+		if m.Multiplier.IsNil() || !m.Multiplier.IsPositive() || m.Multiplier.GT(MaxMsgGasPriceMultiplier) {
+			return fmt.Errorf("gas price multiplier of %s must be positive and at most %v: %v", m.MsgTypeUrl, MaxMsgGasPriceMultiplier, m.Multiplier)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,1103 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/minfee/v1/params.proto
+
+package minfee
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the minfee module.
+type Params struct {
+	// network_min_gas_price is the minimum gas price that every transaction
+	// must pay.
+	NetworkMinGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=network_min_gas_price,json=networkMinGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"network_min_gas_price"`
+	// dynamic_base_gas_price_enabled enables the network base gas price, which
+	// replaces network_min_gas_price as the network minimum gas price and is
+	// adjusted every block based on the blob utilization of the data square.
+	DynamicBaseGasPriceEnabled bool `protobuf:"varint,2,opt,name=dynamic_base_gas_price_enabled,json=dynamicBaseGasPriceEnabled,proto3" json:"dynamic_base_gas_price_enabled,omitempty"`
+	// max_network_base_gas_price is the upper bound of the network base gas
+	// price.
+	MaxNetworkBaseGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_network_base_gas_price,json=maxNetworkBaseGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_network_base_gas_price"`
+	// base_gas_price_change_rate is the max fraction by which the network base
+	// gas price can change from one block to the next.
+	BaseGasPriceChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=base_gas_price_change_rate,json=baseGasPriceChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_gas_price_change_rate"`
+	// target_blob_square_utilization is the fraction of the data square
+	// occupied by blobs that keeps the network base gas price unchanged.
+	TargetBlobSquareUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=target_blob_square_utilization,json=targetBlobSquareUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_blob_square_utilization"`
+	// fee_denom_rates are the governance whitelisted IBC denoms that are
+	// accepted as tx fees in addition to utia, with their conversion rate to
+	// utia.
+	FeeDenomRates []FeeDenomRate `protobuf:"bytes,6,rep,name=fee_denom_rates,json=feeDenomRates,proto3" json:"fee_denom_rates"`
+	// msg_gas_price_multipliers are the multipliers of the network minimum gas
+	// price for txs that contain the given message types.
+	MsgGasPriceMultipliers []MsgGasPriceMultiplier `protobuf:"bytes,7,rep,name=msg_gas_price_multipliers,json=msgGasPriceMultipliers,proto3" json:"msg_gas_price_multipliers"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_821eedeb4e2f93bf, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDynamicBaseGasPriceEnabled() bool {
+	if m != nil {
+		return m.DynamicBaseGasPriceEnabled
+	}
+	return false
+}
+
+func (m *Params) GetFeeDenomRates() []FeeDenomRate {
+	if m != nil {
+		return m.FeeDenomRates
+	}
+	return nil
+}
+
+func (m *Params) GetMsgGasPriceMultipliers() []MsgGasPriceMultiplier {
+	if m != nil {
+		return m.MsgGasPriceMultipliers
+	}
+	return nil
+}
+
+// FeeDenomRate is an IBC denom that is accepted as tx fees and its conversion
+// rate to utia.
+type FeeDenomRate struct {
+	// denom is the IBC denom, e.g. ibc/<hash>.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of utia that one unit of denom is worth.
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+}
+
+func (m *FeeDenomRate) Reset()         { *m = FeeDenomRate{} }
+func (m *FeeDenomRate) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomRate) ProtoMessage()    {}
+func (*FeeDenomRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_821eedeb4e2f93bf, []int{1}
+}
+func (m *FeeDenomRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenomRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenomRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenomRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomRate.Merge(m, src)
+}
+func (m *FeeDenomRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenomRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenomRate proto.InternalMessageInfo
+
+func (m *FeeDenomRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgGasPriceMultiplier is a message type and the multiplier of the network
+// minimum gas price for txs that contain it.
+type MsgGasPriceMultiplier struct {
+	// msg_type_url is the type URL of the message, e.g.
+	// /celestia.blob.v1.MsgPayForBlobs.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// multiplier is the multiplier of the network minimum gas price.
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+}
+
+func (m *MsgGasPriceMultiplier) Reset()         { *m = MsgGasPriceMultiplier{} }
+func (m *MsgGasPriceMultiplier) String() string { return proto.CompactTextString(m) }
+func (*MsgGasPriceMultiplier) ProtoMessage()    {}
+func (*MsgGasPriceMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_821eedeb4e2f93bf, []int{2}
+}
+func (m *MsgGasPriceMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGasPriceMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasPriceMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGasPriceMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasPriceMultiplier.Merge(m, src)
+}
+func (m *MsgGasPriceMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGasPriceMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasPriceMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGasPriceMultiplier proto.InternalMessageInfo
+
+func (m *MsgGasPriceMultiplier) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "celestia.minfee.v1.Params")
+	proto.RegisterType((*FeeDenomRate)(nil), "celestia.minfee.v1.FeeDenomRate")
+	proto.RegisterType((*MsgGasPriceMultiplier)(nil), "celestia.minfee.v1.MsgGasPriceMultiplier")
+}
+
+func init() { proto.RegisterFile("celestia/minfee/v1/params.proto", fileDescriptor_821eedeb4e2f93bf) }
+
+var fileDescriptor_821eedeb4e2f93bf = []byte{
+	// 530 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0xad, 0x2d, 0x60, 0x86, 0x90, 0xac, 0x6d, 0xca, 0x8a, 0x94, 0x56, 0x3d, 0xa0,
+	0x22, 0xd1, 0x44, 0x83, 0x2b, 0xa7, 0x50, 0xc6, 0xa9, 0x53, 0x55, 0xd8, 0x05, 0x21, 0x59, 0x4e,
+	0xfa, 0x35, 0x33, 0x8b, 0xe3, 0x10, 0xbb, 0xa5, 0xe5, 0xc6, 0x1b, 0x70, 0xe3, 0x45, 0x78, 0x88,
+	0x1d, 0x27, 0x4e, 0x88, 0xc3, 0x84, 0xda, 0x27, 0xe0, 0x0d, 0x50, 0xec, 0x74, 0x0d, 0xac, 0xc7,
+	0x9e, 0x6a, 0xf7, 0xfb, 0x7f, 0xbf, 0xff, 0xdf, 0x5f, 0x2c, 0xa3, 0x66, 0x08, 0x31, 0x48, 0xc5,
+	0xa8, 0xc7, 0x59, 0x32, 0x06, 0xf0, 0xa6, 0xc7, 0x5e, 0x4a, 0x33, 0xca, 0xa5, 0x9b, 0x66, 0x42,
+	0x09, 0x8c, 0x57, 0x02, 0xd7, 0x08, 0xdc, 0xe9, 0x71, 0x63, 0x3f, 0x12, 0x91, 0xd0, 0x65, 0x2f,
+	0x5f, 0x19, 0x65, 0xe3, 0x28, 0x14, 0x92, 0x0b, 0x49, 0x4c, 0xc1, 0x6c, 0x4c, 0xa9, 0xfd, 0xa7,
+	0x86, 0xea, 0x03, 0x4d, 0xc5, 0x02, 0x1d, 0x24, 0xa0, 0x3e, 0x89, 0xec, 0x82, 0x70, 0x96, 0x90,
+	0x88, 0xe6, 0x0d, 0x2c, 0x04, 0xdb, 0x6a, 0x59, 0x9d, 0x7b, 0xfe, 0x8b, 0xcb, 0xeb, 0x66, 0xe5,
+	0xd7, 0x75, 0xf3, 0x71, 0xc4, 0xd4, 0xf9, 0x24, 0x70, 0x43, 0xc1, 0x0b, 0x54, 0xf1, 0xd3, 0x95,
+	0xa3, 0x0b, 0x4f, 0xcd, 0x53, 0x90, 0x6e, 0x0f, 0xc2, 0x1f, 0xdf, 0xbb, 0xa8, 0x70, 0xea, 0x41,
+	0x38, 0xc4, 0x05, 0xba, 0xcf, 0x92, 0xd7, 0x54, 0x0e, 0x72, 0x2e, 0xf6, 0x91, 0x33, 0x9a, 0x27,
+	0x94, 0xb3, 0x90, 0x04, 0x54, 0xc2, 0xda, 0x91, 0x40, 0x42, 0x83, 0x18, 0x46, 0xf6, 0x4e, 0xcb,
+	0xea, 0xdc, 0x1d, 0x36, 0x0a, 0x95, 0x4f, 0x25, 0xac, 0x9a, 0x5f, 0x19, 0x05, 0x9e, 0xa1, 0x06,
+	0xa7, 0x33, 0xb2, 0x0a, 0xfe, 0x2f, 0xc7, 0xde, 0xdd, 0x42, 0xf2, 0x43, 0x4e, 0x67, 0xa7, 0x06,
+	0x5f, 0x0e, 0x90, 0x3b, 0xff, 0x97, 0x3a, 0x3c, 0xa7, 0x49, 0x04, 0x24, 0xa3, 0x0a, 0xec, 0xea,
+	0x36, 0x9c, 0x83, 0x92, 0xdf, 0x4b, 0x0d, 0x1f, 0x52, 0x05, 0xf8, 0x8b, 0x85, 0x1c, 0x45, 0xb3,
+	0x08, 0x14, 0x09, 0x62, 0x11, 0x10, 0xf9, 0x71, 0x42, 0x33, 0x20, 0x13, 0xc5, 0x62, 0xf6, 0x99,
+	0x2a, 0x26, 0x12, 0xbb, 0xb6, 0x05, 0xfb, 0x47, 0xc6, 0xc3, 0x8f, 0x45, 0xf0, 0x46, 0x3b, 0x9c,
+	0xad, 0x0d, 0xf0, 0x29, 0x7a, 0x38, 0x06, 0x20, 0x23, 0x48, 0x04, 0xd7, 0x27, 0x96, 0x76, 0xbd,
+	0xb5, 0xdb, 0xb9, 0xff, 0xac, 0xe5, 0xde, 0xbe, 0x96, 0xee, 0x09, 0x40, 0x2f, 0x57, 0xe6, 0xf1,
+	0xfd, 0x6a, 0x9e, 0x6a, 0xf8, 0x60, 0x5c, 0xfa, 0x4f, 0xe2, 0x0f, 0xe8, 0x88, 0xcb, 0xa8, 0x34,
+	0x4c, 0x3e, 0x89, 0x15, 0x4b, 0x63, 0x06, 0x99, 0xb4, 0xef, 0x68, 0xf2, 0x93, 0x4d, 0xe4, 0xbe,
+	0x8c, 0x56, 0x13, 0xea, 0xdf, 0x74, 0x14, 0x16, 0x87, 0x7c, 0x53, 0x51, 0xb6, 0xa7, 0x68, 0xaf,
+	0x1c, 0x08, 0xef, 0xa3, 0x9a, 0x3e, 0x87, 0xb9, 0xe8, 0x43, 0xb3, 0xc1, 0x03, 0x54, 0xd5, 0x5f,
+	0x72, 0x67, 0x0b, 0xa3, 0xd4, 0xa4, 0xf6, 0x37, 0x0b, 0x1d, 0x6c, 0xcc, 0x8b, 0x5b, 0x68, 0x2f,
+	0x3f, 0x7d, 0xde, 0x4e, 0x26, 0x59, 0x5c, 0x04, 0x41, 0x5c, 0x46, 0x6f, 0xe7, 0x29, 0x9c, 0x65,
+	0x31, 0x7e, 0x8f, 0xd0, 0x7a, 0x22, 0x5b, 0xc9, 0x54, 0xe2, 0xf9, 0x27, 0x97, 0x0b, 0xc7, 0xba,
+	0x5a, 0x38, 0xd6, 0xef, 0x85, 0x63, 0x7d, 0x5d, 0x3a, 0x95, 0xab, 0xa5, 0x53, 0xf9, 0xb9, 0x74,
+	0x2a, 0xef, 0x9e, 0x96, 0xd9, 0xc5, 0xf8, 0x45, 0x16, 0xdd, 0xac, 0xbb, 0x34, 0x4d, 0xbd, 0x59,
+	0xf1, 0x44, 0x05, 0x75, 0xfd, 0xa8, 0x3c, 0xff, 0x3b, 0x00, 0xc3, 0x1e, 0xfd, 0xee, 0xbc, 0x04,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgGasPriceMultipliers) > 0 {
+		for iNdEx := len(m.MsgGasPriceMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgGasPriceMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FeeDenomRates) > 0 {
+		for iNdEx := len(m.FeeDenomRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenomRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.TargetBlobSquareUtilization.Size()
+		i -= size
+		if _, err := m.TargetBlobSquareUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BaseGasPriceChangeRate.Size()
+		i -= size
+		if _, err := m.BaseGasPriceChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxNetworkBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MaxNetworkBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DynamicBaseGasPriceEnabled {
+		i--
+		if m.DynamicBaseGasPriceEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.NetworkMinGasPrice.Size()
+		i -= size
+		if _, err := m.NetworkMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenomRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenomRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenomRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGasPriceMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasPriceMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasPriceMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetworkMinGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DynamicBaseGasPriceEnabled {
+		n += 2
+	}
+	l = m.MaxNetworkBaseGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.BaseGasPriceChangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TargetBlobSquareUtilization.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.FeeDenomRates) > 0 {
+		for _, e := range m.FeeDenomRates {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.MsgGasPriceMultipliers) > 0 {
+		for _, e := range m.MsgGasPriceMultipliers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeDenomRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *MsgGasPriceMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetworkMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicBaseGasPriceEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicBaseGasPriceEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNetworkBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxNetworkBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPriceChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPriceChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlobSquareUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBlobSquareUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenomRates = append(m.FeeDenomRates, FeeDenomRate{})
+			if err := m.FeeDenomRates[len(m.FeeDenomRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgGasPriceMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgGasPriceMultipliers = append(m.MsgGasPriceMultipliers, MsgGasPriceMultiplier{})
+			if err := m.MsgGasPriceMultipliers[len(m.MsgGasPriceMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenomRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenomRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenomRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGasPriceMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGasPriceMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGasPriceMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
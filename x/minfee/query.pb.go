@@ -31,6 +31,88 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryParams is the request type for the Query/Params RPC method.
+type QueryParams struct {
+}
+
+func (m *QueryParams) Reset()         { *m = QueryParams{} }
+func (m *QueryParams) String() string { return proto.CompactTextString(m) }
+func (*QueryParams) ProtoMessage()    {}
+func (*QueryParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{0}
+}
+func (m *QueryParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParams.Merge(m, src)
+}
+func (m *QueryParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParams proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // QueryNetworkMinGasPrice is the request type for the Query/NetworkMinGasPrice RPC method.
 type QueryNetworkMinGasPrice struct {
 }
@@ -39,7 +121,7 @@ func (m *QueryNetworkMinGasPrice) Reset()         { *m = QueryNetworkMinGasPrice
 func (m *QueryNetworkMinGasPrice) String() string { return proto.CompactTextString(m) }
 func (*QueryNetworkMinGasPrice) ProtoMessage()    {}
 func (*QueryNetworkMinGasPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c41d9a8b7bf8984, []int{0}
+	return fileDescriptor_4c41d9a8b7bf8984, []int{2}
 }
 func (m *QueryNetworkMinGasPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -77,7 +159,7 @@ func (m *QueryNetworkMinGasPriceResponse) Reset()         { *m = QueryNetworkMin
 func (m *QueryNetworkMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNetworkMinGasPriceResponse) ProtoMessage()    {}
 func (*QueryNetworkMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c41d9a8b7bf8984, []int{1}
+	return fileDescriptor_4c41d9a8b7bf8984, []int{3}
 }
 func (m *QueryNetworkMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -106,36 +188,137 @@ func (m *QueryNetworkMinGasPriceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryNetworkMinGasPriceResponse proto.InternalMessageInfo
 
+// QueryNetworkBaseGasPrice is the request type for the Query/NetworkBaseGasPrice RPC method.
+type QueryNetworkBaseGasPrice struct {
+}
+
+func (m *QueryNetworkBaseGasPrice) Reset()         { *m = QueryNetworkBaseGasPrice{} }
+func (m *QueryNetworkBaseGasPrice) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkBaseGasPrice) ProtoMessage()    {}
+func (*QueryNetworkBaseGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{4}
+}
+func (m *QueryNetworkBaseGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetworkBaseGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworkBaseGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNetworkBaseGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkBaseGasPrice.Merge(m, src)
+}
+func (m *QueryNetworkBaseGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetworkBaseGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkBaseGasPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNetworkBaseGasPrice proto.InternalMessageInfo
+
+// QueryNetworkBaseGasPriceResponse is the response type for Query/NetworkBaseGasPrice RPC method.
+type QueryNetworkBaseGasPriceResponse struct {
+	// network_base_gas_price is the minimum gas price enforced by the network.
+	NetworkBaseGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=network_base_gas_price,json=networkBaseGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"network_base_gas_price"`
+	// dynamic_base_gas_price_enabled is true if the network base gas price is
+	// adjusted based on the blob utilization of the data square.
+	DynamicBaseGasPriceEnabled bool `protobuf:"varint,2,opt,name=dynamic_base_gas_price_enabled,json=dynamicBaseGasPriceEnabled,proto3" json:"dynamic_base_gas_price_enabled,omitempty"`
+}
+
+func (m *QueryNetworkBaseGasPriceResponse) Reset()         { *m = QueryNetworkBaseGasPriceResponse{} }
+func (m *QueryNetworkBaseGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkBaseGasPriceResponse) ProtoMessage()    {}
+func (*QueryNetworkBaseGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{5}
+}
+func (m *QueryNetworkBaseGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetworkBaseGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworkBaseGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNetworkBaseGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkBaseGasPriceResponse.Merge(m, src)
+}
+func (m *QueryNetworkBaseGasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetworkBaseGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkBaseGasPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNetworkBaseGasPriceResponse proto.InternalMessageInfo
+
+func (m *QueryNetworkBaseGasPriceResponse) GetDynamicBaseGasPriceEnabled() bool {
+	if m != nil {
+		return m.DynamicBaseGasPriceEnabled
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterType((*QueryParams)(nil), "celestia.minfee.v1.QueryParams")
+	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.minfee.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryNetworkMinGasPrice)(nil), "celestia.minfee.v1.QueryNetworkMinGasPrice")
 	proto.RegisterType((*QueryNetworkMinGasPriceResponse)(nil), "celestia.minfee.v1.QueryNetworkMinGasPriceResponse")
+	proto.RegisterType((*QueryNetworkBaseGasPrice)(nil), "celestia.minfee.v1.QueryNetworkBaseGasPrice")
+	proto.RegisterType((*QueryNetworkBaseGasPriceResponse)(nil), "celestia.minfee.v1.QueryNetworkBaseGasPriceResponse")
 }
 
 func init() { proto.RegisterFile("celestia/minfee/v1/query.proto", fileDescriptor_4c41d9a8b7bf8984) }
 
 var fileDescriptor_4c41d9a8b7bf8984 = []byte{
-	// 336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0xcf, 0xcd, 0xcc, 0x4b, 0x4b, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x2f,
-	0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xc9, 0xeb, 0x41,
-	0xe4, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xd2, 0xfa, 0x20, 0x16, 0x44,
-	0xa5, 0x94, 0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e,
-	0x5e, 0x7e, 0x49, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x56, 0x32, 0x39, 0xbf, 0x38, 0x37,
-	0xbf, 0x38, 0x1e, 0xa2, 0x0d, 0xc2, 0x81, 0x48, 0x29, 0x49, 0x72, 0x89, 0x07, 0x82, 0x6c, 0xf4,
-	0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xf6, 0xcd, 0xcc, 0x73, 0x4f, 0x2c, 0x0e, 0x28, 0xca, 0x4c,
-	0x4e, 0x55, 0x9a, 0xc4, 0xc8, 0x25, 0x8f, 0x43, 0x2e, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38,
-	0x55, 0x28, 0x9f, 0x4b, 0x34, 0x0f, 0x22, 0x1b, 0x9f, 0x9b, 0x99, 0x17, 0x9f, 0x9e, 0x08, 0xb2,
-	0x24, 0x33, 0x39, 0x55, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0xc9, 0xe6, 0xc4, 0x3d, 0x79, 0x86,
-	0x5b, 0xf7, 0xe4, 0xd5, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xa1, 0xd6,
-	0x43, 0x29, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0x62, 0x3d, 0x97, 0xd4, 0xe4,
-	0x4b, 0x5b, 0x74, 0xb9, 0xa0, 0xae, 0x73, 0x49, 0x4d, 0x0e, 0x12, 0xca, 0xc3, 0xb0, 0xd8, 0x68,
-	0x35, 0x23, 0x17, 0x2b, 0xd8, 0x51, 0x42, 0x0b, 0x19, 0xb9, 0x84, 0x30, 0x5d, 0x26, 0xa4, 0xad,
-	0x87, 0x19, 0x68, 0x7a, 0x38, 0xbc, 0x21, 0x65, 0x4c, 0x82, 0x62, 0x98, 0x9f, 0x95, 0x34, 0x9b,
-	0x2e, 0x3f, 0x99, 0xcc, 0xa4, 0x2c, 0xa4, 0xa8, 0x8f, 0x25, 0xfa, 0x50, 0x42, 0xc1, 0xc9, 0xed,
-	0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x74, 0x90, 0x43, 0x04, 0x6a, 0x4c,
-	0x7e, 0x51, 0x3a, 0x9c, 0xad, 0x9b, 0x58, 0x50, 0xa0, 0x5f, 0x01, 0x35, 0x38, 0x89, 0x0d, 0x1c,
-	0x59, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x49, 0xc3, 0x8f, 0xd9, 0x31, 0x02, 0x00, 0x00,
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0xeb, 0x01, 0x15, 0x78, 0xe2, 0xe2, 0xf2, 0xa7, 0xb3, 0xa6, 0xb4, 0x04, 0x04, 0x03,
+	0xd6, 0x58, 0xdb, 0x38, 0x70, 0xe0, 0x14, 0x0d, 0x38, 0x01, 0xa3, 0x47, 0x2e, 0x95, 0x9b, 0xbe,
+	0x04, 0x6b, 0x8d, 0x9d, 0xc5, 0x59, 0xa1, 0x57, 0x3e, 0x01, 0x82, 0x4f, 0xc0, 0x81, 0x6f, 0xc0,
+	0x87, 0x98, 0xc4, 0x65, 0x82, 0x0b, 0x70, 0x98, 0x50, 0xcb, 0x07, 0x41, 0x8d, 0xdd, 0x28, 0x23,
+	0xa9, 0xa6, 0x49, 0x3b, 0xd5, 0xee, 0xf3, 0xbc, 0x7e, 0x7e, 0xf6, 0xfb, 0x2a, 0xd8, 0x09, 0x60,
+	0x08, 0x3a, 0x15, 0x9c, 0x45, 0x42, 0xbe, 0x06, 0x60, 0xa3, 0x0d, 0xb6, 0xb7, 0x0f, 0xc9, 0xd8,
+	0x8b, 0x13, 0x95, 0x2a, 0x42, 0xe6, 0xba, 0x67, 0x74, 0x6f, 0xb4, 0x41, 0xaf, 0x84, 0x2a, 0x54,
+	0x99, 0xcc, 0x66, 0x2b, 0xe3, 0xa4, 0xab, 0xa1, 0x52, 0xe1, 0x10, 0x18, 0x8f, 0x05, 0xe3, 0x52,
+	0xaa, 0x94, 0xa7, 0x42, 0x49, 0x6d, 0xd5, 0x95, 0x40, 0xe9, 0x48, 0xe9, 0x9e, 0x29, 0x33, 0x1b,
+	0x2b, 0xb5, 0x2a, 0x10, 0x62, 0x9e, 0xf0, 0xc8, 0x1a, 0xdc, 0xcb, 0x78, 0xf9, 0xe5, 0x0c, 0x69,
+	0x27, 0xfb, 0xd3, 0x7d, 0x81, 0x1b, 0x85, 0x6d, 0x17, 0x74, 0xac, 0xa4, 0x06, 0xf2, 0x10, 0xd7,
+	0x4d, 0x55, 0x13, 0xb5, 0xd1, 0xda, 0xf2, 0x26, 0xf5, 0xca, 0xe8, 0x9e, 0xa9, 0xf1, 0xcf, 0x1f,
+	0x1c, 0xb5, 0x6a, 0x5d, 0xeb, 0x77, 0x57, 0xf0, 0xf5, 0xec, 0xc0, 0xe7, 0x90, 0xbe, 0x55, 0xc9,
+	0xee, 0x33, 0x21, 0x9f, 0x72, 0xbd, 0x93, 0x88, 0x00, 0xdc, 0x8f, 0x08, 0xb7, 0x16, 0x68, 0x79,
+	0xb0, 0xc2, 0x57, 0xa5, 0x51, 0x7b, 0x91, 0x90, 0xbd, 0x90, 0xcf, 0x6e, 0x29, 0x02, 0xc8, 0x38,
+	0x2e, 0xf9, 0x8f, 0x66, 0x59, 0xbf, 0x8f, 0x5a, 0xb7, 0x43, 0x91, 0xbe, 0xd9, 0xef, 0x7b, 0x81,
+	0x8a, 0xec, 0xfd, 0xed, 0x4f, 0x47, 0x0f, 0x76, 0x59, 0x3a, 0x8e, 0x41, 0x7b, 0xdb, 0x10, 0x7c,
+	0xff, 0xda, 0xc1, 0xf6, 0x79, 0xb6, 0x21, 0xe8, 0x12, 0x59, 0x86, 0xa2, 0xb8, 0x59, 0x64, 0xf2,
+	0xb9, 0x86, 0x5c, 0xfb, 0x85, 0x70, 0x7b, 0x91, 0x98, 0x13, 0xef, 0xe1, 0x6b, 0x73, 0xe2, 0x3e,
+	0xd7, 0x70, 0xc6, 0xc8, 0x0d, 0x59, 0x8e, 0x26, 0x3e, 0x76, 0x06, 0x63, 0xc9, 0x23, 0x11, 0xfc,
+	0x17, 0xd9, 0x03, 0xc9, 0xfb, 0x43, 0x18, 0x34, 0x97, 0xda, 0x68, 0xed, 0x62, 0x97, 0x5a, 0x57,
+	0xb1, 0xf8, 0xb1, 0x71, 0x6c, 0x7e, 0x3b, 0x87, 0x2f, 0x64, 0x77, 0x23, 0x23, 0x5c, 0x37, 0x9d,
+	0x24, 0xad, 0xaa, 0x2e, 0x17, 0xc6, 0x83, 0xde, 0x39, 0xc1, 0x30, 0x7f, 0x14, 0xd7, 0x7d, 0xff,
+	0xe3, 0xef, 0xa7, 0xa5, 0x55, 0x42, 0xd9, 0xc2, 0x79, 0x24, 0x9f, 0x11, 0x26, 0xe5, 0x49, 0x20,
+	0xf7, 0x17, 0x66, 0x94, 0xcd, 0x74, 0xeb, 0x14, 0xe6, 0x1c, 0xee, 0x6e, 0x06, 0x77, 0x93, 0xdc,
+	0xa8, 0x82, 0x3b, 0x36, 0x75, 0xe4, 0x0b, 0xc2, 0x8d, 0x8a, 0xe6, 0x93, 0xf5, 0x93, 0x72, 0x8b,
+	0x6e, 0xfa, 0xe0, 0x34, 0xee, 0x1c, 0xf3, 0x5e, 0x86, 0x79, 0x8b, 0xb8, 0x55, 0x98, 0xc7, 0xfb,
+	0xee, 0x3f, 0x39, 0x98, 0x38, 0xe8, 0x70, 0xe2, 0xa0, 0x3f, 0x13, 0x07, 0x7d, 0x98, 0x3a, 0xb5,
+	0xc3, 0xa9, 0x53, 0xfb, 0x39, 0x75, 0x6a, 0xaf, 0xd6, 0x8b, 0x63, 0x67, 0xcf, 0x51, 0x49, 0x98,
+	0xaf, 0x3b, 0x3c, 0x8e, 0xd9, 0x3b, 0x7b, 0x72, 0xbf, 0x9e, 0x7d, 0x24, 0xb6, 0xfe, 0x0d, 0x00,
+	0x3d, 0xe0, 0x23, 0xd7, 0xca, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -150,8 +333,14 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params queries the parameters of the minfee module.
+	Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// NetworkMinGasPrice queries the network wide minimum gas price.
 	NetworkMinGasPrice(ctx context.Context, in *QueryNetworkMinGasPrice, opts ...grpc.CallOption) (*QueryNetworkMinGasPriceResponse, error)
+	// NetworkBaseGasPrice queries the minimum gas price currently enforced by
+	// the network. It is the dynamic network base gas price if enabled and the
+	// network min gas price otherwise.
+	NetworkBaseGasPrice(ctx context.Context, in *QueryNetworkBaseGasPrice, opts ...grpc.CallOption) (*QueryNetworkBaseGasPriceResponse, error)
 }
 
 type queryClient struct {
@@ -162,6 +351,15 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.minfee.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) NetworkMinGasPrice(ctx context.Context, in *QueryNetworkMinGasPrice, opts ...grpc.CallOption) (*QueryNetworkMinGasPriceResponse, error) {
 	out := new(QueryNetworkMinGasPriceResponse)
 	err := c.cc.Invoke(ctx, "/celestia.minfee.v1.Query/NetworkMinGasPrice", in, out, opts...)
@@ -171,24 +369,63 @@ func (c *queryClient) NetworkMinGasPrice(ctx context.Context, in *QueryNetworkMi
 	return out, nil
 }
 
+func (c *queryClient) NetworkBaseGasPrice(ctx context.Context, in *QueryNetworkBaseGasPrice, opts ...grpc.CallOption) (*QueryNetworkBaseGasPriceResponse, error) {
+	out := new(QueryNetworkBaseGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.minfee.v1.Query/NetworkBaseGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	// Params queries the parameters of the minfee module.
+	Params(context.Context, *QueryParams) (*QueryParamsResponse, error)
 	// NetworkMinGasPrice queries the network wide minimum gas price.
 	NetworkMinGasPrice(context.Context, *QueryNetworkMinGasPrice) (*QueryNetworkMinGasPriceResponse, error)
+	// NetworkBaseGasPrice queries the minimum gas price currently enforced by
+	// the network. It is the dynamic network base gas price if enabled and the
+	// network min gas price otherwise.
+	NetworkBaseGasPrice(context.Context, *QueryNetworkBaseGasPrice) (*QueryNetworkBaseGasPriceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServer struct {
 }
 
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParams) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (*UnimplementedQueryServer) NetworkMinGasPrice(ctx context.Context, req *QueryNetworkMinGasPrice) (*QueryNetworkMinGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NetworkMinGasPrice not implemented")
 }
+func (*UnimplementedQueryServer) NetworkBaseGasPrice(ctx context.Context, req *QueryNetworkBaseGasPrice) (*QueryNetworkBaseGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkBaseGasPrice not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.minfee.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_NetworkMinGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryNetworkMinGasPrice)
 	if err := dec(in); err != nil {
@@ -207,19 +444,101 @@ func _Query_NetworkMinGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NetworkBaseGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetworkBaseGasPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NetworkBaseGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.minfee.v1.Query/NetworkBaseGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetworkBaseGasPrice(ctx, req.(*QueryNetworkBaseGasPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.minfee.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "NetworkMinGasPrice",
 			Handler:    _Query_NetworkMinGasPrice_Handler,
 		},
+		{
+			MethodName: "NetworkBaseGasPrice",
+			Handler:    _Query_NetworkBaseGasPrice_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/minfee/v1/query.proto",
 }
 
+func (m *QueryParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryNetworkMinGasPrice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -276,6 +595,72 @@ func (m *QueryNetworkMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryNetworkBaseGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkBaseGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkBaseGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworkBaseGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkBaseGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkBaseGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DynamicBaseGasPriceEnabled {
+		i--
+		if m.DynamicBaseGasPriceEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.NetworkBaseGasPrice.Size()
+		i -= size
+		if _, err := m.NetworkBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -287,6 +672,26 @@ func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *QueryParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryNetworkMinGasPrice) Size() (n int) {
 	if m == nil {
 		return 0
@@ -307,12 +712,168 @@ func (m *QueryNetworkMinGasPriceResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryNetworkBaseGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNetworkBaseGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetworkBaseGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DynamicBaseGasPriceEnabled {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *QueryParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryNetworkMinGasPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -447,6 +1008,160 @@ func (m *QueryNetworkMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryNetworkBaseGasPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworkBaseGasPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworkBaseGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetworkBaseGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworkBaseGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworkBaseGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetworkBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicBaseGasPriceEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicBaseGasPriceEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
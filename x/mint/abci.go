@@ -3,6 +3,7 @@ package mint
 import (
 	"time"
 
+	v1 "github.com/celestiaorg/celestia-app/v2/pkg/appconsts/v1"
 	"github.com/celestiaorg/celestia-app/v2/x/mint/keeper"
 	"github.com/celestiaorg/celestia-app/v2/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -22,11 +23,12 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 // maybeUpdateMinter updates the inflation rate and annual provisions if the
 // inflation rate has changed. The inflation rate is expected to change once per
 // year at the genesis time anniversary until the TargetInflationRate is
-// reached.
+// reached, and when governance changes the DisinflationRate or the
+// TargetInflationRate.
 func maybeUpdateMinter(ctx sdk.Context, k keeper.Keeper) {
 	minter := k.GetMinter(ctx)
 	genesisTime := k.GetGenesisTime(ctx).GenesisTime
-	newInflationRate := minter.CalculateInflationRate(ctx, *genesisTime)
+	newInflationRate := minter.CalculateInflationRate(ctx, *genesisTime, k.GetParams(ctx))
 
 	isNonZeroAnnualProvisions := !minter.AnnualProvisions.IsZero()
 	if newInflationRate.Equal(minter.InflationRate) && isNonZeroAnnualProvisions {
@@ -68,6 +70,14 @@ func mintBlockProvision(ctx sdk.Context, k keeper.Keeper) {
 		panic(err)
 	}
 
+	// The minted supply is only tracked for app versions greater than one so
+	// that the state of app version one doesn't change.
+	if ctx.BlockHeader().Version.App > v1.Version {
+		genesisTime := k.GetGenesisTime(ctx).GenesisTime
+		year := types.YearsSinceGenesis(*genesisTime, ctx.BlockTime())
+		k.AddMintedSupply(ctx, uint64(year), toMintCoin.Amount)
+	}
+
 	if toMintCoin.Amount.IsInt64() {
 		defer telemetry.ModuleSetGauge(types.ModuleName, float32(toMintCoin.Amount.Int64()), "minted_tokens")
 	}
@@ -10,10 +10,12 @@ import (
 	"github.com/celestiaorg/celestia-app/v2/x/mint"
 	minttypes "github.com/celestiaorg/celestia-app/v2/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
 )
 
 var oneYear = time.Duration(minttypes.NanosecondsPerYear)
@@ -101,7 +103,7 @@ func TestAnnualProvisions(t *testing.T) {
 
 		blockInterval := time.Second * 15
 
-		want := minttypes.InitialInflationRateAsDec().MulInt(initialSupply)
+		want := minttypes.DefaultInitialInflationRate.MulInt(initialSupply)
 
 		type testCase struct {
 			height int64
@@ -131,3 +133,81 @@ func TestAnnualProvisions(t *testing.T) {
 		})
 	})
 }
+
+func TestMintedSupply(t *testing.T) {
+	t.Run("minted supply is tracked for app version two", func(t *testing.T) {
+		a, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+		header := types.Header{Version: version.Consensus{App: 2}}
+		ctx := sdk.NewContext(a.CommitMultiStore(), header, false, tmlog.NewNopLogger())
+		genesisTime := a.MintKeeper.GetGenesisTime(ctx).GenesisTime
+		feeCollector := a.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+		want := sdk.ZeroInt()
+		blockTimes := []time.Time{
+			genesisTime.Add(15 * time.Second),
+			genesisTime.Add(30 * time.Second),
+			genesisTime.Add(oneYear),
+			genesisTime.Add(oneYear + 15*time.Second),
+		}
+		for i, blockTime := range blockTimes {
+			ctx = ctx.WithBlockHeight(int64(i + 1)).WithBlockTime(blockTime)
+			before := a.BankKeeper.GetBalance(ctx, feeCollector, a.MintKeeper.GetMinter(ctx).BondDenom)
+			mint.BeginBlocker(ctx, a.MintKeeper)
+			after := a.BankKeeper.GetBalance(ctx, feeCollector, a.MintKeeper.GetMinter(ctx).BondDenom)
+			want = want.Add(after.Amount.Sub(before.Amount))
+		}
+
+		got, err := a.MintKeeper.MintedSupply(ctx, &minttypes.QueryMintedSupplyRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, want, got.MintedSupply.Total)
+		// the first block has no previous block time so nothing is minted
+		assert.Equal(t, int64(2), got.MintedSupply.SinceHeight)
+		require.Len(t, got.YearlyMintedSupply, 2)
+		assert.Equal(t, uint64(0), got.YearlyMintedSupply[0].Year)
+		assert.Equal(t, uint64(1), got.YearlyMintedSupply[1].Year)
+		assert.Equal(t, want, got.YearlyMintedSupply[0].Minted.Add(got.YearlyMintedSupply[1].Minted))
+
+		exported := a.MintKeeper.ExportGenesis(ctx)
+		require.NotNil(t, exported.MintedSupply)
+		assert.Equal(t, got.MintedSupply, *exported.MintedSupply)
+		assert.Equal(t, got.YearlyMintedSupply, exported.YearlyMintedSupply)
+	})
+
+	t.Run("minted supply isn't tracked for app version one", func(t *testing.T) {
+		a, _ := util.SetupTestAppWithGenesisValSet(app.DefaultInitialConsensusParams())
+		header := types.Header{Version: version.Consensus{App: 1}}
+		ctx := sdk.NewContext(a.CommitMultiStore(), header, false, tmlog.NewNopLogger())
+		genesisTime := a.MintKeeper.GetGenesisTime(ctx).GenesisTime
+
+		mint.BeginBlocker(ctx.WithBlockHeight(1).WithBlockTime(genesisTime.Add(15*time.Second)), a.MintKeeper)
+		mint.BeginBlocker(ctx.WithBlockHeight(2).WithBlockTime(genesisTime.Add(30*time.Second)), a.MintKeeper)
+
+		_, found := a.MintKeeper.GetMintedSupply(ctx)
+		assert.False(t, found)
+		assert.Empty(t, a.MintKeeper.GetYearlyMintedSupply(ctx))
+		assert.Nil(t, a.MintKeeper.ExportGenesis(ctx).MintedSupply)
+	})
+}
+
+func TestInflationRateWithParams(t *testing.T) {
+	a, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := sdk.NewContext(a.CommitMultiStore(), types.Header{}, false, tmlog.NewNopLogger())
+	genesisTime := a.MintKeeper.GetGenesisTime(ctx).GenesisTime
+	yearOne := genesisTime.Add(oneYear)
+
+	// the default params are used before they are stored
+	assert.Equal(t, minttypes.DefaultParams(), a.MintKeeper.GetParams(ctx))
+	mint.BeginBlocker(ctx.WithBlockTime(yearOne), a.MintKeeper)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.072"), a.MintKeeper.GetMinter(ctx).InflationRate)
+
+	params := a.MintKeeper.GetParams(ctx)
+	params.DisinflationRate = sdk.MustNewDecFromStr("0.2")
+	a.MintKeeper.SetParams(ctx, params)
+	mint.BeginBlocker(ctx.WithBlockTime(yearOne.Add(time.Second)), a.MintKeeper)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.064"), a.MintKeeper.GetMinter(ctx).InflationRate)
+
+	params.TargetInflationRate = sdk.MustNewDecFromStr("0.07")
+	a.MintKeeper.SetParams(ctx, params)
+	mint.BeginBlocker(ctx.WithBlockTime(yearOne.Add(2*time.Second)), a.MintKeeper)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.07"), a.MintKeeper.GetMinter(ctx).InflationRate)
+}
@@ -24,6 +24,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryInflationRate(),
 		GetCmdQueryAnnualProvisions(),
 		GetCmdQueryGenesisTime(),
+		GetCmdQueryInflationSchedule(),
+		GetCmdQueryMintedSupply(),
+		GetCmdQueryParams(),
 	)
 
 	return mintQueryCmd
@@ -114,3 +117,90 @@ func GetCmdQueryGenesisTime() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryInflationSchedule implements a command to return the projected
+// inflation schedule.
+func GetCmdQueryInflationSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation-schedule",
+		Short: "Query the projected inflation schedule until the target inflation rate is reached",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QueryInflationScheduleRequest{}
+			res, err := queryClient.InflationSchedule(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMintedSupply implements a command to return the number of tokens
+// minted due to inflation.
+func GetCmdQueryMintedSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minted-supply",
+		Short: "Query the number of tokens minted due to inflation",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QueryMintedSupplyRequest{}
+			res, err := queryClient.MintedSupply(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements a command to return the params of the mint
+// module.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current mint params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QueryParamsRequest{}
+			res, err := queryClient.Params(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
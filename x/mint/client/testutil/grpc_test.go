@@ -17,7 +17,7 @@ import (
 func (s *IntegrationTestSuite) TestQueryGRPC() {
 	baseURL := s.cctx.APIAddress()
 	baseURL = strings.Replace(baseURL, "tcp", "http", 1)
-	expectedAnnualProvision := mint.InitialInflationRateAsDec().MulInt(sdk.NewInt(testnode.DefaultInitialBalance))
+	expectedAnnualProvision := mint.DefaultInitialInflationRate.MulInt(sdk.NewInt(testnode.DefaultInitialBalance))
 	testCases := []struct {
 		name     string
 		url      string
@@ -101,7 +101,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryAnnualProvisions() {
 		},
 	}
 
-	expectedAnnualProvision := mint.InitialInflationRateAsDec().MulInt(sdk.NewInt(testnode.DefaultInitialBalance))
+	expectedAnnualProvision := mint.DefaultInitialInflationRate.MulInt(sdk.NewInt(testnode.DefaultInitialBalance))
 	for _, tc := range testCases {
 		tc := tc
 
@@ -157,6 +157,51 @@ func (s *IntegrationTestSuite) TestGetCmdQueryGenesisTime() {
 	}
 }
 
+// TestGetCmdQueryInflationSchedule tests that the CLI command for the
+// inflation schedule returns a schedule that starts at the initial inflation
+// rate and ends at the target inflation rate.
+func (s *IntegrationTestSuite) TestGetCmdQueryInflationSchedule() {
+	cmd := cli.GetCmdQueryInflationSchedule()
+	out, err := clitestutil.ExecTestCLICmd(s.cctx.Context, cmd, s.jsonArgs())
+	s.Require().NoError(err)
+
+	var res mint.QueryInflationScheduleResponse
+	s.Require().NoError(s.cctx.Codec.UnmarshalJSON(out.Bytes(), &res))
+	s.Require().NotEmpty(res.Years)
+	s.Assert().Equal(mint.DefaultInitialInflationRate, res.Years[0].InflationRate)
+	s.Assert().Equal(mint.DefaultTargetInflationRate, res.Years[len(res.Years)-1].InflationRate)
+}
+
+// TestGetCmdQueryMintedSupply tests that the CLI command for the minted supply
+// returns the tokens minted since the chain started.
+func (s *IntegrationTestSuite) TestGetCmdQueryMintedSupply() {
+	height, err := s.cctx.WaitForHeight(3)
+	s.Require().NoError(err)
+
+	cmd := cli.GetCmdQueryMintedSupply()
+	args := []string{fmt.Sprintf("--%s=%d", flags.FlagHeight, height), fmt.Sprintf("--%s=json", tmcli.OutputFlag)}
+	out, err := clitestutil.ExecTestCLICmd(s.cctx.Context, cmd, args)
+	s.Require().NoError(err)
+
+	var res mint.QueryMintedSupplyResponse
+	s.Require().NoError(s.cctx.Codec.UnmarshalJSON(out.Bytes(), &res))
+	s.Assert().True(res.MintedSupply.Total.IsPositive())
+	s.Require().Len(res.YearlyMintedSupply, 1)
+	s.Assert().Equal(res.MintedSupply.Total, res.YearlyMintedSupply[0].Minted)
+}
+
+// TestGetCmdQueryParams tests that the CLI command for the params returns the
+// default params.
+func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
+	cmd := cli.GetCmdQueryParams()
+	out, err := clitestutil.ExecTestCLICmd(s.cctx.Context, cmd, s.jsonArgs())
+	s.Require().NoError(err)
+
+	var res mint.Params
+	s.Require().NoError(s.cctx.Codec.UnmarshalJSON(out.Bytes(), &res))
+	s.Assert().Equal(mint.DefaultParams(), res)
+}
+
 // In order for 'go test' to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run
 func TestMintIntegrationTestSuite(t *testing.T) {
@@ -7,7 +7,13 @@ import (
 
 // InitGenesis initializes the x/mint store with data from the genesis state.
 func (k Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *types.GenesisState) {
+	// the params are only stored if they are set so that the state of chains
+	// that use the default params doesn't change.
+	if data.Params != nil {
+		k.SetParams(ctx, *data.Params)
+	}
 	minter := types.DefaultMinter()
+	minter.InflationRate = k.GetParams(ctx).InitialInflationRate
 	minter.BondDenom = data.BondDenom
 	k.SetMinter(ctx, minter)
 	// override the genesis time with the actual genesis time supplied in `InitChain`
@@ -16,6 +22,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *types
 		GenesisTime: &blockTime,
 	}
 	k.SetGenesisTime(ctx, gt)
+	if data.MintedSupply != nil {
+		k.SetMintedSupply(ctx, *data.MintedSupply)
+	}
+	for _, ym := range data.YearlyMintedSupply {
+		k.SetYearlyMintedSupply(ctx, ym)
+	}
 	// Although ak.GetModuleAccount appears to be a no-op, it actually creates a
 	// new module account in the x/auth account store if it doesn't exist. See
 	// the x/auth keeper for more details.
@@ -25,5 +37,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *types
 // ExportGenesis returns a x/mint GenesisState for the given context.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	bondDenom := k.GetMinter(ctx).BondDenom
-	return types.NewGenesisState(bondDenom)
+	gs := types.NewGenesisState(bondDenom)
+	if mintedSupply, found := k.GetMintedSupply(ctx); found {
+		gs.MintedSupply = &mintedSupply
+	}
+	gs.YearlyMintedSupply = k.GetYearlyMintedSupply(ctx)
+	params := k.GetParams(ctx)
+	gs.Params = &params
+	return gs
 }
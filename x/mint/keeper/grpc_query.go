@@ -32,3 +32,32 @@ func (k Keeper) GenesisTime(c context.Context, _ *types.QueryGenesisTimeRequest)
 
 	return &types.QueryGenesisTimeResponse{GenesisTime: genesisTime}, nil
 }
+
+// InflationSchedule returns the projected inflation schedule of the mint
+// module from the current year until the target inflation rate is reached.
+func (k Keeper) InflationSchedule(c context.Context, _ *types.QueryInflationScheduleRequest) (*types.QueryInflationScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+	genesisTime := k.GetGenesisTime(ctx).GenesisTime
+
+	return &types.QueryInflationScheduleResponse{Years: minter.InflationSchedule(*genesisTime, ctx.BlockTime(), k.GetParams(ctx))}, nil
+}
+
+// MintedSupply returns the number of tokens minted due to inflation by the
+// mint module.
+func (k Keeper) MintedSupply(c context.Context, _ *types.QueryMintedSupplyRequest) (*types.QueryMintedSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	mintedSupply, _ := k.GetMintedSupply(ctx)
+
+	return &types.QueryMintedSupplyResponse{
+		MintedSupply:       mintedSupply,
+		YearlyMintedSupply: k.GetYearlyMintedSupply(ctx),
+	}, nil
+}
+
+// Params returns the params of the mint module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
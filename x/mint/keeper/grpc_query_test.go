@@ -50,6 +50,22 @@ func (suite *MintTestSuite) TestGRPC() {
 	genesisTime, err := queryClient.GenesisTime(gocontext.Background(), &types.QueryGenesisTimeRequest{})
 	suite.Require().NoError(err)
 	suite.Require().Equal(genesisTime.GenesisTime, app.MintKeeper.GetGenesisTime(ctx).GenesisTime)
+
+	schedule, err := queryClient.InflationSchedule(gocontext.Background(), &types.QueryInflationScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(schedule.Years)
+	suite.Require().Equal(app.MintKeeper.GetMinter(ctx).InflationRate, schedule.Years[0].InflationRate)
+	suite.Require().Equal(types.DefaultTargetInflationRate, schedule.Years[len(schedule.Years)-1].InflationRate)
+
+	mintedSupply, err := queryClient.MintedSupply(gocontext.Background(), &types.QueryMintedSupplyRequest{})
+	suite.Require().NoError(err)
+	wantMintedSupply, _ := app.MintKeeper.GetMintedSupply(ctx)
+	suite.Require().Equal(wantMintedSupply, mintedSupply.MintedSupply)
+	suite.Require().ElementsMatch(app.MintKeeper.GetYearlyMintedSupply(ctx), mintedSupply.YearlyMintedSupply)
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.MintKeeper.GetParams(ctx), params.Params)
 }
 
 func TestMintTestSuite(t *testing.T) {
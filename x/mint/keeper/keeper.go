@@ -8,12 +8,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Keeper of the mint store
 type Keeper struct {
 	cdc              codec.BinaryCodec
 	storeKey         storetypes.StoreKey
+	paramSpace       paramtypes.Subspace
 	stakingKeeper    types.StakingKeeper
 	bankKeeper       types.BankKeeper
 	feeCollectorName string
@@ -23,6 +25,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	paramSpace paramtypes.Subspace,
 	stakingKeeper types.StakingKeeper,
 	ak types.AccountKeeper,
 	bankKeeper types.BankKeeper,
@@ -33,9 +36,14 @@ func NewKeeper(
 		panic("the mint module account has not been set")
 	}
 
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		cdc:              cdc,
 		storeKey:         storeKey,
+		paramSpace:       paramSpace,
 		stakingKeeper:    stakingKeeper,
 		bankKeeper:       bankKeeper,
 		feeCollectorName: feeCollectorName,
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateParams writes the params, which were hard-coded constants before app
+// version 2, to the store. It runs during the upgrade from app version 1 to 2.
+// Until it runs, the keeper falls back to the default params.
+func (m Migrator) MigrateParams(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, m.keeper.GetParams(ctx))
+	return nil
+}
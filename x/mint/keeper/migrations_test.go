@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
+	"github.com/celestiaorg/celestia-app/v2/x/mint/keeper"
+	minttypes "github.com/celestiaorg/celestia-app/v2/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	a, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := sdk.NewContext(a.CommitMultiStore(), types.Header{}, false, tmlog.NewNopLogger())
+	subspace := a.GetSubspace(minttypes.ModuleName)
+
+	// the default params aren't stored at genesis
+	assert.False(t, subspace.Has(ctx, minttypes.KeyDisinflationRate))
+
+	require.NoError(t, keeper.NewMigrator(a.MintKeeper).MigrateParams(ctx))
+	var got minttypes.Params
+	subspace.GetParamSet(ctx, &got)
+	assert.Equal(t, minttypes.DefaultParams(), got)
+}
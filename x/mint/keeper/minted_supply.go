@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/v2/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetMintedSupply returns the minted supply and whether it was ever tracked.
+func (k Keeper) GetMintedSupply(ctx sdk.Context) (types.MintedSupply, bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.KeyMintedSupply)
+	if b == nil {
+		return types.MintedSupply{Total: sdk.ZeroInt()}, false
+	}
+
+	var mintedSupply types.MintedSupply
+	k.cdc.MustUnmarshal(b, &mintedSupply)
+	return mintedSupply, true
+}
+
+// SetMintedSupply sets the minted supply.
+func (k Keeper) SetMintedSupply(ctx sdk.Context, mintedSupply types.MintedSupply) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&mintedSupply)
+	store.Set(types.KeyMintedSupply, b)
+}
+
+// GetYearlyMintedSupply returns the number of tokens minted during each year
+// of the inflation schedule, ordered by year.
+func (k Keeper) GetYearlyMintedSupply(ctx sdk.Context) []types.YearlyMintedSupply {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixYearlyMintedSupply)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	yearly := []types.YearlyMintedSupply{}
+	for ; iterator.Valid(); iterator.Next() {
+		var ym types.YearlyMintedSupply
+		k.cdc.MustUnmarshal(iterator.Value(), &ym)
+		yearly = append(yearly, ym)
+	}
+	return yearly
+}
+
+// SetYearlyMintedSupply sets the number of tokens minted during a year of the
+// inflation schedule.
+func (k Keeper) SetYearlyMintedSupply(ctx sdk.Context, ym types.YearlyMintedSupply) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&ym)
+	store.Set(types.YearlyMintedSupplyKey(ym.Year), b)
+}
+
+// AddMintedSupply adds amount to the total minted supply and to the minted
+// supply of year. The minted supply starts being tracked at the current block
+// height the first time it is called.
+func (k Keeper) AddMintedSupply(ctx sdk.Context, year uint64, amount sdk.Int) {
+	mintedSupply, found := k.GetMintedSupply(ctx)
+	if !found {
+		mintedSupply.SinceHeight = ctx.BlockHeight()
+	}
+	mintedSupply.Total = mintedSupply.Total.Add(amount)
+	k.SetMintedSupply(ctx, mintedSupply)
+
+	ym := types.YearlyMintedSupply{Year: year, Minted: sdk.ZeroInt()}
+	if b := ctx.KVStore(k.storeKey).Get(types.YearlyMintedSupplyKey(year)); b != nil {
+		k.cdc.MustUnmarshal(b, &ym)
+	}
+	ym.Minted = ym.Minted.Add(amount)
+	k.SetYearlyMintedSupply(ctx, ym)
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"github.com/celestiaorg/celestia-app/v2/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the params of the mint module. The params that are not in
+// the store are set to their default values.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params.WithDefaults()
+}
+
+// SetParams sets the params of the mint module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
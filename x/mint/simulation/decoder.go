@@ -24,6 +24,16 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &genesisTimeA)
 			cdc.MustUnmarshal(kvB.Value, &genesisTimeB)
 			return fmt.Sprintf("%v\n%v", genesisTimeA, genesisTimeB)
+		case bytes.Equal(kvA.Key, types.KeyMintedSupply):
+			var mintedSupplyA, mintedSupplyB types.MintedSupply
+			cdc.MustUnmarshal(kvA.Value, &mintedSupplyA)
+			cdc.MustUnmarshal(kvB.Value, &mintedSupplyB)
+			return fmt.Sprintf("%v\n%v", mintedSupplyA, mintedSupplyB)
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefixYearlyMintedSupply):
+			var yearlyA, yearlyB types.YearlyMintedSupply
+			cdc.MustUnmarshal(kvA.Value, &yearlyA)
+			cdc.MustUnmarshal(kvB.Value, &yearlyB)
+			return fmt.Sprintf("%v\n%v", yearlyA, yearlyB)
 		default:
 			panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 		}
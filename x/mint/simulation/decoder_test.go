@@ -20,11 +20,15 @@ func TestDecodeStore(t *testing.T) {
 	minter := types.NewMinter(sdk.OneDec(), sdk.NewDec(15), sdk.DefaultBondDenom)
 	unixEpoch := time.Unix(0, 0).UTC()
 	genesisTime := types.GenesisTime{GenesisTime: &unixEpoch}
+	mintedSupply := types.MintedSupply{Total: sdk.NewInt(10), SinceHeight: 2}
+	yearly := types.YearlyMintedSupply{Year: 1, Minted: sdk.NewInt(5)}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.KeyMinter, Value: cdc.MustMarshal(&minter)},
 			{Key: types.KeyGenesisTime, Value: cdc.MustMarshal(&genesisTime)},
+			{Key: types.KeyMintedSupply, Value: cdc.MustMarshal(&mintedSupply)},
+			{Key: types.YearlyMintedSupplyKey(yearly.Year), Value: cdc.MustMarshal(&yearly)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -43,6 +47,16 @@ func TestDecodeStore(t *testing.T) {
 			expected:    fmt.Sprintf("%v\n%v", genesisTime, genesisTime),
 			expectPanic: false,
 		},
+		{
+			name:        "MintedSupply",
+			expected:    fmt.Sprintf("%v\n%v", mintedSupply, mintedSupply),
+			expectPanic: false,
+		},
+		{
+			name:        "YearlyMintedSupply",
+			expected:    fmt.Sprintf("%v\n%v", yearly, yearly),
+			expectPanic: false,
+		},
 		{
 			name:        "other",
 			expected:    "",
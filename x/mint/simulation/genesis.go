@@ -0,0 +1,66 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/celestiaorg/celestia-app/v2/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Simulation parameter constants
+const (
+	InitialInflationRate = "initial_inflation_rate"
+	DisinflationRate     = "disinflation_rate"
+	TargetInflationRate  = "target_inflation_rate"
+)
+
+// GenInitialInflationRate randomizes the InitialInflationRate between 4% and
+// 12%.
+func GenInitialInflationRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(4+r.Intn(9)), 2)
+}
+
+// GenDisinflationRate randomizes the DisinflationRate between 5% and 20%.
+func GenDisinflationRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(50+r.Intn(151)), 3)
+}
+
+// GenTargetInflationRate randomizes the TargetInflationRate between 1% and 3%.
+func GenTargetInflationRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(10+r.Intn(21)), 3)
+}
+
+// RandomizedGenState generates a random GenesisState for mint.
+func RandomizedGenState(simState *module.SimulationState) {
+	var initialInflationRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InitialInflationRate, &initialInflationRate, simState.Rand,
+		func(r *rand.Rand) { initialInflationRate = GenInitialInflationRate(r) },
+	)
+
+	var disinflationRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DisinflationRate, &disinflationRate, simState.Rand,
+		func(r *rand.Rand) { disinflationRate = GenDisinflationRate(r) },
+	)
+
+	var targetInflationRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TargetInflationRate, &targetInflationRate, simState.Rand,
+		func(r *rand.Rand) { targetInflationRate = GenTargetInflationRate(r) },
+	)
+
+	params := types.NewParams(initialInflationRate, disinflationRate, targetInflationRate)
+	mintGenesis := types.NewGenesisState(sdk.DefaultBondDenom)
+	mintGenesis.Params = &params
+
+	bz, err := json.MarshalIndent(&mintGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated minting parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(mintGenesis)
+}
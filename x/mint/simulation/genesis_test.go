@@ -0,0 +1,56 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v2/x/mint/simulation"
+	"github.com/celestiaorg/celestia-app/v2/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         rand.New(rand.NewSource(1)),
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(rand.New(rand.NewSource(1)), 3),
+		InitialStake: sdk.NewInt(1000),
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var mintGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &mintGenesis)
+	require.NoError(t, types.ValidateGenesis(mintGenesis))
+	require.Equal(t, sdk.DefaultBondDenom, mintGenesis.BondDenom)
+	require.NotNil(t, mintGenesis.Params)
+	require.False(t, mintGenesis.Params.InitialInflationRate.IsZero())
+}
+
+func TestParamChanges(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	paramChanges := simulation.ParamChanges(r)
+	require.Len(t, paramChanges, 2)
+
+	wantKeys := []string{string(types.KeyDisinflationRate), string(types.KeyTargetInflationRate)}
+	for i, p := range paramChanges {
+		require.Equal(t, types.ModuleName, p.Subspace())
+		require.Equal(t, wantKeys[i], p.Key())
+
+		var value sdk.Dec
+		require.NoError(t, value.UnmarshalJSON([]byte(p.SimValue()(r))))
+		require.True(t, value.IsPositive())
+	}
+}
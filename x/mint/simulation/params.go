@@ -0,0 +1,28 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/celestiaorg/celestia-app/v2/x/mint/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// ParamChanges defines the parameters that can be modified by param change
+// proposals on the simulation. The InitialInflationRate is omitted because
+// it can't be changed by governance.
+func ParamChanges(_ *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDisinflationRate),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenDisinflationRate(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyTargetInflationRate),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenTargetInflationRate(r))
+			},
+		),
+	}
+}
@@ -1,6 +1,9 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(bondDenom string) *GenesisState {
@@ -22,5 +25,23 @@ func ValidateGenesis(data GenesisState) error {
 	if data.BondDenom == "" {
 		return errors.New("bond denom cannot be empty")
 	}
+	if data.MintedSupply != nil && (data.MintedSupply.Total.IsNil() || data.MintedSupply.Total.IsNegative()) {
+		return errors.New("minted supply cannot be negative")
+	}
+	years := make(map[uint64]bool, len(data.YearlyMintedSupply))
+	for _, ym := range data.YearlyMintedSupply {
+		if ym.Minted.IsNil() || ym.Minted.IsNegative() {
+			return fmt.Errorf("minted supply of year %d cannot be negative", ym.Year)
+		}
+		if years[ym.Year] {
+			return fmt.Errorf("duplicate minted supply for year %d", ym.Year)
+		}
+		years[ym.Year] = true
+	}
+	if data.Params != nil {
+		if err := data.Params.Validate(); err != nil {
+			return fmt.Errorf("params: %w", err)
+		}
+	}
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -26,6 +27,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// BondDenom is the denomination of the token that should be minted.
 	BondDenom string `protobuf:"bytes,2,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// MintedSupply is the total number of tokens minted due to inflation. It is
+	// nil if no tokens were tracked.
+	MintedSupply *MintedSupply `protobuf:"bytes,3,opt,name=minted_supply,json=mintedSupply,proto3" json:"minted_supply,omitempty"`
+	// YearlyMintedSupply is the number of tokens minted during each year of the
+	// inflation schedule.
+	YearlyMintedSupply []YearlyMintedSupply `protobuf:"bytes,4,rep,name=yearly_minted_supply,json=yearlyMintedSupply,proto3" json:"yearly_minted_supply"`
+	// Params are the params of the mint module. They are the default params if
+	// nil.
+	Params *Params `protobuf:"bytes,5,opt,name=params,proto3" json:"params,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +78,27 @@ func (m *GenesisState) GetBondDenom() string {
 	return ""
 }
 
+func (m *GenesisState) GetMintedSupply() *MintedSupply {
+	if m != nil {
+		return m.MintedSupply
+	}
+	return nil
+}
+
+func (m *GenesisState) GetYearlyMintedSupply() []YearlyMintedSupply {
+	if m != nil {
+		return m.YearlyMintedSupply
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "celestia.mint.v1.GenesisState")
 }
@@ -75,18 +106,26 @@ func init() {
 func init() { proto.RegisterFile("celestia/mint/v1/genesis.proto", fileDescriptor_1932cb996a3161e7) }
 
 var fileDescriptor_1932cb996a3161e7 = []byte{
-	// 176 bytes of a gzipped FileDescriptorProto
+	// 303 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4e, 0xcd, 0x49,
 	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
 	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xeb, 0x81,
-	0xe4, 0xf5, 0xca, 0x0c, 0x95, 0x8c, 0xb9, 0x78, 0xdc, 0x21, 0x4a, 0x82, 0x4b, 0x12, 0x4b, 0x52,
+	0xe4, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44,
+	0x9d, 0x94, 0x34, 0x86, 0x39, 0x60, 0xf5, 0x10, 0x49, 0x59, 0x0c, 0xc9, 0x82, 0xc4, 0xa2, 0xc4,
+	0x5c, 0xa8, 0x1d, 0x4a, 0x1d, 0x4c, 0x5c, 0x3c, 0xee, 0x10, 0x5b, 0x83, 0x4b, 0x12, 0x4b, 0x52,
 	0x85, 0x64, 0xb9, 0xb8, 0x92, 0xf2, 0xf3, 0x52, 0xe2, 0x53, 0x52, 0xf3, 0xf2, 0x73, 0x25, 0x98,
-	0x14, 0x18, 0x35, 0x38, 0x83, 0x38, 0x41, 0x22, 0x2e, 0x20, 0x01, 0x2f, 0x16, 0x0e, 0x46, 0x01,
-	0x26, 0x27, 0xaf, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
-	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf,
-	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xd9, 0x95, 0x5f, 0x94, 0x0e, 0x67,
-	0xeb, 0x26, 0x16, 0x14, 0xe8, 0x57, 0x40, 0x5c, 0x57, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06,
-	0x76, 0x99, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x47, 0xd0, 0x42, 0xbb, 0x00, 0x00, 0x00,
+	0x14, 0x18, 0x35, 0x38, 0x83, 0x38, 0x41, 0x22, 0x2e, 0x20, 0x01, 0x21, 0x67, 0x2e, 0x5e, 0x90,
+	0x39, 0xa9, 0x29, 0xf1, 0xc5, 0xa5, 0x05, 0x05, 0x39, 0x95, 0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0xdc,
+	0x46, 0x72, 0x7a, 0xe8, 0x6e, 0xd5, 0xf3, 0x05, 0x2b, 0x0b, 0x06, 0xab, 0x0a, 0xe2, 0xc9, 0x45,
+	0xe2, 0x09, 0xc5, 0x70, 0x89, 0x54, 0xa6, 0x26, 0x16, 0xe5, 0x54, 0xc6, 0xa3, 0x9a, 0xc5, 0xa2,
+	0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0x82, 0x69, 0x56, 0x24, 0x58, 0x35, 0xb2, 0x89, 0x4e, 0x2c, 0x27,
+	0xee, 0xc9, 0x33, 0x04, 0x09, 0x55, 0x62, 0xc8, 0x08, 0x19, 0x70, 0xb1, 0x41, 0xbc, 0x28, 0xc1,
+	0x0a, 0x76, 0x9b, 0x04, 0xa6, 0x79, 0x01, 0x60, 0xf9, 0x20, 0xa8, 0x3a, 0x2f, 0x16, 0x0e, 0x46,
+	0x01, 0x26, 0x27, 0xaf, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e,
+	0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48,
+	0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x99, 0x95, 0x5f, 0x94, 0x0e,
+	0x67, 0xeb, 0x26, 0x16, 0x14, 0xe8, 0x57, 0x40, 0x02, 0xb8, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
+	0x0d, 0x1c, 0xba, 0xc6, 0x80, 0x01, 0x00, 0xc0, 0x56, 0x06, 0x60, 0xe3, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -109,6 +148,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.YearlyMintedSupply) > 0 {
+		for iNdEx := len(m.YearlyMintedSupply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.YearlyMintedSupply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MintedSupply != nil {
+		{
+			size, err := m.MintedSupply.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -140,6 +217,20 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.MintedSupply != nil {
+		l = m.MintedSupply.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.YearlyMintedSupply) > 0 {
+		for _, e := range m.YearlyMintedSupply {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -210,6 +301,112 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintedSupply == nil {
+				m.MintedSupply = &MintedSupply{}
+			}
+			if err := m.MintedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YearlyMintedSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YearlyMintedSupply = append(m.YearlyMintedSupply, YearlyMintedSupply{})
+			if err := m.YearlyMintedSupply[len(m.YearlyMintedSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := []struct {
+		name    string
+		genesis GenesisState
+		wantErr bool
+	}{
+		{
+			name:    "default genesis",
+			genesis: *DefaultGenesisState(),
+		},
+		{
+			name: "minted supply",
+			genesis: GenesisState{
+				BondDenom:          DefaultBondDenom,
+				MintedSupply:       &MintedSupply{Total: sdk.NewInt(3), SinceHeight: 2},
+				YearlyMintedSupply: []YearlyMintedSupply{{Year: 0, Minted: sdk.NewInt(1)}, {Year: 1, Minted: sdk.NewInt(2)}},
+			},
+		},
+		{
+			name:    "empty bond denom",
+			genesis: GenesisState{},
+			wantErr: true,
+		},
+		{
+			name: "negative minted supply",
+			genesis: GenesisState{
+				BondDenom:    DefaultBondDenom,
+				MintedSupply: &MintedSupply{Total: sdk.NewInt(-1)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative yearly minted supply",
+			genesis: GenesisState{
+				BondDenom:          DefaultBondDenom,
+				YearlyMintedSupply: []YearlyMintedSupply{{Year: 0, Minted: sdk.NewInt(-1)}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate year",
+			genesis: GenesisState{
+				BondDenom:          DefaultBondDenom,
+				YearlyMintedSupply: []YearlyMintedSupply{{Year: 0, Minted: sdk.NewInt(1)}, {Year: 0, Minted: sdk.NewInt(1)}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "params",
+			genesis: GenesisState{
+				BondDenom: DefaultBondDenom,
+				Params:    &Params{InitialInflationRate: sdk.NewDecWithPrec(5, 2), DisinflationRate: sdk.NewDecWithPrec(2, 1), TargetInflationRate: sdk.NewDecWithPrec(2, 2)},
+			},
+		},
+		{
+			name: "invalid params",
+			genesis: GenesisState{
+				BondDenom: DefaultBondDenom,
+				Params:    &Params{InitialInflationRate: DefaultInitialInflationRate, DisinflationRate: sdk.ZeroDec(), TargetInflationRate: DefaultTargetInflationRate},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateGenesis(tc.genesis)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,11 +1,26 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 // KeyMinter is the key to use for the Minter in the mint store.
 var KeyMinter = []byte("Minter")
 
 // KeyGenesisTime is the key to use for GenesisTime in the mint store.
 var KeyGenesisTime = []byte("GenesisTime")
 
+// KeyMintedSupply is the key to use for MintedSupply in the mint store.
+var KeyMintedSupply = []byte("MintedSupply")
+
+// KeyPrefixYearlyMintedSupply is the prefix of the keys to use for the
+// YearlyMintedSupply of each year in the mint store.
+var KeyPrefixYearlyMintedSupply = []byte("YearlyMintedSupply/")
+
+// YearlyMintedSupplyKey returns the key to use for the YearlyMintedSupply of
+// year in the mint store.
+func YearlyMintedSupplyKey(year uint64) []byte {
+	return append(append([]byte{}, KeyPrefixYearlyMintedSupply...), sdk.Uint64ToBigEndian(year)...)
+}
+
 const (
 	// ModuleName is the name of the mint module.
 	ModuleName = "mint"
@@ -17,7 +32,10 @@ const (
 	QuerierRoute = StoreKey
 
 	// Query endpoints supported by the mint querier
-	QueryInflationRate    = "inflation_rate"
-	QueryAnnualProvisions = "annual_provisions"
-	QueryGenesisTime      = "genesis_time"
+	QueryInflationRate     = "inflation_rate"
+	QueryAnnualProvisions  = "annual_provisions"
+	QueryGenesisTime       = "genesis_time"
+	QueryInflationSchedule = "inflation_schedule"
+	QueryMintedSupply      = "minted_supply"
+	QueryParams            = "params"
 )
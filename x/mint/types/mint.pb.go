@@ -137,39 +137,147 @@ func (m *GenesisTime) GetGenesisTime() *time.Time {
 	return nil
 }
 
+// MintedSupply tracks the total number of tokens minted due to inflation.
+type MintedSupply struct {
+	// Total is the total number of tokens minted since SinceHeight.
+	Total github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total"`
+	// SinceHeight is the height of the first block whose provision was tracked.
+	// Chains that started before the minted supply was tracked don't include
+	// the tokens minted before this height.
+	SinceHeight int64 `protobuf:"varint,2,opt,name=since_height,json=sinceHeight,proto3" json:"since_height,omitempty"`
+}
+
+func (m *MintedSupply) Reset()         { *m = MintedSupply{} }
+func (m *MintedSupply) String() string { return proto.CompactTextString(m) }
+func (*MintedSupply) ProtoMessage()    {}
+func (*MintedSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962d7cf1c9c59571, []int{2}
+}
+func (m *MintedSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintedSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintedSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintedSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintedSupply.Merge(m, src)
+}
+func (m *MintedSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintedSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintedSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintedSupply proto.InternalMessageInfo
+
+func (m *MintedSupply) GetSinceHeight() int64 {
+	if m != nil {
+		return m.SinceHeight
+	}
+	return 0
+}
+
+// YearlyMintedSupply is the number of tokens minted during a year of the
+// inflation schedule.
+type YearlyMintedSupply struct {
+	// Year is the number of years since genesis (rounded down) at which the
+	// tokens were minted.
+	Year uint64 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Minted is the number of tokens minted during the year.
+	Minted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted"`
+}
+
+func (m *YearlyMintedSupply) Reset()         { *m = YearlyMintedSupply{} }
+func (m *YearlyMintedSupply) String() string { return proto.CompactTextString(m) }
+func (*YearlyMintedSupply) ProtoMessage()    {}
+func (*YearlyMintedSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962d7cf1c9c59571, []int{3}
+}
+func (m *YearlyMintedSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *YearlyMintedSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_YearlyMintedSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *YearlyMintedSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_YearlyMintedSupply.Merge(m, src)
+}
+func (m *YearlyMintedSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *YearlyMintedSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_YearlyMintedSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_YearlyMintedSupply proto.InternalMessageInfo
+
+func (m *YearlyMintedSupply) GetYear() uint64 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "celestia.mint.v1.Minter")
 	proto.RegisterType((*GenesisTime)(nil), "celestia.mint.v1.GenesisTime")
+	proto.RegisterType((*MintedSupply)(nil), "celestia.mint.v1.MintedSupply")
+	proto.RegisterType((*YearlyMintedSupply)(nil), "celestia.mint.v1.YearlyMintedSupply")
 }
 
 func init() { proto.RegisterFile("celestia/mint/v1/mint.proto", fileDescriptor_962d7cf1c9c59571) }
 
 var fileDescriptor_962d7cf1c9c59571 = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xc1, 0xca, 0xd3, 0x40,
-	0x10, 0xce, 0x96, 0x5a, 0xe8, 0x56, 0xa5, 0x8d, 0x1e, 0x62, 0xc5, 0xa4, 0xf4, 0x20, 0xbd, 0x34,
-	0xb1, 0x7a, 0xf5, 0x14, 0x0b, 0x82, 0x20, 0x94, 0xe0, 0xc9, 0x4b, 0xd8, 0xa4, 0xdb, 0x75, 0x69,
-	0xb2, 0x13, 0xb2, 0x9b, 0xa0, 0x6f, 0xd1, 0x87, 0xf1, 0x21, 0xea, 0xad, 0x78, 0x12, 0x0f, 0x55,
-	0xda, 0x17, 0x91, 0xcd, 0x26, 0xd5, 0xa3, 0x87, 0xff, 0xb4, 0x33, 0xf3, 0xcd, 0x7c, 0xdf, 0xec,
-	0xc7, 0xe0, 0xa7, 0x29, 0xcd, 0xa8, 0x54, 0x9c, 0x04, 0x39, 0x17, 0x2a, 0xa8, 0x57, 0xcd, 0xeb,
-	0x17, 0x25, 0x28, 0xb0, 0xc7, 0x1d, 0xe8, 0x37, 0xc5, 0x7a, 0x35, 0x7d, 0xcc, 0x80, 0x41, 0x03,
-	0x06, 0x3a, 0x32, 0x7d, 0xd3, 0x27, 0x29, 0xc8, 0x1c, 0x64, 0x6c, 0x00, 0x93, 0xb4, 0x90, 0xc7,
-	0x00, 0x58, 0x46, 0x83, 0x26, 0x4b, 0xaa, 0x5d, 0xa0, 0x78, 0x4e, 0xa5, 0x22, 0x79, 0x61, 0x1a,
-	0xe6, 0xdf, 0x7a, 0x78, 0xf0, 0x9e, 0x0b, 0x45, 0x4b, 0x3b, 0xc5, 0x0f, 0xb9, 0xd8, 0x65, 0x44,
-	0x71, 0x10, 0x71, 0x49, 0x14, 0x75, 0xd0, 0x0c, 0x2d, 0x86, 0xe1, 0xeb, 0xe3, 0xd9, 0xb3, 0x7e,
-	0x9e, 0xbd, 0xe7, 0x8c, 0xab, 0x4f, 0x55, 0xe2, 0xa7, 0x90, 0xb7, 0x22, 0xed, 0xb3, 0x94, 0xdb,
-	0x7d, 0xa0, 0xbe, 0x14, 0x54, 0xfa, 0x6b, 0x9a, 0x7e, 0xff, 0xba, 0xc4, 0xed, 0x0e, 0x6b, 0x9a,
-	0x46, 0x0f, 0x6e, 0x9c, 0x11, 0x51, 0xd4, 0xe6, 0x78, 0x42, 0x84, 0xa8, 0x48, 0xa6, 0xb7, 0xad,
-	0xb9, 0xe4, 0x20, 0xa4, 0xd3, 0xbb, 0x03, 0x9d, 0xb1, 0xa1, 0xdd, 0xdc, 0x58, 0xed, 0x0d, 0x7e,
-	0x54, 0x94, 0xb4, 0xe6, 0x50, 0xc9, 0x38, 0xc9, 0x20, 0xdd, 0xc7, 0xfa, 0xf3, 0x4e, 0x7f, 0x86,
-	0x16, 0xa3, 0x97, 0x53, 0xdf, 0x38, 0xe3, 0x77, 0xce, 0xf8, 0x1f, 0x3a, 0x67, 0xc2, 0xfe, 0xe1,
-	0x97, 0x87, 0xa2, 0x49, 0x37, 0x1c, 0xea, 0x59, 0x8d, 0xda, 0xcf, 0x30, 0x4e, 0x40, 0x6c, 0xe3,
-	0x2d, 0x15, 0x90, 0x3b, 0xf7, 0xf4, 0xd6, 0xd1, 0x50, 0x57, 0xd6, 0xba, 0x30, 0x8f, 0xf0, 0xe8,
-	0x2d, 0x15, 0x54, 0x72, 0xd9, 0x74, 0xbf, 0xc1, 0xf7, 0x99, 0x49, 0x8d, 0x30, 0xfa, 0x4f, 0xe1,
-	0x11, 0xfb, 0x4b, 0x12, 0xbe, 0x3b, 0x5e, 0x5c, 0x74, 0xba, 0xb8, 0xe8, 0xf7, 0xc5, 0x45, 0x87,
-	0xab, 0x6b, 0x9d, 0xae, 0xae, 0xf5, 0xe3, 0xea, 0x5a, 0x1f, 0x5f, 0xfc, 0x6b, 0x53, 0x7b, 0x28,
-	0x50, 0xb2, 0x5b, 0xbc, 0x24, 0x45, 0x11, 0x7c, 0x36, 0x77, 0xd5, 0x98, 0x96, 0x0c, 0x1a, 0xc9,
-	0x57, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x71, 0xfd, 0xfc, 0xbb, 0x75, 0x02, 0x00, 0x00,
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0x13, 0x31,
+	0x1c, 0x8d, 0x43, 0x1a, 0xa9, 0x4e, 0x40, 0xad, 0x61, 0x38, 0x82, 0xb8, 0x94, 0x0c, 0xa8, 0x4b,
+	0xee, 0x28, 0xac, 0x4c, 0x21, 0x12, 0x14, 0x09, 0xa9, 0x32, 0x5d, 0x60, 0x39, 0xf9, 0x2e, 0xbf,
+	0x3a, 0x56, 0xef, 0xec, 0xd3, 0xd9, 0x17, 0x91, 0x85, 0x8d, 0xbd, 0x1f, 0x86, 0x0f, 0x51, 0xb6,
+	0x8a, 0x09, 0x31, 0x14, 0x94, 0x7c, 0x11, 0x74, 0xf6, 0x5d, 0x28, 0x1b, 0xaa, 0x32, 0xf9, 0xf7,
+	0xcf, 0xef, 0x3d, 0xbf, 0x9f, 0x8c, 0x1f, 0x25, 0x90, 0x82, 0x36, 0x82, 0x85, 0x99, 0x90, 0x26,
+	0x5c, 0x1c, 0xd9, 0x33, 0xc8, 0x0b, 0x65, 0x14, 0xd9, 0x6b, 0x9a, 0x81, 0x2d, 0x2e, 0x8e, 0x06,
+	0x0f, 0xb8, 0xe2, 0xca, 0x36, 0xc3, 0x2a, 0x72, 0x73, 0x83, 0x87, 0x89, 0xd2, 0x99, 0xd2, 0x91,
+	0x6b, 0xb8, 0xa4, 0x6e, 0x0d, 0xb9, 0x52, 0x3c, 0x85, 0xd0, 0x66, 0x71, 0x79, 0x16, 0x1a, 0x91,
+	0x81, 0x36, 0x2c, 0xcb, 0xdd, 0xc0, 0xe8, 0x5b, 0x1b, 0x77, 0xdf, 0x09, 0x69, 0xa0, 0x20, 0x09,
+	0xbe, 0x27, 0xe4, 0x59, 0xca, 0x8c, 0x50, 0x32, 0x2a, 0x98, 0x01, 0x0f, 0x1d, 0xa0, 0xc3, 0xdd,
+	0xc9, 0xcb, 0xcb, 0xeb, 0x61, 0xeb, 0xe7, 0xf5, 0xf0, 0x29, 0x17, 0x66, 0x5e, 0xc6, 0x41, 0xa2,
+	0xb2, 0x9a, 0xa4, 0x3e, 0xc6, 0x7a, 0x76, 0x1e, 0x9a, 0x65, 0x0e, 0x3a, 0x98, 0x42, 0xf2, 0xfd,
+	0xeb, 0x18, 0xd7, 0x1a, 0xa6, 0x90, 0xd0, 0xbb, 0x1b, 0x4c, 0xca, 0x0c, 0x10, 0x81, 0xf7, 0x99,
+	0x94, 0x25, 0x4b, 0x2b, 0xb5, 0x0b, 0xa1, 0x85, 0x92, 0xda, 0x6b, 0x6f, 0x81, 0x67, 0xcf, 0xc1,
+	0x9e, 0x6c, 0x50, 0xc9, 0x09, 0xbe, 0x9f, 0x17, 0xb0, 0x10, 0xaa, 0xd4, 0x51, 0x9c, 0xaa, 0xe4,
+	0x3c, 0xaa, 0x1e, 0xef, 0x75, 0x0e, 0xd0, 0x61, 0xef, 0xf9, 0x20, 0x70, 0xce, 0x04, 0x8d, 0x33,
+	0xc1, 0x69, 0xe3, 0xcc, 0xa4, 0x73, 0xf1, 0x6b, 0x88, 0xe8, 0x7e, 0x73, 0x79, 0x52, 0xdd, 0xad,
+	0xba, 0xe4, 0x31, 0xc6, 0xb1, 0x92, 0xb3, 0x68, 0x06, 0x52, 0x65, 0xde, 0x4e, 0xa5, 0x9a, 0xee,
+	0x56, 0x95, 0x69, 0x55, 0x18, 0x51, 0xdc, 0x7b, 0x0d, 0x12, 0xb4, 0xd0, 0x76, 0xfa, 0x15, 0xee,
+	0x73, 0x97, 0x3a, 0x62, 0xf4, 0x9f, 0xc4, 0x3d, 0xfe, 0x17, 0x64, 0xf4, 0x05, 0xe1, 0xbe, 0xdd,
+	0xcf, 0xec, 0x7d, 0x99, 0xe7, 0xe9, 0x92, 0x50, 0xbc, 0x63, 0x94, 0x61, 0xe9, 0x2d, 0x96, 0x73,
+	0x2c, 0xcd, 0x0d, 0xd3, 0x8e, 0xa5, 0xa1, 0x0e, 0x8a, 0x3c, 0xc1, 0x7d, 0x2d, 0x64, 0x02, 0xd1,
+	0x1c, 0x04, 0x9f, 0x1b, 0xbb, 0x8f, 0x3b, 0xb4, 0x67, 0x6b, 0x6f, 0x6c, 0x69, 0xf4, 0x19, 0x93,
+	0x0f, 0xc0, 0x8a, 0x74, 0xf9, 0x8f, 0x18, 0x82, 0x3b, 0x4b, 0x60, 0x85, 0xd5, 0xd2, 0xa1, 0x36,
+	0x26, 0xa7, 0xb8, 0x9b, 0xd9, 0x19, 0xaf, 0xbd, 0x05, 0x85, 0x35, 0xd6, 0xe4, 0xed, 0xe5, 0xca,
+	0x47, 0x57, 0x2b, 0x1f, 0xfd, 0x5e, 0xf9, 0xe8, 0x62, 0xed, 0xb7, 0xae, 0xd6, 0x7e, 0xeb, 0xc7,
+	0xda, 0x6f, 0x7d, 0x7c, 0x76, 0x13, 0xb7, 0xfe, 0x30, 0xaa, 0xe0, 0x9b, 0x78, 0xcc, 0xf2, 0x3c,
+	0xfc, 0xe4, 0xfe, 0x97, 0x65, 0x89, 0xbb, 0xd6, 0xfa, 0x17, 0x7f, 0x06, 0x00, 0xc6, 0x73, 0x48,
+	0xee, 0x7d, 0x03, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -265,6 +373,82 @@ func (m *GenesisTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MintedSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintedSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintedSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SinceHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.SinceHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *YearlyMintedSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *YearlyMintedSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *YearlyMintedSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Year != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Year))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -310,6 +494,34 @@ func (m *GenesisTime) Size() (n int) {
 	return n
 }
 
+func (m *MintedSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.SinceHeight != 0 {
+		n += 1 + sovMint(uint64(m.SinceHeight))
+	}
+	return n
+}
+
+func (m *YearlyMintedSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Year != 0 {
+		n += 1 + sovMint(uint64(m.Year))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
 func sovMint(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -588,6 +800,212 @@ func (m *GenesisTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MintedSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintedSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintedSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceHeight", wireType)
+			}
+			m.SinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *YearlyMintedSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: YearlyMintedSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: YearlyMintedSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
+			}
+			m.Year = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Year |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMint(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
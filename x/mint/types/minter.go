@@ -21,7 +21,7 @@ func NewMinter(inflationRate sdk.Dec, annualProvisions sdk.Dec, bondDenom string
 // DefaultMinter returns a Minter object with default values.
 func DefaultMinter() Minter {
 	annualProvisions := sdk.NewDec(0)
-	return NewMinter(InitialInflationRateAsDec(), annualProvisions, DefaultBondDenom)
+	return NewMinter(DefaultInitialInflationRate, annualProvisions, DefaultBondDenom)
 }
 
 // Validate returns an error if the minter is invalid.
@@ -41,14 +41,43 @@ func (m Minter) Validate() error {
 // CalculateInflationRate returns the inflation rate for the current year depending on
 // the current block height in context. The inflation rate is expected to
 // decrease every year according to the schedule specified in the README.
-func (m Minter) CalculateInflationRate(ctx sdk.Context, genesis time.Time) sdk.Dec {
-	years := yearsSinceGenesis(genesis, ctx.BlockTime())
-	inflationRate := InitialInflationRateAsDec().Mul(sdk.OneDec().Sub(DisinflationRateAsDec()).Power(uint64(years)))
+func (m Minter) CalculateInflationRate(ctx sdk.Context, genesis time.Time, params Params) sdk.Dec {
+	years := YearsSinceGenesis(genesis, ctx.BlockTime())
+	return params.InflationRateForYear(uint64(years))
+}
+
+// InflationSchedule returns the projected inflation schedule from the year
+// that contains current until the first year with the TargetInflationRate of
+// params.
+// The annual provisions of the current year are the minter's. The annual
+// provisions of the following years assume that the supply at the start of a
+// year is the supply at the start of the previous year plus its provisions.
+func (m Minter) InflationSchedule(genesis time.Time, current time.Time, params Params) []InflationScheduleYear {
+	year := uint64(YearsSinceGenesis(genesis, current))
+	inflationRate := m.InflationRate
+	annualProvisions := m.AnnualProvisions
+	supply := sdk.ZeroDec()
+	if inflationRate.IsPositive() {
+		supply = annualProvisions.Quo(inflationRate)
+	}
 
-	if inflationRate.LT(TargetInflationRateAsDec()) {
-		return TargetInflationRateAsDec()
+	schedule := []InflationScheduleYear{}
+	for {
+		schedule = append(schedule, InflationScheduleYear{
+			Year:             year,
+			StartTime:        yearStartTime(genesis, year),
+			InflationRate:    inflationRate,
+			AnnualProvisions: annualProvisions,
+		})
+		if inflationRate.LTE(params.TargetInflationRate) {
+			return schedule
+		}
+
+		year++
+		supply = supply.Add(annualProvisions)
+		inflationRate = params.InflationRateForYear(year)
+		annualProvisions = inflationRate.Mul(supply)
 	}
-	return inflationRate
 }
 
 // CalculateBlockProvision returns the total number of coins that should be
@@ -63,11 +92,16 @@ func (m Minter) CalculateBlockProvision(current time.Time, previous time.Time) (
 	return sdk.NewCoin(m.BondDenom, blockProvision.TruncateInt()), nil
 }
 
-// yearsSinceGenesis returns the number of years that have passed between
+// YearsSinceGenesis returns the number of years that have passed between
 // genesis and current (rounded down).
-func yearsSinceGenesis(genesis time.Time, current time.Time) (years int64) {
+func YearsSinceGenesis(genesis time.Time, current time.Time) (years int64) {
 	if current.Before(genesis) {
 		return 0
 	}
 	return current.Sub(genesis).Nanoseconds() / NanosecondsPerYear
 }
+
+// yearStartTime returns the time at which the given year since genesis starts.
+func yearStartTime(genesis time.Time, year uint64) time.Time {
+	return genesis.Add(time.Duration(int64(year) * NanosecondsPerYear))
+}
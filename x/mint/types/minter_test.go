@@ -69,7 +69,7 @@ func TestCalculateInflationRate(t *testing.T) {
 		years := time.Duration(tc.year * NanosecondsPerYear * int64(time.Nanosecond))
 		blockTime := genesisTime.Add(years)
 		ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil).WithBlockTime(blockTime)
-		inflationRate := minter.CalculateInflationRate(ctx, genesisTime)
+		inflationRate := minter.CalculateInflationRate(ctx, genesisTime, DefaultParams())
 		got, err := inflationRate.Float64()
 		assert.NoError(t, err)
 		assert.Equal(t, tc.want, got, "want %v got %v year %v blockTime %v", tc.want, got, tc.year, blockTime)
@@ -81,7 +81,7 @@ func TestCalculateBlockProvision(t *testing.T) {
 	current := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
 	blockInterval := 15 * time.Second
 	totalSupply := sdk.NewDec(1_000_000_000_000)                     // 1 trillion utia
-	annualProvisions := totalSupply.Mul(InitialInflationRateAsDec()) // 80 billion utia
+	annualProvisions := totalSupply.Mul(DefaultInitialInflationRate) // 80 billion utia
 
 	type testCase struct {
 		name             string
@@ -138,7 +138,7 @@ func TestCalculateBlockProvisionError(t *testing.T) {
 	end := current.Add(oneYear)
 
 	totalSupply := sdk.NewDec(1_000_000_000_000)                     // 1 trillion utia
-	annualProvisions := totalSupply.Mul(InitialInflationRateAsDec()) // 80 billion utia
+	annualProvisions := totalSupply.Mul(DefaultInitialInflationRate) // 80 billion utia
 	minter.AnnualProvisions = annualProvisions
 	totalBlockProvisions := sdk.NewDec(0)
 	for current.Before(end) {
@@ -189,11 +189,11 @@ func BenchmarkCalculateInflationRate(b *testing.B) {
 
 	for n := 0; n < b.N; n++ {
 		ctx := sdk.NewContext(nil, tmproto.Header{Height: int64(n)}, false, nil)
-		minter.CalculateInflationRate(ctx, genesisTime)
+		minter.CalculateInflationRate(ctx, genesisTime, DefaultParams())
 	}
 }
 
-func Test_yearsSinceGenesis(t *testing.T) {
+func TestYearsSinceGenesis(t *testing.T) {
 	type testCase struct {
 		name    string
 		current time.Time
@@ -254,7 +254,49 @@ func Test_yearsSinceGenesis(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		got := yearsSinceGenesis(genesis, tc.current)
+		got := YearsSinceGenesis(genesis, tc.current)
 		assert.Equal(t, tc.want, got, tc.name)
 	}
 }
+
+func TestInflationSchedule(t *testing.T) {
+	genesisTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	oneYear := time.Duration(NanosecondsPerYear)
+	supply := sdk.NewDec(1_000_000)
+
+	t.Run("projects the schedule until the target inflation rate", func(t *testing.T) {
+		minter := NewMinter(DefaultInitialInflationRate, DefaultInitialInflationRate.Mul(supply), DefaultBondDenom)
+		schedule := minter.InflationSchedule(genesisTime, genesisTime.Add(oneYear/2), DefaultParams())
+
+		require.Len(t, schedule, 17)
+		for i, year := range schedule {
+			assert.Equal(t, uint64(i), year.Year)
+			assert.Equal(t, genesisTime.Add(time.Duration(i)*oneYear), year.StartTime)
+			assert.Equal(t, DefaultParams().InflationRateForYear(uint64(i)), year.InflationRate)
+		}
+		assert.Equal(t, minter.AnnualProvisions, schedule[0].AnnualProvisions)
+		// the supply of year one includes the provisions of year zero
+		assert.Equal(t, sdk.MustNewDecFromStr("77760"), schedule[1].AnnualProvisions)
+		assert.Equal(t, DefaultTargetInflationRate, schedule[16].InflationRate)
+		assert.True(t, schedule[15].InflationRate.GT(DefaultTargetInflationRate))
+	})
+
+	t.Run("returns a single year once the target inflation rate is reached", func(t *testing.T) {
+		minter := NewMinter(DefaultTargetInflationRate, DefaultTargetInflationRate.Mul(supply), DefaultBondDenom)
+		schedule := minter.InflationSchedule(genesisTime, genesisTime.Add(20*oneYear), DefaultParams())
+
+		require.Len(t, schedule, 1)
+		assert.Equal(t, uint64(20), schedule[0].Year)
+		assert.Equal(t, minter.AnnualProvisions, schedule[0].AnnualProvisions)
+	})
+
+	t.Run("uses the inflation rates of the params", func(t *testing.T) {
+		params := NewParams(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(2, 2))
+		minter := NewMinter(params.InitialInflationRate, params.InitialInflationRate.Mul(supply), DefaultBondDenom)
+		schedule := minter.InflationSchedule(genesisTime, genesisTime, params)
+
+		require.Len(t, schedule, 4)
+		assert.Equal(t, sdk.NewDecWithPrec(25, 3), schedule[2].InflationRate)
+		assert.Equal(t, params.TargetInflationRate, schedule[3].InflationRate)
+	})
+}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyInitialInflationRate     = []byte("InitialInflationRate")
+	DefaultInitialInflationRate = sdk.NewDecWithPrec(8, 2) // 0.08
+	KeyDisinflationRate         = []byte("DisinflationRate")
+	DefaultDisinflationRate     = sdk.NewDecWithPrec(1, 1) // 0.1
+	KeyTargetInflationRate      = []byte("TargetInflationRate")
+	DefaultTargetInflationRate  = sdk.NewDecWithPrec(15, 3) // 0.015
+)
+
+// ParamKeyTable returns the param key table for the mint module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(initialInflationRate, disinflationRate, targetInflationRate sdk.Dec) Params {
+	return Params{
+		InitialInflationRate: initialInflationRate,
+		DisinflationRate:     disinflationRate,
+		TargetInflationRate:  targetInflationRate,
+	}
+}
+
+// DefaultParams returns the default params of the mint module. They are the
+// inflation rates that were hard-coded before app version 2.
+func DefaultParams() Params {
+	return NewParams(DefaultInitialInflationRate, DefaultDisinflationRate, DefaultTargetInflationRate)
+}
+
+// ParamSetPairs gets the list of param key-value pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyInitialInflationRate, &p.InitialInflationRate, validateInitialInflationRate),
+		paramtypes.NewParamSetPair(KeyDisinflationRate, &p.DisinflationRate, validateDisinflationRate),
+		paramtypes.NewParamSetPair(KeyTargetInflationRate, &p.TargetInflationRate, validateTargetInflationRate),
+	}
+}
+
+// WithDefaults returns a copy of the params where the unset params are
+// replaced by their default values. The params are unset on chains that
+// never stored them.
+func (p Params) WithDefaults() Params {
+	if p.InitialInflationRate.IsNil() {
+		p.InitialInflationRate = DefaultInitialInflationRate
+	}
+	if p.DisinflationRate.IsNil() {
+		p.DisinflationRate = DefaultDisinflationRate
+	}
+	if p.TargetInflationRate.IsNil() {
+		p.TargetInflationRate = DefaultTargetInflationRate
+	}
+	return p
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateInitialInflationRate(p.InitialInflationRate); err != nil {
+		return err
+	}
+	if err := validateDisinflationRate(p.DisinflationRate); err != nil {
+		return err
+	}
+	return validateTargetInflationRate(p.TargetInflationRate)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// InflationRateForYear returns the inflation rate for the given number of
+// years since genesis. It decreases by the DisinflationRate every year until
+// it reaches the TargetInflationRate.
+func (p Params) InflationRateForYear(years uint64) sdk.Dec {
+	inflationRate := p.InitialInflationRate.Mul(sdk.OneDec().Sub(p.DisinflationRate).Power(years))
+
+	if inflationRate.LT(p.TargetInflationRate) {
+		return p.TargetInflationRate
+	}
+	return inflationRate
+}
+
+// validateInitialInflationRate validates the InitialInflationRate param.
+func validateInitialInflationRate(v interface{}) error {
+	rate, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if rate.IsNil() || rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return fmt.Errorf("initial inflation rate must be between 0 and 1: %v", rate)
+	}
+
+	return nil
+}
+
+// validateDisinflationRate validates the DisinflationRate param. It must be
+// positive so that the inflation rate eventually reaches the target inflation
+// rate.
+func validateDisinflationRate(v interface{}) error {
+	rate, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if rate.IsNil() || !rate.IsPositive() || rate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("disinflation rate must be greater than 0 and less than 1: %v", rate)
+	}
+
+	return nil
+}
+
+// validateTargetInflationRate validates the TargetInflationRate param.
+func validateTargetInflationRate(v interface{}) error {
+	rate, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if rate.IsNil() || !rate.IsPositive() || rate.GT(sdk.OneDec()) {
+		return fmt.Errorf("target inflation rate must be greater than 0 and at most 1: %v", rate)
+	}
+
+	return nil
+}
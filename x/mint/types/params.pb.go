@@ -0,0 +1,423 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/mint/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the mint module.
+type Params struct {
+	// initial_inflation_rate is the inflation rate that the network starts at.
+	InitialInflationRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=initial_inflation_rate,json=initialInflationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_inflation_rate" yaml:"initial_inflation_rate"`
+	// disinflation_rate is the rate at which the inflation rate decreases each
+	// year.
+	DisinflationRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=disinflation_rate,json=disinflationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"disinflation_rate" yaml:"disinflation_rate"`
+	// target_inflation_rate is the inflation rate that the network aims to
+	// stabilize at. The inflation rate doesn't decrease after reaching it.
+	TargetInflationRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=target_inflation_rate,json=targetInflationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_inflation_rate" yaml:"target_inflation_rate"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ad74936e076812e, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "celestia.mint.v1.Params")
+}
+
+func init() { proto.RegisterFile("celestia/mint/v1/params.proto", fileDescriptor_3ad74936e076812e) }
+
+var fileDescriptor_3ad74936e076812e = []byte{
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x4b, 0xf3, 0x40,
+	0x18, 0xc7, 0x73, 0x6f, 0x5f, 0x0a, 0xde, 0x54, 0x6b, 0x95, 0x5a, 0xec, 0x45, 0x3a, 0x88, 0x4b,
+	0x72, 0x16, 0xb7, 0x8e, 0xa5, 0x8b, 0x4e, 0xd2, 0x49, 0x44, 0x09, 0xd7, 0xf4, 0x8c, 0x87, 0x49,
+	0x2e, 0xe4, 0xce, 0x62, 0x3f, 0x80, 0x7b, 0xc1, 0xc5, 0xd1, 0x0f, 0xe1, 0xee, 0xda, 0xb1, 0x38,
+	0x89, 0x43, 0x90, 0xe4, 0x1b, 0xf8, 0x09, 0xa4, 0x77, 0xa9, 0xd4, 0xd8, 0xa5, 0xd3, 0x3d, 0xc7,
+	0xff, 0xcf, 0x8f, 0x1f, 0x0f, 0x0f, 0x6c, 0xba, 0xd4, 0xa7, 0x42, 0x32, 0x82, 0x03, 0x16, 0x4a,
+	0x3c, 0x6a, 0xe3, 0x88, 0xc4, 0x24, 0x10, 0x76, 0x14, 0x73, 0xc9, 0xab, 0x95, 0x45, 0x6c, 0xcf,
+	0x63, 0x7b, 0xd4, 0x6e, 0xd4, 0x3c, 0xee, 0x71, 0x15, 0xe2, 0xf9, 0xa4, 0x7b, 0x8d, 0x5d, 0x97,
+	0x8b, 0x80, 0x0b, 0x47, 0x07, 0xfa, 0xa3, 0xa3, 0xd6, 0x6b, 0x09, 0x96, 0xcf, 0x14, 0xb3, 0xfa,
+	0x08, 0xe0, 0x0e, 0x0b, 0x99, 0x64, 0xc4, 0x77, 0x58, 0x78, 0xed, 0x13, 0xc9, 0x78, 0xe8, 0xc4,
+	0x44, 0xd2, 0x3a, 0xd8, 0x07, 0x87, 0x1b, 0xdd, 0xab, 0x69, 0x62, 0x1a, 0x1f, 0x89, 0x79, 0xe0,
+	0x31, 0x79, 0x73, 0x37, 0xb0, 0x5d, 0x1e, 0xe4, 0xb0, 0xfc, 0xb1, 0xc4, 0xf0, 0x16, 0xcb, 0x71,
+	0x44, 0x85, 0xdd, 0xa3, 0xee, 0x57, 0x62, 0x36, 0xc7, 0x24, 0xf0, 0x3b, 0xad, 0xd5, 0xd4, 0xd6,
+	0xdb, 0x8b, 0x05, 0x73, 0x99, 0x1e, 0x75, 0xfb, 0xb5, 0xbc, 0x76, 0xb2, 0x68, 0xf5, 0x89, 0xa4,
+	0xd5, 0x07, 0x00, 0x37, 0x87, 0x4c, 0x14, 0x84, 0xfe, 0x29, 0xa1, 0xf3, 0xb5, 0x85, 0xea, 0x5a,
+	0xe8, 0x0f, 0xb0, 0xe8, 0x52, 0x59, 0x6e, 0x28, 0x8f, 0x09, 0x80, 0xdb, 0x92, 0xc4, 0x1e, 0x95,
+	0xc5, 0xe5, 0x94, 0x94, 0xcb, 0xe5, 0xda, 0x2e, 0x7b, 0xda, 0x65, 0x25, 0xb4, 0xe8, 0xb3, 0xa5,
+	0x5b, 0xbf, 0x56, 0xd3, 0xf9, 0xff, 0xf4, 0x6c, 0x1a, 0xdd, 0xd3, 0x69, 0x8a, 0xc0, 0x2c, 0x45,
+	0xe0, 0x33, 0x45, 0x60, 0x92, 0x21, 0x63, 0x96, 0x21, 0xe3, 0x3d, 0x43, 0xc6, 0xc5, 0xd1, 0xb2,
+	0x4a, 0x7e, 0x29, 0x3c, 0xf6, 0x7e, 0x66, 0x8b, 0x44, 0x11, 0xbe, 0xd7, 0xa7, 0xa5, 0xc4, 0x06,
+	0x65, 0x75, 0x14, 0xc7, 0xdf, 0x03, 0x00, 0x46, 0xea, 0xb7, 0x41, 0x78, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TargetInflationRate.Size()
+		i -= size
+		if _, err := m.TargetInflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DisinflationRate.Size()
+		i -= size
+		if _, err := m.DisinflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InitialInflationRate.Size()
+		i -= size
+		if _, err := m.InitialInflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialInflationRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DisinflationRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TargetInflationRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialInflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialInflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisinflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisinflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetInflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetInflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
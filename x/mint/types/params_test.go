@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  Params
+		wantErr bool
+	}{
+		{
+			name:   "default params",
+			params: DefaultParams(),
+		},
+		{
+			name:   "zero initial inflation rate",
+			params: NewParams(sdk.ZeroDec(), DefaultDisinflationRate, DefaultTargetInflationRate),
+		},
+		{
+			name:    "negative initial inflation rate",
+			params:  NewParams(sdk.NewDec(-1), DefaultDisinflationRate, DefaultTargetInflationRate),
+			wantErr: true,
+		},
+		{
+			name:    "initial inflation rate greater than one",
+			params:  NewParams(sdk.NewDec(2), DefaultDisinflationRate, DefaultTargetInflationRate),
+			wantErr: true,
+		},
+		{
+			name:    "zero disinflation rate",
+			params:  NewParams(DefaultInitialInflationRate, sdk.ZeroDec(), DefaultTargetInflationRate),
+			wantErr: true,
+		},
+		{
+			name:    "disinflation rate of one",
+			params:  NewParams(DefaultInitialInflationRate, sdk.OneDec(), DefaultTargetInflationRate),
+			wantErr: true,
+		},
+		{
+			name:    "zero target inflation rate",
+			params:  NewParams(DefaultInitialInflationRate, DefaultDisinflationRate, sdk.ZeroDec()),
+			wantErr: true,
+		},
+		{
+			name:    "nil target inflation rate",
+			params:  Params{InitialInflationRate: DefaultInitialInflationRate, DisinflationRate: DefaultDisinflationRate},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsWithDefaults(t *testing.T) {
+	assert.Equal(t, DefaultParams(), Params{}.WithDefaults())
+
+	params := Params{DisinflationRate: sdk.NewDecWithPrec(2, 1)}.WithDefaults()
+	assert.Equal(t, NewParams(DefaultInitialInflationRate, sdk.NewDecWithPrec(2, 1), DefaultTargetInflationRate), params)
+}
+
+func TestInflationRateForYear(t *testing.T) {
+	params := NewParams(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(2, 2))
+
+	assert.Equal(t, sdk.NewDecWithPrec(1, 1), params.InflationRateForYear(0))
+	assert.Equal(t, sdk.NewDecWithPrec(5, 2), params.InflationRateForYear(1))
+	assert.Equal(t, sdk.NewDecWithPrec(25, 3), params.InflationRateForYear(2))
+	// 0.0125 is below the target inflation rate
+	assert.Equal(t, sdk.NewDecWithPrec(2, 2), params.InflationRateForYear(3))
+	assert.Equal(t, sdk.NewDecWithPrec(2, 2), params.InflationRateForYear(100))
+}
@@ -275,6 +275,330 @@ func (m *QueryGenesisTimeResponse) GetGenesisTime() *time.Time {
 	return nil
 }
 
+// QueryInflationScheduleRequest is the request type for the
+// Query/InflationSchedule RPC method.
+type QueryInflationScheduleRequest struct {
+}
+
+func (m *QueryInflationScheduleRequest) Reset()         { *m = QueryInflationScheduleRequest{} }
+func (m *QueryInflationScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationScheduleRequest) ProtoMessage()    {}
+func (*QueryInflationScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{6}
+}
+func (m *QueryInflationScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationScheduleRequest.Merge(m, src)
+}
+func (m *QueryInflationScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationScheduleRequest proto.InternalMessageInfo
+
+// QueryInflationScheduleResponse is the response type for the
+// Query/InflationSchedule RPC method.
+type QueryInflationScheduleResponse struct {
+	// Years are the projected years of the inflation schedule, starting at the
+	// current year and ending at the first year with the target inflation rate.
+	Years []InflationScheduleYear `protobuf:"bytes,1,rep,name=years,proto3" json:"years"`
+}
+
+func (m *QueryInflationScheduleResponse) Reset()         { *m = QueryInflationScheduleResponse{} }
+func (m *QueryInflationScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationScheduleResponse) ProtoMessage()    {}
+func (*QueryInflationScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{7}
+}
+func (m *QueryInflationScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationScheduleResponse.Merge(m, src)
+}
+func (m *QueryInflationScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryInflationScheduleResponse) GetYears() []InflationScheduleYear {
+	if m != nil {
+		return m.Years
+	}
+	return nil
+}
+
+// InflationScheduleYear is a projected year of the inflation schedule.
+type InflationScheduleYear struct {
+	// Year is the number of years since genesis.
+	Year uint64 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// StartTime is the time at which the year starts.
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// InflationRate is the inflation rate of the year.
+	InflationRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation_rate,json=inflationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate"`
+	// AnnualProvisions is the projected number of tokens minted during the
+	// year.
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+}
+
+func (m *InflationScheduleYear) Reset()         { *m = InflationScheduleYear{} }
+func (m *InflationScheduleYear) String() string { return proto.CompactTextString(m) }
+func (*InflationScheduleYear) ProtoMessage()    {}
+func (*InflationScheduleYear) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{8}
+}
+func (m *InflationScheduleYear) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationScheduleYear) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationScheduleYear.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationScheduleYear) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationScheduleYear.Merge(m, src)
+}
+func (m *InflationScheduleYear) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationScheduleYear) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationScheduleYear.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationScheduleYear proto.InternalMessageInfo
+
+func (m *InflationScheduleYear) GetYear() uint64 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *InflationScheduleYear) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+// QueryMintedSupplyRequest is the request type for the Query/MintedSupply RPC
+// method.
+type QueryMintedSupplyRequest struct {
+}
+
+func (m *QueryMintedSupplyRequest) Reset()         { *m = QueryMintedSupplyRequest{} }
+func (m *QueryMintedSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintedSupplyRequest) ProtoMessage()    {}
+func (*QueryMintedSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{9}
+}
+func (m *QueryMintedSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintedSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintedSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintedSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintedSupplyRequest.Merge(m, src)
+}
+func (m *QueryMintedSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintedSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintedSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintedSupplyRequest proto.InternalMessageInfo
+
+// QueryMintedSupplyResponse is the response type for the Query/MintedSupply
+// RPC method.
+type QueryMintedSupplyResponse struct {
+	// MintedSupply is the total number of tokens minted due to inflation.
+	MintedSupply MintedSupply `protobuf:"bytes,1,opt,name=minted_supply,json=mintedSupply,proto3" json:"minted_supply"`
+	// YearlyMintedSupply is the number of tokens minted during each year of the
+	// inflation schedule.
+	YearlyMintedSupply []YearlyMintedSupply `protobuf:"bytes,2,rep,name=yearly_minted_supply,json=yearlyMintedSupply,proto3" json:"yearly_minted_supply"`
+}
+
+func (m *QueryMintedSupplyResponse) Reset()         { *m = QueryMintedSupplyResponse{} }
+func (m *QueryMintedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintedSupplyResponse) ProtoMessage()    {}
+func (*QueryMintedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{10}
+}
+func (m *QueryMintedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintedSupplyResponse.Merge(m, src)
+}
+func (m *QueryMintedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintedSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintedSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryMintedSupplyResponse) GetMintedSupply() MintedSupply {
+	if m != nil {
+		return m.MintedSupply
+	}
+	return MintedSupply{}
+}
+
+func (m *QueryMintedSupplyResponse) GetYearlyMintedSupply() []YearlyMintedSupply {
+	if m != nil {
+		return m.YearlyMintedSupply
+	}
+	return nil
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{11}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// Params are the params of the mint module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{12}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryInflationRateRequest)(nil), "celestia.mint.v1.QueryInflationRateRequest")
 	proto.RegisterType((*QueryInflationRateResponse)(nil), "celestia.mint.v1.QueryInflationRateResponse")
@@ -282,44 +606,69 @@ func init() {
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "celestia.mint.v1.QueryAnnualProvisionsResponse")
 	proto.RegisterType((*QueryGenesisTimeRequest)(nil), "celestia.mint.v1.QueryGenesisTimeRequest")
 	proto.RegisterType((*QueryGenesisTimeResponse)(nil), "celestia.mint.v1.QueryGenesisTimeResponse")
+	proto.RegisterType((*QueryInflationScheduleRequest)(nil), "celestia.mint.v1.QueryInflationScheduleRequest")
+	proto.RegisterType((*QueryInflationScheduleResponse)(nil), "celestia.mint.v1.QueryInflationScheduleResponse")
+	proto.RegisterType((*InflationScheduleYear)(nil), "celestia.mint.v1.InflationScheduleYear")
+	proto.RegisterType((*QueryMintedSupplyRequest)(nil), "celestia.mint.v1.QueryMintedSupplyRequest")
+	proto.RegisterType((*QueryMintedSupplyResponse)(nil), "celestia.mint.v1.QueryMintedSupplyResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.mint.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.mint.v1.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("celestia/mint/v1/query.proto", fileDescriptor_a1ed5b0ae449a133) }
 
 var fileDescriptor_a1ed5b0ae449a133 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4d, 0x6e, 0x13, 0x31,
-	0x1c, 0xc5, 0x63, 0xbe, 0x16, 0x4e, 0x8b, 0x82, 0x85, 0x44, 0x3b, 0x0d, 0x13, 0x98, 0x8a, 0xaa,
-	0xa5, 0xd4, 0x26, 0xe5, 0x04, 0x04, 0x24, 0x04, 0x2b, 0x88, 0xca, 0x06, 0x16, 0x91, 0x13, 0x5c,
-	0x63, 0x91, 0xb1, 0xdd, 0xb1, 0x27, 0xa2, 0x12, 0x2b, 0x2e, 0x40, 0x25, 0x16, 0x1c, 0x80, 0xcb,
-	0x74, 0x59, 0x89, 0x0d, 0xb0, 0x28, 0x28, 0xe1, 0x20, 0x68, 0x3c, 0x9e, 0x90, 0xaf, 0x91, 0x22,
-	0x56, 0x71, 0xe6, 0xfd, 0xe7, 0xff, 0x7e, 0xe3, 0xf7, 0x60, 0xbd, 0xc7, 0xfa, 0xcc, 0x58, 0x41,
-	0x49, 0x2c, 0xa4, 0x25, 0x83, 0x26, 0x39, 0x4a, 0x59, 0x72, 0x8c, 0x75, 0xa2, 0xac, 0x42, 0xb5,
-	0x42, 0xc5, 0x99, 0x8a, 0x07, 0xcd, 0xe0, 0x3a, 0x57, 0x5c, 0x39, 0x91, 0x64, 0xa7, 0x7c, 0x2e,
-	0xa8, 0x73, 0xa5, 0x78, 0x9f, 0x11, 0xaa, 0x05, 0xa1, 0x52, 0x2a, 0x4b, 0xad, 0x50, 0xd2, 0x78,
-	0x75, 0x63, 0xce, 0xc3, 0x6d, 0xcb, 0xc5, 0x86, 0x7f, 0xd5, 0xfd, 0xeb, 0xa6, 0x87, 0xc4, 0x8a,
-	0x98, 0x19, 0x4b, 0x63, 0x9d, 0x0f, 0x44, 0x1b, 0x70, 0xfd, 0x45, 0x86, 0xf4, 0x54, 0x1e, 0xf6,
-	0xdd, 0xda, 0x36, 0xb5, 0xac, 0xcd, 0x8e, 0x52, 0x66, 0x6c, 0x64, 0x60, 0xb0, 0x48, 0x34, 0x5a,
-	0x49, 0xc3, 0xd0, 0x4b, 0x78, 0x55, 0x14, 0x42, 0x27, 0xa1, 0x96, 0xad, 0x81, 0x5b, 0x60, 0x7b,
-	0xa5, 0x85, 0x4f, 0xcf, 0x1b, 0x95, 0x9f, 0xe7, 0x8d, 0x2d, 0x2e, 0xec, 0xdb, 0xb4, 0x8b, 0x7b,
-	0x2a, 0x26, 0x3d, 0x65, 0x62, 0x65, 0xfc, 0xcf, 0x9e, 0x79, 0xf3, 0x8e, 0xd8, 0x63, 0xcd, 0x0c,
-	0x7e, 0xcc, 0x7a, 0xed, 0x55, 0x31, 0xb9, 0x3e, 0x0a, 0x61, 0xdd, 0x99, 0x3e, 0x94, 0x32, 0xa5,
-	0xfd, 0xe7, 0x89, 0x1a, 0x08, 0x93, 0x7d, 0x6e, 0x01, 0xf5, 0x01, 0xde, 0x2c, 0xd1, 0x3d, 0xd7,
-	0x6b, 0x78, 0x8d, 0x3a, 0xad, 0xa3, 0xc7, 0xe2, 0x7f, 0xa2, 0xd5, 0xe8, 0x8c, 0x49, 0xb4, 0x0e,
-	0x6f, 0x38, 0xf7, 0x27, 0x4c, 0x32, 0x23, 0xcc, 0x81, 0x88, 0xc7, 0xb7, 0xd5, 0x81, 0x6b, 0xf3,
-	0x92, 0x67, 0x7a, 0x04, 0x57, 0x78, 0xfe, 0xb8, 0x93, 0x25, 0xe0, 0x70, 0xaa, 0xfb, 0x01, 0xce,
-	0xe3, 0xc1, 0x45, 0x3c, 0xf8, 0xa0, 0x88, 0xa7, 0x75, 0xe9, 0xe4, 0x57, 0x03, 0xb4, 0xab, 0xfc,
-	0xdf, 0xb2, 0xfd, 0x1f, 0x17, 0xe1, 0x65, 0xe7, 0x80, 0xbe, 0x00, 0xb8, 0x3a, 0x15, 0x0a, 0xda,
-	0xc5, 0xb3, 0x65, 0xc2, 0xa5, 0xb9, 0x06, 0xf7, 0x96, 0x1b, 0xce, 0xd9, 0xa3, 0xdd, 0x8f, 0xdf,
-	0xfe, 0x7c, 0xbe, 0x70, 0x07, 0x6d, 0x16, 0x57, 0xe5, 0x7b, 0xd6, 0x65, 0x96, 0x36, 0xc9, 0x74,
-	0x05, 0xd0, 0x57, 0x00, 0x6b, 0xb3, 0xc9, 0x20, 0x5c, 0xe2, 0x57, 0x12, 0x71, 0x40, 0x96, 0x9e,
-	0xf7, 0x88, 0xd8, 0x21, 0x6e, 0xa3, 0xad, 0x85, 0x88, 0x73, 0x6d, 0x40, 0x9f, 0x00, 0xac, 0x4e,
-	0xc4, 0x84, 0x76, 0x4a, 0x0c, 0xe7, 0x53, 0x0e, 0xee, 0x2e, 0x33, 0xea, 0xb1, 0x76, 0x1c, 0xd6,
-	0x26, 0xba, 0xbd, 0x10, 0x6b, 0xb2, 0x10, 0xad, 0x67, 0xa7, 0xc3, 0x10, 0x9c, 0x0d, 0x43, 0xf0,
-	0x7b, 0x18, 0x82, 0x93, 0x51, 0x58, 0x39, 0x1b, 0x85, 0x95, 0xef, 0xa3, 0xb0, 0xf2, 0xea, 0xfe,
-	0x64, 0x57, 0xbd, 0xb5, 0x4a, 0xf8, 0xf8, 0xbc, 0x47, 0xb5, 0x26, 0xef, 0xf3, 0xd5, 0xae, 0xb9,
-	0xdd, 0x2b, 0xae, 0x4e, 0x0f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xb5, 0x7b, 0x2f, 0x7e,
-	0x04, 0x00, 0x00,
+	// 797 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x4e, 0x13, 0x4d,
+	0x18, 0xee, 0x96, 0xc2, 0xf7, 0x7d, 0x6f, 0xcb, 0x17, 0x18, 0x31, 0xc2, 0x42, 0xb7, 0xba, 0xfc,
+	0x83, 0xec, 0x02, 0x26, 0x9e, 0x5b, 0x4c, 0x0c, 0x26, 0x24, 0x58, 0xf0, 0x00, 0x35, 0x69, 0xa6,
+	0x65, 0x58, 0x36, 0x76, 0x7f, 0xd8, 0x99, 0x12, 0x6b, 0x3c, 0xf2, 0x06, 0x24, 0x21, 0xc6, 0x0b,
+	0xd0, 0xc4, 0x1b, 0xf1, 0x80, 0x43, 0x12, 0x4f, 0x8c, 0x07, 0x68, 0xc0, 0x0b, 0x31, 0x3b, 0x3b,
+	0x5b, 0xbb, 0xdd, 0xdd, 0xb4, 0x31, 0x1c, 0xb1, 0xcc, 0xf3, 0xfe, 0x3c, 0xef, 0x3c, 0xef, 0x3c,
+	0x85, 0xa9, 0x3a, 0x69, 0x10, 0xca, 0x4c, 0xac, 0x5b, 0xa6, 0xcd, 0xf4, 0xe3, 0x35, 0xfd, 0xa8,
+	0x49, 0xbc, 0x96, 0xe6, 0x7a, 0x0e, 0x73, 0xd0, 0x48, 0x88, 0x6a, 0x3e, 0xaa, 0x1d, 0xaf, 0xc9,
+	0x63, 0x86, 0x63, 0x38, 0x1c, 0xd4, 0xfd, 0xaf, 0x20, 0x4e, 0x9e, 0x32, 0x1c, 0xc7, 0x68, 0x10,
+	0x1d, 0xbb, 0xa6, 0x8e, 0x6d, 0xdb, 0x61, 0x98, 0x99, 0x8e, 0x4d, 0x05, 0x3a, 0x19, 0xeb, 0xc1,
+	0xab, 0x05, 0x60, 0x31, 0x06, 0xba, 0xd8, 0xc3, 0x56, 0x98, 0x5b, 0x12, 0x95, 0xf9, 0x7f, 0xb5,
+	0xe6, 0x81, 0xce, 0x4c, 0x8b, 0x50, 0x86, 0x2d, 0x37, 0x08, 0x50, 0x27, 0x61, 0xe2, 0x89, 0xcf,
+	0x78, 0xd3, 0x3e, 0x68, 0xf0, 0xae, 0x15, 0xcc, 0x48, 0x85, 0x1c, 0x35, 0x09, 0x65, 0x2a, 0x05,
+	0x39, 0x09, 0xa4, 0xae, 0x63, 0x53, 0x82, 0x9e, 0xc2, 0xff, 0x66, 0x08, 0x54, 0x3d, 0xcc, 0xc8,
+	0xb8, 0x74, 0x5b, 0x5a, 0x28, 0x94, 0xb5, 0xb3, 0x8b, 0x52, 0xe6, 0xfb, 0x45, 0x69, 0xce, 0x30,
+	0xd9, 0x61, 0xb3, 0xa6, 0xd5, 0x1d, 0x4b, 0xaf, 0x3b, 0xd4, 0x72, 0xa8, 0xf8, 0xb3, 0x42, 0xf7,
+	0x5f, 0xea, 0xac, 0xe5, 0x12, 0xaa, 0x3d, 0x24, 0xf5, 0xca, 0xb0, 0xd9, 0x59, 0x5e, 0x55, 0x60,
+	0x8a, 0x37, 0x7d, 0x60, 0xdb, 0x4d, 0xdc, 0xd8, 0xf6, 0x9c, 0x63, 0x93, 0xfa, 0xb7, 0x11, 0x92,
+	0x7a, 0x03, 0xc5, 0x14, 0x5c, 0xf0, 0x7a, 0x0e, 0xa3, 0x98, 0x63, 0x55, 0xb7, 0x0d, 0xfe, 0x25,
+	0xb5, 0x11, 0xdc, 0xd5, 0x44, 0x9d, 0x80, 0x5b, 0xbc, 0xfb, 0x23, 0x62, 0x13, 0x6a, 0xd2, 0x5d,
+	0xd3, 0x6a, 0xdf, 0x56, 0x15, 0xc6, 0xe3, 0x90, 0xe0, 0xb4, 0x01, 0x05, 0x23, 0x38, 0xae, 0xfa,
+	0x0a, 0x70, 0x3a, 0xf9, 0x75, 0x59, 0x0b, 0xe4, 0xd1, 0x42, 0x79, 0xb4, 0xdd, 0x50, 0x9e, 0x72,
+	0xee, 0xe4, 0x47, 0x49, 0xaa, 0xe4, 0x8d, 0x3f, 0xc5, 0xd4, 0x12, 0x14, 0xa3, 0x72, 0xec, 0xd4,
+	0x0f, 0xc9, 0x7e, 0xb3, 0xd1, 0x66, 0x40, 0x40, 0x49, 0x0b, 0x68, 0xf3, 0x18, 0x6c, 0x11, 0xec,
+	0xf9, 0xf7, 0x31, 0xb0, 0x90, 0x5f, 0x9f, 0xd7, 0xba, 0x37, 0x54, 0x8b, 0xe5, 0xee, 0x11, 0xec,
+	0x95, 0x73, 0xfe, 0xc5, 0x55, 0x82, 0x5c, 0xf5, 0x53, 0x16, 0x6e, 0x26, 0x86, 0x21, 0x04, 0x39,
+	0x3f, 0x84, 0x8f, 0x97, 0xab, 0xf0, 0x6f, 0xb4, 0x01, 0x40, 0x19, 0xf6, 0x58, 0x30, 0x78, 0xb6,
+	0xe7, 0xe0, 0xff, 0xfa, 0xad, 0xf8, 0xf0, 0xff, 0xf1, 0x3c, 0x1f, 0x49, 0xd8, 0xb5, 0x81, 0x6b,
+	0xd8, 0xb5, 0xe4, 0x55, 0xc9, 0x5d, 0xd3, 0xaa, 0xc8, 0x62, 0x1f, 0xb6, 0x4c, 0x9b, 0x91, 0xfd,
+	0x9d, 0xa6, 0xeb, 0x36, 0x5a, 0xa1, 0x52, 0x5f, 0x24, 0x98, 0x48, 0x00, 0x85, 0x4a, 0x9b, 0x30,
+	0x6c, 0xf1, 0xf3, 0x2a, 0xe5, 0x80, 0x58, 0x17, 0x25, 0xae, 0x56, 0x67, 0xba, 0x10, 0xa9, 0x60,
+	0x75, 0x9c, 0xa1, 0x17, 0x30, 0xe6, 0xab, 0xd0, 0x68, 0x55, 0xa3, 0x15, 0xb3, 0x5c, 0xff, 0x99,
+	0x78, 0xc5, 0x3d, 0x1e, 0x9d, 0x50, 0x17, 0xb5, 0x62, 0x88, 0x3a, 0x06, 0x88, 0x4f, 0xb1, 0xcd,
+	0x3d, 0x27, 0x1c, 0x6e, 0x0b, 0x6e, 0x44, 0x4e, 0xc5, 0x54, 0xf7, 0x61, 0x28, 0xf0, 0x26, 0x31,
+	0xce, 0x78, 0xbc, 0x79, 0x90, 0x21, 0x1a, 0x8a, 0xe8, 0xf5, 0xf7, 0xff, 0xc0, 0x20, 0xaf, 0x87,
+	0x3e, 0x48, 0x30, 0x1c, 0xf1, 0x22, 0xb4, 0x1c, 0xaf, 0x91, 0x6a, 0x67, 0xf2, 0xdd, 0xfe, 0x82,
+	0x03, 0xba, 0xea, 0xf2, 0xdb, 0xaf, 0xbf, 0x4e, 0xb3, 0xb3, 0x68, 0x3a, 0x94, 0x5d, 0x18, 0x6c,
+	0x8d, 0x30, 0xbc, 0xa6, 0x47, 0xb7, 0x11, 0x7d, 0x94, 0x60, 0xa4, 0xdb, 0x90, 0x90, 0x96, 0xd2,
+	0x2f, 0xc5, 0xd9, 0x64, 0xbd, 0xef, 0x78, 0x41, 0x51, 0xe3, 0x14, 0x17, 0xd0, 0x5c, 0x22, 0xc5,
+	0xd8, 0x66, 0xa3, 0x77, 0x12, 0xe4, 0x3b, 0xdc, 0x09, 0x2d, 0xa6, 0x34, 0x8c, 0x9b, 0x9b, 0xbc,
+	0xd4, 0x4f, 0xa8, 0xa0, 0xb5, 0xc8, 0x69, 0x4d, 0xa3, 0x3b, 0x89, 0xb4, 0x3a, 0x7d, 0x10, 0x7d,
+	0x96, 0x60, 0x34, 0x66, 0x25, 0x48, 0xef, 0x25, 0x54, 0x97, 0xf1, 0xc9, 0xab, 0xfd, 0x27, 0x08,
+	0x8e, 0x3a, 0xe7, 0xb8, 0x88, 0xe6, 0x7b, 0xa8, 0x4b, 0x43, 0x4e, 0xa7, 0x12, 0x14, 0x3a, 0x77,
+	0x1f, 0xa5, 0xdd, 0x48, 0xc2, 0x73, 0x97, 0x97, 0xfb, 0x8a, 0x15, 0xd4, 0x96, 0x38, 0xb5, 0x19,
+	0xa4, 0x26, 0x52, 0x8b, 0x3c, 0x63, 0xf4, 0x1a, 0x86, 0x82, 0x37, 0x83, 0x66, 0x52, 0x5a, 0x44,
+	0x9e, 0xa6, 0x3c, 0xdb, 0x23, 0x4a, 0x50, 0x98, 0xe6, 0x14, 0x8a, 0x68, 0x32, 0x91, 0x42, 0xf0,
+	0x2e, 0xcb, 0x8f, 0xcf, 0x2e, 0x15, 0xe9, 0xfc, 0x52, 0x91, 0x7e, 0x5e, 0x2a, 0xd2, 0xc9, 0x95,
+	0x92, 0x39, 0xbf, 0x52, 0x32, 0xdf, 0xae, 0x94, 0xcc, 0xb3, 0xd5, 0x4e, 0xcf, 0x14, 0xfd, 0x1c,
+	0xcf, 0x68, 0x7f, 0xaf, 0x60, 0xd7, 0xd5, 0x5f, 0x05, 0x45, 0xb9, 0x83, 0xd6, 0x86, 0xf8, 0x0f,
+	0xc1, 0xbd, 0xdf, 0x03, 0x00, 0xa4, 0x5a, 0xcd, 0xd0, 0x50, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -340,6 +689,13 @@ type QueryClient interface {
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
 	// GenesisTime returns the genesis time.
 	GenesisTime(ctx context.Context, in *QueryGenesisTimeRequest, opts ...grpc.CallOption) (*QueryGenesisTimeResponse, error)
+	// InflationSchedule returns the projected inflation schedule from the
+	// current year until the target inflation rate is reached.
+	InflationSchedule(ctx context.Context, in *QueryInflationScheduleRequest, opts ...grpc.CallOption) (*QueryInflationScheduleResponse, error)
+	// MintedSupply returns the number of tokens minted due to inflation.
+	MintedSupply(ctx context.Context, in *QueryMintedSupplyRequest, opts ...grpc.CallOption) (*QueryMintedSupplyResponse, error)
+	// Params returns the params of the mint module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -377,6 +733,33 @@ func (c *queryClient) GenesisTime(ctx context.Context, in *QueryGenesisTimeReque
 	return out, nil
 }
 
+func (c *queryClient) InflationSchedule(ctx context.Context, in *QueryInflationScheduleRequest, opts ...grpc.CallOption) (*QueryInflationScheduleResponse, error) {
+	out := new(QueryInflationScheduleResponse)
+	err := c.cc.Invoke(ctx, "/celestia.mint.v1.Query/InflationSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintedSupply(ctx context.Context, in *QueryMintedSupplyRequest, opts ...grpc.CallOption) (*QueryMintedSupplyResponse, error) {
+	out := new(QueryMintedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/celestia.mint.v1.Query/MintedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.mint.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// InflationRate returns the current inflation rate.
@@ -385,6 +768,13 @@ type QueryServer interface {
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
 	// GenesisTime returns the genesis time.
 	GenesisTime(context.Context, *QueryGenesisTimeRequest) (*QueryGenesisTimeResponse, error)
+	// InflationSchedule returns the projected inflation schedule from the
+	// current year until the target inflation rate is reached.
+	InflationSchedule(context.Context, *QueryInflationScheduleRequest) (*QueryInflationScheduleResponse, error)
+	// MintedSupply returns the number of tokens minted due to inflation.
+	MintedSupply(context.Context, *QueryMintedSupplyRequest) (*QueryMintedSupplyResponse, error)
+	// Params returns the params of the mint module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -400,6 +790,15 @@ func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) GenesisTime(ctx context.Context, req *QueryGenesisTimeRequest) (*QueryGenesisTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenesisTime not implemented")
 }
+func (*UnimplementedQueryServer) InflationSchedule(ctx context.Context, req *QueryInflationScheduleRequest) (*QueryInflationScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationSchedule not implemented")
+}
+func (*UnimplementedQueryServer) MintedSupply(ctx context.Context, req *QueryMintedSupplyRequest) (*QueryMintedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintedSupply not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -459,6 +858,60 @@ func _Query_GenesisTime_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InflationSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.mint.v1.Query/InflationSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationSchedule(ctx, req.(*QueryInflationScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.mint.v1.Query/MintedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintedSupply(ctx, req.(*QueryMintedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.mint.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.mint.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -475,6 +928,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GenesisTime",
 			Handler:    _Query_GenesisTime_Handler,
 		},
+		{
+			MethodName: "InflationSchedule",
+			Handler:    _Query_InflationSchedule_Handler,
+		},
+		{
+			MethodName: "MintedSupply",
+			Handler:    _Query_MintedSupply_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/mint/v1/query.proto",
@@ -648,16 +1113,258 @@ func (m *QueryGenesisTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryInflationScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Years) > 0 {
+		for iNdEx := len(m.Years) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Years[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationScheduleYear) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationScheduleYear) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationScheduleYear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Year != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Year))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.YearlyMintedSupply) > 0 {
+		for iNdEx := len(m.YearlyMintedSupply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.YearlyMintedSupply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.MintedSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *QueryInflationRateRequest) Size() (n int) {
 	if m == nil {
@@ -692,42 +1399,582 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGenesisTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGenesisTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GenesisTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.GenesisTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInflationScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Years) > 0 {
+		for _, e := range m.Years {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InflationScheduleYear) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Year != 0 {
+		n += 1 + sovQuery(uint64(m.Year))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMintedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.YearlyMintedSupply) > 0 {
+		for _, e := range m.YearlyMintedSupply {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGenesisTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGenesisTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGenesisTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGenesisTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGenesisTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGenesisTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GenesisTime == nil {
+				m.GenesisTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.GenesisTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryGenesisTimeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryGenesisTimeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GenesisTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.GenesisTime)
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryInflationScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -750,12 +1997,46 @@ func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Years", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Years = append(m.Years, InflationScheduleYear{})
+			if err := m.Years[len(m.Years)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -777,7 +2058,7 @@ func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
+func (m *InflationScheduleYear) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -800,13 +2081,65 @@ func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InflationScheduleYear: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InflationScheduleYear: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
+			}
+			m.Year = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Year |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
 			}
@@ -839,6 +2172,39 @@ func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -860,7 +2226,7 @@ func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintedSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -883,10 +2249,10 @@ func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintedSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -910,7 +2276,7 @@ func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintedSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -933,17 +2299,17 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintedSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -953,22 +2319,56 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YearlyMintedSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YearlyMintedSupply = append(m.YearlyMintedSupply, YearlyMintedSupply{})
+			if err := m.YearlyMintedSupply[len(m.YearlyMintedSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -993,7 +2393,7 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGenesisTimeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1016,10 +2416,10 @@ func (m *QueryGenesisTimeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGenesisTimeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGenesisTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1043,7 +2443,7 @@ func (m *QueryGenesisTimeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGenesisTimeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1066,15 +2466,15 @@ func (m *QueryGenesisTimeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGenesisTimeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGenesisTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1101,10 +2501,7 @@ func (m *QueryGenesisTimeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GenesisTime == nil {
-				m.GenesisTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.GenesisTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
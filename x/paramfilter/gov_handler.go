@@ -12,9 +12,38 @@ import (
 )
 
 // ParamBlockList keeps track of parameters that cannot be changed by governance
-// proposals
+// proposals, of the bounds of the parameters that can only be changed within
+// them and of the app versions from which parameters can be changed.
 type ParamBlockList struct {
-	params map[string]bool
+	params         map[string]bool
+	bounds         map[string]ParamBound
+	minAppVersions map[string]uint64
+}
+
+// ParamBound bounds the values that governance proposals can set a sdk.Dec
+// parameter to.
+type ParamBound struct {
+	Subspace string
+	Key      string
+	// Min and Max are the inclusive bounds of the parameter.
+	Min sdk.Dec
+	Max sdk.Dec
+	// MaxChange is the maximum absolute difference between the current value
+	// of the parameter and the value that a proposal sets it to.
+	MaxChange sdk.Dec
+	// Default is the current value of the parameter if it isn't stored.
+	Default sdk.Dec
+}
+
+// VersionedParam is a parameter that governance proposals can only change from
+// an app version on, e.g. because the binaries that only support earlier app
+// versions don't know about it.
+type VersionedParam struct {
+	Subspace string
+	Key      string
+	// MinAppVersion is the first app version in which the parameter can be
+	// changed.
+	MinAppVersion uint64
 }
 
 // NewParamBlockList creates a new ParamBlockList that can be used to block gov
@@ -24,7 +53,33 @@ func NewParamBlockList(blockedParams ...[2]string) ParamBlockList {
 	for _, param := range blockedParams {
 		consolidatedParams[fmt.Sprintf("%s-%s", param[0], param[1])] = true
 	}
-	return ParamBlockList{params: consolidatedParams}
+	return ParamBlockList{params: consolidatedParams, bounds: map[string]ParamBound{}, minAppVersions: map[string]uint64{}}
+}
+
+// WithBounds returns a copy of the ParamBlockList that also rejects
+// proposals that set the given parameters out of their bounds.
+func (pbl ParamBlockList) WithBounds(bounds ...ParamBound) ParamBlockList {
+	consolidatedBounds := make(map[string]ParamBound, len(pbl.bounds)+len(bounds))
+	for key, bound := range pbl.bounds {
+		consolidatedBounds[key] = bound
+	}
+	for _, bound := range bounds {
+		consolidatedBounds[fmt.Sprintf("%s-%s", bound.Subspace, bound.Key)] = bound
+	}
+	return ParamBlockList{params: pbl.params, bounds: consolidatedBounds, minAppVersions: pbl.minAppVersions}
+}
+
+// WithMinAppVersions returns a copy of the ParamBlockList that also rejects
+// proposals that change the given parameters before their min app version.
+func (pbl ParamBlockList) WithMinAppVersions(params ...VersionedParam) ParamBlockList {
+	consolidatedVersions := make(map[string]uint64, len(pbl.minAppVersions)+len(params))
+	for key, version := range pbl.minAppVersions {
+		consolidatedVersions[key] = version
+	}
+	for _, param := range params {
+		consolidatedVersions[fmt.Sprintf("%s-%s", param.Subspace, param.Key)] = param.MinAppVersion
+	}
+	return ParamBlockList{params: pbl.params, bounds: pbl.bounds, minAppVersions: consolidatedVersions}
 }
 
 // IsBlocked returns true if the given parameter is blocked.
@@ -32,6 +87,22 @@ func (pbl ParamBlockList) IsBlocked(subspace string, key string) bool {
 	return pbl.params[fmt.Sprintf("%s-%s", subspace, key)]
 }
 
+// IsBlockedAt returns true if the given parameter is blocked at appVersion,
+// i.e. if it is blocked or if appVersion is lower than its min app version.
+func (pbl ParamBlockList) IsBlockedAt(subspace string, key string, appVersion uint64) bool {
+	if pbl.IsBlocked(subspace, key) {
+		return true
+	}
+	minAppVersion, ok := pbl.minAppVersions[fmt.Sprintf("%s-%s", subspace, key)]
+	return ok && appVersion < minAppVersion
+}
+
+// Bound returns the bound of the given parameter and whether it has one.
+func (pbl ParamBlockList) Bound(subspace string, key string) (ParamBound, bool) {
+	bound, ok := pbl.bounds[fmt.Sprintf("%s-%s", subspace, key)]
+	return bound, ok
+}
+
 // GovHandler creates a new governance Handler for a ParamChangeProposal using
 // the underlying ParamBlockList.
 func (pbl ParamBlockList) GovHandler(pk paramskeeper.Keeper) govtypes.Handler {
@@ -51,13 +122,24 @@ func (pbl ParamBlockList) handleParameterChangeProposal(
 	pk paramskeeper.Keeper,
 	p *proposal.ParameterChangeProposal,
 ) error {
-	// throw an error if any of the parameter changes are blocked
+	// throw an error if any of the parameter changes are blocked at the
+	// current app version
+	appVersion := ctx.BlockHeader().Version.App
 	for _, c := range p.Changes {
-		if pbl.IsBlocked(c.Subspace, c.Key) {
+		if pbl.IsBlockedAt(c.Subspace, c.Key, appVersion) {
 			return ErrBlockedParameter
 		}
 	}
 
+	// throw an error if any of the parameter changes are out of bounds. The
+	// bounds are checked against the values before the proposal so that a
+	// proposal can't exceed the maximum change by changing a parameter twice.
+	for _, c := range p.Changes {
+		if err := pbl.checkBound(ctx, pk, c); err != nil {
+			return err
+		}
+	}
+
 	for _, c := range p.Changes {
 		ss, ok := pk.GetSubspace(c.Subspace)
 		if !ok {
@@ -75,3 +157,29 @@ func (pbl ParamBlockList) handleParameterChangeProposal(
 
 	return nil
 }
+
+// checkBound returns an error if the parameter change is out of the bound of
+// its parameter.
+func (pbl ParamBlockList) checkBound(ctx sdk.Context, pk paramskeeper.Keeper, c proposal.ParamChange) error {
+	bound, ok := pbl.Bound(c.Subspace, c.Key)
+	if !ok {
+		return nil
+	}
+
+	var value sdk.Dec
+	if err := value.UnmarshalJSON([]byte(c.Value)); err != nil {
+		return sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
+	}
+	if value.LT(bound.Min) || value.GT(bound.Max) {
+		return sdkerrors.Wrapf(ErrOutOfBoundsParameter, "%s must be between %v and %v: %v", c.Key, bound.Min, bound.Max, value)
+	}
+
+	current := bound.Default
+	if ss, ok := pk.GetSubspace(c.Subspace); ok {
+		ss.GetIfExists(ctx, []byte(c.Key), &current)
+	}
+	if value.Sub(current).Abs().GT(bound.MaxChange) {
+		return sdkerrors.Wrapf(ErrOutOfBoundsParameter, "%s can change by at most %v from %v: %v", c.Key, bound.MaxChange, current, value)
+	}
+	return nil
+}
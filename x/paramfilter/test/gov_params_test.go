@@ -5,15 +5,18 @@ import (
 	"time"
 
 	"github.com/celestiaorg/celestia-app/v2/app"
+	"github.com/celestiaorg/celestia-app/v2/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v2/x/paramfilter"
 	"github.com/stretchr/testify/suite"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
 
 	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
 	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
 	bsmoduletypes "github.com/celestiaorg/celestia-app/v2/x/blobstream/types"
 	minfeetypes "github.com/celestiaorg/celestia-app/v2/x/minfee"
+	minttypes "github.com/celestiaorg/celestia-app/v2/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -39,8 +42,11 @@ type GovParamsTestSuite struct {
 
 func (suite *GovParamsTestSuite) SetupTest() {
 	suite.app, _ = testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
-	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{})
-	suite.govHandler = paramfilter.NewParamBlockList(suite.app.BlockedParams()...).GovHandler(suite.app.ParamsKeeper)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{Version: tmversion.Consensus{App: appconsts.LatestVersion}})
+	suite.govHandler = paramfilter.NewParamBlockList(suite.app.BlockedParams()...).
+		WithBounds(suite.app.BoundedParams()...).
+		WithMinAppVersions(suite.app.VersionedParams()...).
+		GovHandler(suite.app.ParamsKeeper)
 }
 
 func TestGovParamsTestSuite(t *testing.T) {
@@ -151,6 +157,71 @@ func (suite *GovParamsTestSuite) TestModifiableParams() {
 				assert.Equal(want, got)
 			},
 		},
+		{
+			"blob.BlobFeeBurnFraction",
+			testProposal(proposal.ParamChange{
+				Subspace: blobtypes.ModuleName,
+				Key:      string(blobtypes.KeyBlobFeeBurnFraction),
+				Value:    `"0.5"`,
+			}),
+			func() {
+				got := suite.app.BlobKeeper.GetParams(suite.ctx).BlobFeeBurnFraction
+				want := sdk.NewDecWithPrec(5, 1)
+				assert.Equal(want, got)
+			},
+		},
+		{
+			"blob.GasRefundFraction",
+			testProposal(proposal.ParamChange{
+				Subspace: blobtypes.ModuleName,
+				Key:      string(blobtypes.KeyGasRefundFraction),
+				Value:    `"0.5"`,
+			}),
+			func() {
+				got := suite.app.BlobKeeper.GetParams(suite.ctx).GasRefundFraction
+				want := sdk.NewDecWithPrec(5, 1)
+				assert.Equal(want, got)
+			},
+		},
+		{
+			"blob.MaxGasRefund",
+			testProposal(proposal.ParamChange{
+				Subspace: blobtypes.ModuleName,
+				Key:      string(blobtypes.KeyMaxGasRefund),
+				Value:    `"2"`,
+			}),
+			func() {
+				got := suite.app.BlobKeeper.GetParams(suite.ctx).MaxGasRefund
+				want := uint64(2)
+				assert.Equal(want, got)
+			},
+		},
+		{
+			"blob.NamespaceGasMultipliers",
+			testProposal(proposal.ParamChange{
+				Subspace: blobtypes.ModuleName,
+				Key:      string(blobtypes.KeyNamespaceGasMultipliers),
+				Value:    `[{"namespace_prefix":"AAAB","multiplier":"0.5"}]`,
+			}),
+			func() {
+				got := suite.app.BlobKeeper.GetParams(suite.ctx).NamespaceGasMultipliers
+				want := []blobtypes.NamespaceGasMultiplier{{NamespacePrefix: []byte{0, 0, 1}, Multiplier: sdk.NewDecWithPrec(5, 1)}}
+				assert.Equal(want, got)
+			},
+		},
+		{
+			"blob.BlobSizeGasTiers",
+			testProposal(proposal.ParamChange{
+				Subspace: blobtypes.ModuleName,
+				Key:      string(blobtypes.KeyBlobSizeGasTiers),
+				Value:    `[{"min_blob_size":1000000,"multiplier":"2"}]`,
+			}),
+			func() {
+				got := suite.app.BlobKeeper.GetParams(suite.ctx).BlobSizeGasTiers
+				want := []blobtypes.BlobSizeGasTier{{MinBlobSize: 1_000_000, Multiplier: sdk.NewDec(2)}}
+				assert.Equal(want, got)
+			},
+		},
 		{
 			"blobstream.DataCommitmentWindow",
 			testProposal(proposal.ParamChange{
@@ -504,6 +575,61 @@ func (suite *GovParamsTestSuite) TestModifiableParams() {
 				assert.Equal(want, got)
 			},
 		},
+		{
+			"minfee.FeeDenomRates",
+			testProposal(proposal.ParamChange{
+				Subspace: minfeetypes.ModuleName,
+				Key:      string(minfeetypes.KeyFeeDenomRates),
+				Value:    `[{"denom":"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2","rate":"0.25"}]`,
+			}),
+			func() {
+				got := suite.app.MinFeeKeeper.GetParams(suite.ctx).FeeDenomRates
+				want := []minfeetypes.FeeDenomRate{{
+					Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+					Rate:  sdk.NewDecWithPrec(25, 2),
+				}}
+				assert.Equal(want, got)
+			},
+		},
+		{
+			"minfee.MsgGasPriceMultipliers",
+			testProposal(proposal.ParamChange{
+				Subspace: minfeetypes.ModuleName,
+				Key:      string(minfeetypes.KeyMsgGasPriceMultipliers),
+				Value:    `[{"msg_type_url":"/celestia.blob.v1.MsgPayForBlobs","multiplier":"2"}]`,
+			}),
+			func() {
+				got := suite.app.MinFeeKeeper.GetParams(suite.ctx).MsgGasPriceMultipliers
+				want := []minfeetypes.MsgGasPriceMultiplier{{MsgTypeUrl: "/celestia.blob.v1.MsgPayForBlobs", Multiplier: sdk.NewDec(2)}}
+				assert.Equal(want, got)
+			},
+		},
+		{
+			"mint.DisinflationRate",
+			testProposal(proposal.ParamChange{
+				Subspace: minttypes.ModuleName,
+				Key:      string(minttypes.KeyDisinflationRate),
+				Value:    `"0.09"`,
+			}),
+			func() {
+				got := suite.app.MintKeeper.GetParams(suite.ctx).DisinflationRate
+				want := sdk.MustNewDecFromStr("0.09")
+				assert.Equal(want, got)
+			},
+		},
+		{
+			"mint.TargetInflationRate",
+			testProposal(proposal.ParamChange{
+				Subspace: minttypes.ModuleName,
+				Key:      string(minttypes.KeyTargetInflationRate),
+				Value:    `"0.017"`,
+			}),
+			func() {
+				got := suite.app.MintKeeper.GetParams(suite.ctx).TargetInflationRate
+				want := sdk.MustNewDecFromStr("0.017")
+				assert.Equal(want, got)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -528,6 +654,7 @@ func (suite *GovParamsTestSuite) TestUnmodifiableParams() {
 	wantPubKeyTypes := *suite.app.BaseApp.GetConsensusParams(suite.ctx).Validator
 	wantBondDenom := suite.app.StakingKeeper.GetParams(suite.ctx).BondDenom
 	wantUnbondingTime := suite.app.StakingKeeper.GetParams(suite.ctx).UnbondingTime
+	wantMintParams := suite.app.MintKeeper.GetParams(suite.ctx)
 
 	testCases := []struct {
 		name         string
@@ -598,6 +725,45 @@ func (suite *GovParamsTestSuite) TestUnmodifiableParams() {
 				assert.Equal(wantUnbondingTime, got)
 			},
 		},
+		{
+			"mint.InitialInflationRate",
+			testProposal(proposal.ParamChange{
+				Subspace: minttypes.ModuleName,
+				Key:      string(minttypes.KeyInitialInflationRate),
+				Value:    `"0.1"`,
+			}),
+			paramfilter.ErrBlockedParameter,
+			func() {
+				got := suite.app.MintKeeper.GetParams(suite.ctx).InitialInflationRate
+				assert.Equal(wantMintParams.InitialInflationRate, got)
+			},
+		},
+		{
+			"mint.DisinflationRate out of bounds",
+			testProposal(proposal.ParamChange{
+				Subspace: minttypes.ModuleName,
+				Key:      string(minttypes.KeyDisinflationRate),
+				Value:    `"0.5"`,
+			}),
+			paramfilter.ErrOutOfBoundsParameter,
+			func() {
+				got := suite.app.MintKeeper.GetParams(suite.ctx).DisinflationRate
+				assert.Equal(wantMintParams.DisinflationRate, got)
+			},
+		},
+		{
+			"mint.TargetInflationRate out of bounds",
+			testProposal(proposal.ParamChange{
+				Subspace: minttypes.ModuleName,
+				Key:      string(minttypes.KeyTargetInflationRate),
+				Value:    `"0.005"`,
+			}),
+			paramfilter.ErrOutOfBoundsParameter,
+			func() {
+				got := suite.app.MintKeeper.GetParams(suite.ctx).TargetInflationRate
+				assert.Equal(wantMintParams.TargetInflationRate, got)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -5,6 +5,8 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v2/app"
 	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
+	blobtypes "github.com/celestiaorg/celestia-app/v2/x/blob/types"
+	minttypes "github.com/celestiaorg/celestia-app/v2/x/mint/types"
 	"github.com/celestiaorg/celestia-app/v2/x/paramfilter"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
@@ -12,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
 )
 
 func TestParamFilter(t *testing.T) {
@@ -63,3 +66,96 @@ func TestParamFilter(t *testing.T) {
 func testProposal(changes ...proposal.ParamChange) *proposal.ParameterChangeProposal {
 	return proposal.NewParameterChangeProposal("title", "description", changes)
 }
+
+func TestParamBounds(t *testing.T) {
+	app, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	pph := paramfilter.NewParamBlockList(app.BlockedParams()...).WithBounds(app.BoundedParams()...)
+	for _, b := range app.BoundedParams() {
+		_, ok := pph.Bound(b.Subspace, b.Key)
+		require.True(t, ok)
+	}
+
+	handler := pph.GovHandler(app.ParamsKeeper)
+	ctx := sdk.NewContext(app.CommitMultiStore(), types.Header{}, false, tmlog.NewNopLogger())
+	disinflationRate := func() sdk.Dec { return app.MintKeeper.GetParams(ctx).DisinflationRate }
+	change := func(value string) proposal.ParamChange {
+		return proposal.NewParamChange(minttypes.ModuleName, string(minttypes.KeyDisinflationRate), value)
+	}
+
+	testCases := []struct {
+		name     string
+		proposal *proposal.ParameterChangeProposal
+		wantErr  error
+		want     sdk.Dec
+	}{
+		{
+			name:     "change within the max change of the default value",
+			proposal: testProposal(change(`"0.12"`)),
+			want:     sdk.MustNewDecFromStr("0.12"),
+		},
+		{
+			name:     "change exceeding the max change",
+			proposal: testProposal(change(`"0.16"`)),
+			wantErr:  paramfilter.ErrOutOfBoundsParameter,
+			want:     sdk.MustNewDecFromStr("0.12"),
+		},
+		{
+			name:     "change below the min",
+			proposal: testProposal(change(`"0.04"`)),
+			wantErr:  paramfilter.ErrOutOfBoundsParameter,
+			want:     sdk.MustNewDecFromStr("0.12"),
+		},
+		{
+			name:     "two changes are bounded by the value before the proposal",
+			proposal: testProposal(change(`"0.14"`), change(`"0.16"`)),
+			wantErr:  paramfilter.ErrOutOfBoundsParameter,
+			want:     sdk.MustNewDecFromStr("0.12"),
+		},
+		{
+			name:     "change within the max change of the stored value",
+			proposal: testProposal(change(`"0.145"`)),
+			want:     sdk.MustNewDecFromStr("0.145"),
+		},
+		{
+			name:     "invalid value",
+			proposal: testProposal(change(`"abc"`)),
+			wantErr:  proposal.ErrSettingParameter,
+			want:     sdk.MustNewDecFromStr("0.145"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := handler(ctx, tc.proposal)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.want, disinflationRate())
+		})
+	}
+}
+
+func TestParamMinAppVersions(t *testing.T) {
+	app, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	pph := paramfilter.NewParamBlockList(app.BlockedParams()...).WithMinAppVersions(app.VersionedParams()...)
+	handler := pph.GovHandler(app.ParamsKeeper)
+	change := proposal.NewParamChange(blobtypes.ModuleName, string(blobtypes.KeyBlobFeeBurnFraction), `"0.5"`)
+
+	for _, p := range app.VersionedParams() {
+		require.True(t, pph.IsBlockedAt(p.Subspace, p.Key, p.MinAppVersion-1))
+		require.False(t, pph.IsBlockedAt(p.Subspace, p.Key, p.MinAppVersion))
+	}
+
+	// the change is rejected before the min app version of the parameter
+	ctx := sdk.NewContext(app.CommitMultiStore(), types.Header{Version: version.Consensus{App: 1}}, false, tmlog.NewNopLogger())
+	err := handler(ctx, testProposal(change))
+	require.ErrorIs(t, err, paramfilter.ErrBlockedParameter)
+	require.Equal(t, blobtypes.DefaultBlobFeeBurnFraction, app.BlobKeeper.GetParams(ctx).BlobFeeBurnFraction)
+
+	// and accepted from it on
+	ctx = ctx.WithBlockHeader(types.Header{Version: version.Consensus{App: 2}})
+	err = handler(ctx, testProposal(change))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), app.BlobKeeper.GetParams(ctx).BlobFeeBurnFraction)
+}
@@ -13,3 +13,7 @@ const (
 // ErrBlockedParameter is the error wrapped when a proposal to change a
 // blocked parameter is submitted.
 var ErrBlockedParameter = sdkerrors.Register(ModuleName, baseErrorCode, "parameter can not be modified")
+
+// ErrOutOfBoundsParameter is the error wrapped when a proposal to change a
+// bounded parameter out of its bounds is submitted.
+var ErrOutOfBoundsParameter = sdkerrors.Register(ModuleName, baseErrorCode+1, "parameter change is out of bounds")
@@ -45,3 +45,29 @@ func (s *CLITestSuite) TestCmdGetUpgrade() {
 	s.Require().NoError(err)
 	s.Require().Contains(output.String(), "No upgrade is pending.")
 }
+
+func (s *CLITestSuite) TestCmdQueryValidatorVersions() {
+	cmd := cli.CmdQueryValidatorVersions()
+	output, err := testutil.ExecTestCLICmd(s.ctx.Context, cmd, []string{"--count-total"})
+	s.Require().NoError(err)
+	s.Require().Contains(output.String(), "validator_address")
+	s.Require().Contains(output.String(), "voting_power")
+	s.Require().Contains(output.String(), "pagination")
+}
+
+func (s *CLITestSuite) TestCmdQueryUpgradeStatus() {
+	cmd := cli.CmdQueryUpgradeStatus()
+	output, err := testutil.ExecTestCLICmd(s.ctx.Context, cmd, []string{})
+	s.Require().NoError(err)
+	s.Require().Contains(output.String(), "blocks_remaining")
+	s.Require().Contains(output.String(), "threshold_power")
+	s.Require().Contains(output.String(), "total_voting_power")
+}
+
+func (s *CLITestSuite) TestCmdQueryParams() {
+	cmd := cli.CmdQueryParams()
+	output, err := testutil.ExecTestCLICmd(s.ctx.Context, cmd, []string{})
+	s.Require().NoError(err)
+	s.Require().Contains(output.String(), "upgrade_height_delay")
+	s.Require().Contains(output.String(), "threshold")
+}
@@ -20,8 +20,37 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdQueryTally())
 	cmd.AddCommand(CmdGetUpgrade())
+	cmd.AddCommand(CmdQueryValidatorVersions())
+	cmd.AddCommand(CmdQueryUpgradeStatus())
+	return cmd
+}
+
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query for the parameters of the signal module",
+		Args:    cobra.NoArgs,
+		Example: "params",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&resp.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -84,3 +113,61 @@ func CmdGetUpgrade() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQueryValidatorVersions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "validator-versions",
+		Short:   "Query for the version signalled by each validator in the active set",
+		Args:    cobra.NoArgs,
+		Example: "validator-versions --limit 10",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ValidatorVersions(cmd.Context(), &types.QueryValidatorVersionsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator-versions")
+	return cmd
+}
+
+func CmdQueryUpgradeStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upgrade-status",
+		Short:   "Query for the pending upgrade, the blocks remaining until it and the tally of every signalled version",
+		Args:    cobra.NoArgs,
+		Example: "upgrade-status",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.UpgradeStatus(cmd.Context(), &types.QueryUpgradeStatusRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
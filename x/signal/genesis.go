@@ -0,0 +1,67 @@
+package signal
+
+import (
+	"bytes"
+
+	"github.com/celestiaorg/celestia-app/v2/x/signal/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the signal module's state from a provided genesis
+// state. The params are only written if they differ from the defaults, which
+// the keeper falls back to, so that the state of a chain started with the
+// default params doesn't depend on whether its binary knows about them.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	defaults := types.DefaultParams()
+	if genState.Params.UpgradeHeightDelay != defaults.UpgradeHeightDelay || !genState.Params.Threshold.Equal(defaults.Threshold) {
+		k.SetParams(ctx, genState.Params)
+	}
+	for _, signal := range genState.ValidatorSignals {
+		valAddr, err := sdk.ValAddressFromBech32(signal.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorVersion(ctx, valAddr, signal.Version)
+	}
+	if genState.Upgrade != nil {
+		k.setUpgrade(ctx, *genState.Upgrade)
+	}
+}
+
+// ExportGenesis returns the signal module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genState := &types.GenesisState{
+		Params: k.GetParams(ctx),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.FirstSignalKey, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// the upgrade shares the store with the signals when one is pending.
+		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
+			continue
+		}
+		genState.ValidatorSignals = append(genState.ValidatorSignals, types.ValidatorSignal{
+			ValidatorAddress: sdk.ValAddress(iterator.Key()).String(),
+			Version:          VersionFromBytes(iterator.Value()),
+		})
+	}
+
+	if upgrade, ok := k.getUpgrade(ctx); ok {
+		genState.Upgrade = &upgrade
+	}
+	return genState
+}
+
+// PrepForZeroHeightGenesis rebases the height of the pending upgrade, if any,
+// for a chain that restarts at height zero from the state at height. The
+// upgrade happens after the same number of blocks on the new chain.
+func (k Keeper) PrepForZeroHeightGenesis(ctx sdk.Context, height int64) {
+	upgrade, ok := k.getUpgrade(ctx)
+	if !ok {
+		return
+	}
+	upgrade.UpgradeHeight = max(upgrade.UpgradeHeight-height, 1)
+	k.setUpgrade(ctx, upgrade)
+}
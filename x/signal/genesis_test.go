@@ -0,0 +1,133 @@
+package signal_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v2/app"
+	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
+	"github.com/celestiaorg/celestia-app/v2/x/signal/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestGenesis(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+
+	params := types.Params{
+		UpgradeHeightDelay: 100,
+		Threshold:          sdk.NewDecWithPrec(75, 2),
+	}
+	genState := types.GenesisState{
+		Params: params,
+		ValidatorSignals: []types.ValidatorSignal{
+			{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 2},
+			{ValidatorAddress: testutil.ValAddrs[1].String(), Version: 3},
+		},
+		Upgrade: &types.Upgrade{AppVersion: 2, UpgradeHeight: 100},
+	}
+	require.NoError(t, genState.Validate())
+	upgradeKeeper.InitGenesis(ctx, genState)
+	assert.Equal(t, params, upgradeKeeper.GetParams(ctx))
+	assert.True(t, upgradeKeeper.IsUpgradePending(ctx))
+
+	got := upgradeKeeper.ExportGenesis(ctx)
+	assert.Equal(t, genState.Params, got.Params)
+	assert.ElementsMatch(t, genState.ValidatorSignals, got.ValidatorSignals)
+	assert.Equal(t, genState.Upgrade, got.Upgrade)
+
+	t.Run("should export an empty state", func(t *testing.T) {
+		upgradeKeeper, ctx, _ := setup(t)
+		got := upgradeKeeper.ExportGenesis(ctx)
+		assert.Equal(t, types.DefaultGenesis(), got)
+	})
+}
+
+func TestGenesisValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		wantErr bool
+	}{
+		{
+			name:   "default params",
+			params: types.DefaultParams(),
+		},
+		{
+			name:   "threshold of one",
+			params: types.Params{UpgradeHeightDelay: 1, Threshold: sdk.OneDec()},
+		},
+		{
+			name:    "zero upgrade height delay",
+			params:  types.Params{UpgradeHeightDelay: 0, Threshold: types.DefaultThreshold},
+			wantErr: true,
+		},
+		{
+			name:    "negative upgrade height delay",
+			params:  types.Params{UpgradeHeightDelay: -1, Threshold: types.DefaultThreshold},
+			wantErr: true,
+		},
+		{
+			name:    "threshold of 2/3",
+			params:  types.Params{UpgradeHeightDelay: 1, Threshold: types.MinThreshold},
+			wantErr: true,
+		},
+		{
+			name:    "threshold greater than one",
+			params:  types.Params{UpgradeHeightDelay: 1, Threshold: sdk.NewDecWithPrec(101, 2)},
+			wantErr: true,
+		},
+		{
+			name:    "unset threshold",
+			params:  types.Params{UpgradeHeightDelay: 1},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.GenesisState{Params: tc.params}.Validate()
+			if tc.wantErr {
+				assert.ErrorIs(t, err, types.ErrInvalidParams)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("invalid validator address", func(t *testing.T) {
+		genState := types.DefaultGenesis()
+		genState.ValidatorSignals = []types.ValidatorSignal{{ValidatorAddress: "invalid", Version: 2}}
+		assert.Error(t, genState.Validate())
+	})
+	t.Run("duplicate validator signal", func(t *testing.T) {
+		genState := types.DefaultGenesis()
+		genState.ValidatorSignals = []types.ValidatorSignal{
+			{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 2},
+			{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 3},
+		}
+		assert.Error(t, genState.Validate())
+	})
+	t.Run("invalid upgrade version", func(t *testing.T) {
+		genState := types.DefaultGenesis()
+		genState.Upgrade = &types.Upgrade{AppVersion: 0, UpgradeHeight: 100}
+		assert.ErrorIs(t, genState.Validate(), types.ErrInvalidUpgradeVersion)
+	})
+	t.Run("invalid upgrade height", func(t *testing.T) {
+		genState := types.DefaultGenesis()
+		genState.Upgrade = &types.Upgrade{AppVersion: 2, UpgradeHeight: 0}
+		assert.ErrorIs(t, genState.Validate(), types.ErrInvalidUpgradeHeight)
+	})
+}
+
+func TestInitGenesisDefaultParams(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(false, tmproto.Header{})
+
+	// the default params are not written to the store so that the state
+	// matches the one of binaries that predate the params.
+	subspace := testApp.GetSubspace(types.ModuleName)
+	assert.False(t, subspace.Has(ctx, types.ParamsStoreKeyUpgradeHeightDelay))
+	assert.False(t, subspace.Has(ctx, types.ParamsStoreKeyThreshold))
+	assert.Equal(t, types.DefaultParams(), testApp.SignalKeeper.GetParams(ctx))
+}
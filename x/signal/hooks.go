@@ -0,0 +1,79 @@
+package signal
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks is a wrapper struct around Keeper that deletes the signal of a
+// validator when it leaves the active set.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the staking hooks of the signal module.
+func (k Keeper) Hooks() Hooks {
+	// if startup is mis-ordered in app.go this hook will halt the chain when
+	// called. Keep this check to make such a mistake obvious
+	if k.storeKey == nil {
+		panic("hooks initialized before SignalKeeper")
+	}
+	return Hooks{k}
+}
+
+// AfterValidatorBeginUnbonding deletes the signal of a validator that left the
+// active set, so that it isn't counted if the validator bonds again.
+func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	if ctx.BlockHeader().Version.App < 2 {
+		// no-op if the app version is less than 2 because the signal store
+		// was added in v2.
+		return nil
+	}
+	h.k.DeleteValidatorVersion(ctx, valAddr)
+	return nil
+}
+
+// AfterValidatorRemoved deletes the signal of a validator that was removed.
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	if ctx.BlockHeader().Version.App < 2 {
+		// no-op if the app version is less than 2 because the signal store
+		// was added in v2.
+		return nil
+	}
+	h.k.DeleteValidatorVersion(ctx, valAddr)
+	return nil
+}
+
+func (h Hooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
@@ -5,11 +5,12 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v2/app"
 	testutil "github.com/celestiaorg/celestia-app/v2/test/util"
-	"github.com/celestiaorg/celestia-app/v2/x/signal"
 	"github.com/celestiaorg/celestia-app/v2/x/signal/types"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
@@ -74,9 +75,120 @@ func TestUpgradeIntegration(t *testing.T) {
 	require.False(t, shouldUpgrade)
 	require.EqualValues(t, 0, version)
 
-	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + signal.DefaultUpgradeHeightDelay)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + types.DefaultUpgradeHeightDelay)
 
 	shouldUpgrade, version = app.SignalKeeper.ShouldUpgrade(ctx)
 	require.True(t, shouldUpgrade)
 	require.EqualValues(t, 2, version)
 }
+
+// TestCancelAndRescheduleUpgradeIntegration uses the real application to route
+// the governance messages through the message service router that is
+// populated by the versioned module manager. It asserts that a pending upgrade
+// can be rescheduled and cancelled, after which validators can signal again.
+func TestCancelAndRescheduleUpgradeIntegration(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := sdk.NewContext(testApp.CommitMultiStore(), tmtypes.Header{
+		Version: tmversion.Consensus{
+			App: 2,
+		},
+	}, false, tmlog.NewNopLogger())
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	validators := testApp.StakingKeeper.GetAllValidators(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].OperatorAddress)
+	require.NoError(t, err)
+
+	execMsg := func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		handler := testApp.MsgServiceRouter().Handler(msg)
+		require.NotNil(t, handler)
+		return handler(ctx, msg)
+	}
+
+	_, err = execMsg(ctx, types.NewMsgSignalVersion(valAddr, 3))
+	require.NoError(t, err)
+	_, err = execMsg(ctx, types.NewMsgTryUpgrade(govAddr))
+	require.NoError(t, err)
+	require.True(t, testApp.SignalKeeper.IsUpgradePending(ctx))
+
+	// the messages are not supported before app version 2.
+	_, err = execMsg(ctx.WithBlockHeader(tmtypes.Header{Version: tmversion.Consensus{App: 1}}), types.NewMsgCancelUpgrade(govAddr))
+	require.Error(t, err)
+	require.True(t, testApp.SignalKeeper.IsUpgradePending(ctx))
+
+	rescheduledHeight := ctx.BlockHeight() + 10
+	res, err := execMsg(ctx, types.NewMsgRescheduleUpgrade(govAddr, rescheduledHeight))
+	require.NoError(t, err)
+	require.Equal(t, types.EventTypeRescheduleUpgrade, res.Events[len(res.Events)-1].Type)
+
+	shouldUpgrade, _ := testApp.SignalKeeper.ShouldUpgrade(ctx.WithBlockHeight(rescheduledHeight - 1))
+	require.False(t, shouldUpgrade)
+	shouldUpgrade, version := testApp.SignalKeeper.ShouldUpgrade(ctx.WithBlockHeight(rescheduledHeight))
+	require.True(t, shouldUpgrade)
+	require.EqualValues(t, 3, version)
+
+	res, err = execMsg(ctx, types.NewMsgCancelUpgrade(govAddr))
+	require.NoError(t, err)
+	require.Equal(t, types.EventTypeCancelUpgrade, res.Events[len(res.Events)-1].Type)
+	require.False(t, testApp.SignalKeeper.IsUpgradePending(ctx))
+
+	shouldUpgrade, _ = testApp.SignalKeeper.ShouldUpgrade(ctx.WithBlockHeight(rescheduledHeight))
+	require.False(t, shouldUpgrade)
+
+	// the tally was reset so validators need to signal again.
+	tally, err := testApp.SignalKeeper.VersionTally(sdk.WrapSDKContext(ctx), &types.QueryVersionTallyRequest{Version: 3})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, tally.VotingPower)
+	_, err = execMsg(ctx, types.NewMsgSignalVersion(valAddr, 4))
+	require.NoError(t, err)
+}
+
+// TestSignalDeletedWhenValidatorUnbonds uses the real application to assert
+// that the staking hooks delete the signal of a validator that leaves the
+// active set.
+func TestSignalDeletedWhenValidatorUnbonds(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := sdk.NewContext(testApp.CommitMultiStore(), tmtypes.Header{
+		Version: tmversion.Consensus{
+			App: 2,
+		},
+	}, false, tmlog.NewNopLogger())
+
+	validators := testApp.StakingKeeper.GetAllValidators(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].OperatorAddress)
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+
+	_, err = testApp.SignalKeeper.SignalVersion(sdk.WrapSDKContext(ctx), types.NewMsgSignalVersion(valAddr, 3))
+	require.NoError(t, err)
+	res, err := testApp.SignalKeeper.VersionTally(sdk.WrapSDKContext(ctx), &types.QueryVersionTallyRequest{Version: 3})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, res.VotingPower)
+
+	// jailing the validator removes it from the active set when the
+	// validator set updates are applied.
+	testApp.StakingKeeper.Jail(ctx, consAddr)
+	_, err = testApp.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	validator, found := testApp.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.IsUnbonding())
+
+	resp, err := testApp.SignalKeeper.ValidatorVersions(sdk.WrapSDKContext(ctx), &types.QueryValidatorVersionsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Validators)
+	res, err = testApp.SignalKeeper.VersionTally(sdk.WrapSDKContext(ctx), &types.QueryVersionTallyRequest{Version: 3})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, res.VotingPower)
+
+	// the validator has to signal again once it is bonded again.
+	testApp.StakingKeeper.Unjail(ctx, consAddr)
+	_, err = testApp.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	resp, err = testApp.SignalKeeper.ValidatorVersions(sdk.WrapSDKContext(ctx), &types.QueryValidatorVersionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Validators, 1)
+	require.EqualValues(t, 0, resp.Validators[0].Version)
+}
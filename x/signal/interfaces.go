@@ -10,4 +10,5 @@ type StakingKeeper interface {
 	GetLastValidatorPower(ctx sdk.Context, addr sdk.ValAddress) int64
 	GetLastTotalPower(ctx sdk.Context) math.Int
 	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	IterateLastValidatorPowers(ctx sdk.Context, handler func(operator sdk.ValAddress, power int64) (stop bool))
 }
@@ -1,40 +1,30 @@
 package signal
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
+	"fmt"
+	"sort"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v2/x/signal/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// DefaultUpgradeHeightDelay is the number of blocks after a quorum has been
-// reached that the chain should upgrade to the new version. Assuming a block
-// interval of 12 seconds, this is 7 days.
-const DefaultUpgradeHeightDelay = int64(7 * 24 * 60 * 60 / 12) // 7 days * 24 hours * 60 minutes * 60 seconds / 12 seconds per block = 50,400 blocks.
-
 // Keeper implements the MsgServer and QueryServer interfaces
 var (
 	_ types.MsgServer   = &Keeper{}
 	_ types.QueryServer = Keeper{}
-
-	// defaultSignalThreshold is 5/6 or approximately 83.33%
-	defaultSignalThreshold = sdk.NewDec(5).Quo(sdk.NewDec(6))
 )
 
-// Threshold is the fraction of voting power that is required
-// to signal for a version change. It is set to 5/6 as the middle point
-// between 2/3 and 3/3 providing 1/6 fault tolerance to halting the
-// network during an upgrade period. It can be modified through a
-// hard fork change that modified the app version
-func Threshold(_ uint64) sdk.Dec {
-	return defaultSignalThreshold
-}
-
 type Keeper struct {
 	// binaryCodec is used to marshal and unmarshal data from the store.
 	binaryCodec codec.BinaryCodec
@@ -43,24 +33,64 @@ type Keeper struct {
 	// store.
 	storeKey storetypes.StoreKey
 
+	// paramSpace is used to get and set the upgrade height delay and the
+	// signalling threshold.
+	paramSpace paramtypes.Subspace
+
 	// stakingKeeper is used to fetch validators to calculate the total power
 	// signalled to a version.
 	stakingKeeper StakingKeeper
+
+	// authority is the address allowed to cancel and reschedule a pending
+	// upgrade. It is usually the gov module account.
+	authority string
 }
 
 // NewKeeper returns a signal keeper.
 func NewKeeper(
 	binaryCodec codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
+	paramSpace paramtypes.Subspace,
 	stakingKeeper StakingKeeper,
+	authority string,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		binaryCodec:   binaryCodec,
 		storeKey:      storeKey,
+		paramSpace:    paramSpace,
 		stakingKeeper: stakingKeeper,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the address allowed to cancel and reschedule a pending
+// upgrade.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the parameters from the store. The params that are not in
+// the store are set to their default values.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params.WithDefaults()
+}
+
+// SetParams sets the parameters in the store.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// Params enables a client to query for the parameters of the module.
+func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: k.GetParams(sdk.UnwrapSDKContext(ctx))}, nil
+}
+
 // SignalVersion is a method required by the MsgServer interface.
 func (k Keeper) SignalVersion(ctx context.Context, req *types.MsgSignalVersion) (*types.MsgSignalVersionResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -108,13 +138,71 @@ func (k *Keeper) TryUpgrade(ctx context.Context, _ *types.MsgTryUpgrade) (*types
 		}
 		upgrade := types.Upgrade{
 			AppVersion:    version,
-			UpgradeHeight: sdkCtx.BlockHeader().Height + DefaultUpgradeHeightDelay,
+			UpgradeHeight: sdkCtx.BlockHeader().Height + k.GetParams(sdkCtx).UpgradeHeightDelay,
 		}
 		k.setUpgrade(sdkCtx, upgrade)
 	}
 	return &types.MsgTryUpgradeResponse{}, nil
 }
 
+// CancelUpgrade is a method required by the MsgServer interface. It deletes
+// the pending upgrade and resets the tally so that validators can signal
+// again.
+func (k *Keeper) CancelUpgrade(ctx context.Context, req *types.MsgCancelUpgrade) (*types.MsgCancelUpgradeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != k.authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	upgrade, ok := k.getUpgrade(sdkCtx)
+	if !ok {
+		return nil, types.ErrNoUpgradePending.Wrap("can not cancel upgrade")
+	}
+
+	k.ResetTally(sdkCtx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelUpgrade,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyAppVersion, fmt.Sprint(upgrade.AppVersion)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeHeight, fmt.Sprint(upgrade.UpgradeHeight)),
+		),
+	)
+	return &types.MsgCancelUpgradeResponse{}, nil
+}
+
+// RescheduleUpgrade is a method required by the MsgServer interface. It moves
+// the pending upgrade to the requested height and resets the tally.
+func (k *Keeper) RescheduleUpgrade(ctx context.Context, req *types.MsgRescheduleUpgrade) (*types.MsgRescheduleUpgradeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.Authority != k.authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	upgrade, ok := k.getUpgrade(sdkCtx)
+	if !ok {
+		return nil, types.ErrNoUpgradePending.Wrap("can not reschedule upgrade")
+	}
+	if req.UpgradeHeight <= sdkCtx.BlockHeight() {
+		return nil, types.ErrInvalidUpgradeHeight.Wrapf("upgrade height %d must be greater than the current height %d", req.UpgradeHeight, sdkCtx.BlockHeight())
+	}
+
+	k.ResetTally(sdkCtx)
+	upgrade.UpgradeHeight = req.UpgradeHeight
+	k.setUpgrade(sdkCtx, upgrade)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRescheduleUpgrade,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyAppVersion, fmt.Sprint(upgrade.AppVersion)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeHeight, fmt.Sprint(upgrade.UpgradeHeight)),
+		),
+	)
+	return &types.MsgRescheduleUpgradeResponse{}, nil
+}
+
 // VersionTally enables a client to query for the tally of voting power has
 // signalled for a particular version.
 func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRequest) (*types.QueryVersionTallyResponse, error) {
@@ -125,6 +213,10 @@ func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRe
 	iterator := store.Iterator(types.FirstSignalKey, nil)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
+		// the upgrade shares the store with the signals when one is pending.
+		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
+			continue
+		}
 		valAddress := sdk.ValAddress(iterator.Key())
 		power := k.stakingKeeper.GetLastValidatorPower(sdkCtx, valAddress)
 		version := VersionFromBytes(iterator.Value())
@@ -140,6 +232,135 @@ func (k Keeper) VersionTally(ctx context.Context, req *types.QueryVersionTallyRe
 	}, nil
 }
 
+// ValidatorVersions enables a client to query for the version signalled by
+// each validator in the active set along with its voting power.
+func (k Keeper) ValidatorVersions(ctx context.Context, req *types.QueryValidatorVersionsRequest) (*types.QueryValidatorVersionsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(k.storeKey)
+
+	// the validators are iterated in increasing address order.
+	var validators []types.ValidatorVersion
+	k.stakingKeeper.IterateLastValidatorPowers(sdkCtx, func(valAddress sdk.ValAddress, power int64) bool {
+		var version uint64
+		if value := store.Get(valAddress); value != nil {
+			version = VersionFromBytes(value)
+		}
+		validators = append(validators, types.ValidatorVersion{
+			ValidatorAddress: valAddress.String(),
+			Version:          version,
+			VotingPower:      power,
+		})
+		return false
+	})
+
+	page, pageRes, err := paginateValidatorVersions(validators, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryValidatorVersionsResponse{Validators: page, Pagination: pageRes}, nil
+}
+
+// UpgradeStatus enables a client to query for the pending upgrade, the number
+// of blocks remaining until it and the tally of every signalled version.
+func (k Keeper) UpgradeStatus(ctx context.Context, _ *types.QueryUpgradeStatusRequest) (*types.QueryUpgradeStatusResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	resp := &types.QueryUpgradeStatusResponse{
+		Tallies:          k.tallyVersions(sdkCtx),
+		ThresholdPower:   k.GetVotingPowerThreshold(sdkCtx).Uint64(),
+		TotalVotingPower: k.stakingKeeper.GetLastTotalPower(sdkCtx).Uint64(),
+	}
+	if upgrade, ok := k.getUpgrade(sdkCtx); ok {
+		resp.Upgrade = &upgrade
+		if remaining := upgrade.UpgradeHeight - sdkCtx.BlockHeight(); remaining > 0 {
+			resp.BlocksRemaining = remaining
+		}
+	}
+	return resp, nil
+}
+
+// tallyVersions returns the voting power of the active set that has signalled
+// for each version, in increasing version order. Unlike TallyVotingPower, it
+// doesn't modify the store.
+func (k Keeper) tallyVersions(ctx sdk.Context) []types.VersionTally {
+	versionToPower := make(map[uint64]uint64)
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.FirstSignalKey, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// the upgrade shares the store with the signals when one is pending.
+		if bytes.Equal(iterator.Key(), types.UpgradeKey) {
+			continue
+		}
+		valAddress := sdk.ValAddress(iterator.Key())
+		power := k.stakingKeeper.GetLastValidatorPower(ctx, valAddress)
+		versionToPower[VersionFromBytes(iterator.Value())] += uint64(power)
+	}
+
+	tallies := make([]types.VersionTally, 0, len(versionToPower))
+	for version, power := range versionToPower {
+		tallies = append(tallies, types.VersionTally{Version: version, VotingPower: power})
+	}
+	sort.Slice(tallies, func(i, j int) bool {
+		return tallies[i].Version < tallies[j].Version
+	})
+	return tallies
+}
+
+// paginateValidatorVersions returns the page of validators defined by the
+// page request. The validators must be sorted by address as the next key is
+// the address of the first validator of the next page.
+func paginateValidatorVersions(validators []types.ValidatorVersion, pageReq *query.PageRequest) ([]types.ValidatorVersion, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, nil, sdkerrors.ErrInvalidRequest.Wrap("either offset or key is expected, got both")
+	}
+	if pageReq.Reverse {
+		reversed := make([]types.ValidatorVersion, len(validators))
+		for i, validator := range validators {
+			reversed[len(validators)-1-i] = validator
+		}
+		validators = reversed
+	}
+
+	start := min(pageReq.Offset, uint64(len(validators)))
+	if pageReq.Key != nil {
+		// start at the first validator that is not before the key so that
+		// the pagination continues even if the key left the active set.
+		start = uint64(len(validators))
+		for i, validator := range validators {
+			valAddress, err := sdk.ValAddressFromBech32(validator.ValidatorAddress)
+			if err != nil {
+				return nil, nil, err
+			}
+			cmp := bytes.Compare(valAddress, pageReq.Key)
+			if cmp == 0 || (cmp > 0) != pageReq.Reverse {
+				start = uint64(i)
+				break
+			}
+		}
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	end := min(start+limit, uint64(len(validators)))
+
+	pageRes := &query.PageResponse{}
+	if pageReq.CountTotal {
+		pageRes.Total = uint64(len(validators))
+	}
+	if end < uint64(len(validators)) {
+		nextKey, err := sdk.ValAddressFromBech32(validators[end].ValidatorAddress)
+		if err != nil {
+			return nil, nil, err
+		}
+		pageRes.NextKey = nextKey
+	}
+	return validators[start:end], pageRes, nil
+}
+
 // SetValidatorVersion saves a signalled version for a validator.
 func (k Keeper) SetValidatorVersion(ctx sdk.Context, valAddress sdk.ValAddress, version uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -190,7 +411,7 @@ func (k Keeper) TallyVotingPower(ctx sdk.Context, threshold int64) (bool, uint64
 // upgrade to a new version.
 func (k Keeper) GetVotingPowerThreshold(ctx sdk.Context) sdkmath.Int {
 	totalVotingPower := k.stakingKeeper.GetLastTotalPower(ctx)
-	thresholdFraction := Threshold(ctx.BlockHeader().Version.App)
+	thresholdFraction := k.GetParams(ctx).Threshold
 	return thresholdFraction.MulInt(totalVotingPower).Ceil().TruncateInt()
 }
 
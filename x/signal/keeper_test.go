@@ -1,14 +1,17 @@
 package signal_test
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/celestiaorg/celestia-app/v2/app"
 	"github.com/celestiaorg/celestia-app/v2/app/encoding"
@@ -16,6 +19,9 @@ import (
 	v2 "github.com/celestiaorg/celestia-app/v2/pkg/appconsts/v2"
 	"github.com/celestiaorg/celestia-app/v2/x/signal"
 	"github.com/celestiaorg/celestia-app/v2/x/signal/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,6 +34,8 @@ import (
 	tmdb "github.com/tendermint/tm-db"
 )
 
+var govModuleAddress = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 func TestGetVotingPowerThreshold(t *testing.T) {
 	bigInt := big.NewInt(0)
 	bigInt.SetString("23058430092136939509", 10)
@@ -66,10 +74,8 @@ func TestGetVotingPowerThreshold(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
-			stakingKeeper := newMockStakingKeeper(tc.validators)
-			k := signal.NewKeeper(config.Codec, nil, stakingKeeper)
-			got := k.GetVotingPowerThreshold(sdk.Context{})
+			k, ctx := newKeeper(t, newMockStakingKeeper(tc.validators))
+			got := k.GetVotingPowerThreshold(ctx)
 			assert.Equal(t, tc.want, got, fmt.Sprintf("want %v, got %v", tc.want.String(), got.String()))
 		})
 	}
@@ -182,7 +188,7 @@ func TestTallyingLogic(t *testing.T) {
 	require.False(t, shouldUpgrade) // should be false because upgrade height hasn't been reached.
 	require.Equal(t, uint64(0), version)
 
-	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + signal.DefaultUpgradeHeightDelay)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + types.DefaultUpgradeHeightDelay)
 
 	shouldUpgrade, version = upgradeKeeper.ShouldUpgrade(ctx)
 	require.True(t, shouldUpgrade) // should be true because upgrade height has been reached.
@@ -425,15 +431,278 @@ func TestGetUpgrade(t *testing.T) {
 		got, err := upgradeKeeper.GetUpgrade(ctx, &types.QueryGetUpgradeRequest{})
 		require.NoError(t, err)
 		assert.Equal(t, v2.Version, got.Upgrade.AppVersion)
-		assert.Equal(t, signal.DefaultUpgradeHeightDelay, got.Upgrade.UpgradeHeight)
+		assert.Equal(t, types.DefaultUpgradeHeightDelay, got.Upgrade.UpgradeHeight)
+	})
+}
+
+func TestParams(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	upgradeKeeper.SetParams(ctx, types.Params{
+		UpgradeHeightDelay: 10,
+		Threshold:          sdk.NewDecWithPrec(75, 2),
+	})
+	got, err := upgradeKeeper.Params(goCtx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), got.Params.UpgradeHeightDelay)
+
+	// 75% of the total voting power of 120.
+	assert.Equal(t, sdkmath.NewInt(90), upgradeKeeper.GetVotingPowerThreshold(ctx))
+
+	// 40 + 59 = 99 is enough to reach the lowered threshold.
+	_, err = upgradeKeeper.SignalVersion(goCtx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 2})
+	require.NoError(t, err)
+	_, err = upgradeKeeper.SignalVersion(goCtx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[2].String(), Version: 2})
+	require.NoError(t, err)
+	_, err = upgradeKeeper.TryUpgrade(goCtx, &types.MsgTryUpgrade{})
+	require.NoError(t, err)
+
+	upgrade, err := upgradeKeeper.GetUpgrade(goCtx, &types.QueryGetUpgradeRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, &types.Upgrade{AppVersion: 2, UpgradeHeight: ctx.BlockHeight() + 10}, upgrade.Upgrade)
+}
+
+func TestCancelUpgrade(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Run("should return an error if no upgrade is pending", func(t *testing.T) {
+		_, err := upgradeKeeper.CancelUpgrade(goCtx, &types.MsgCancelUpgrade{Authority: govModuleAddress})
+		require.ErrorIs(t, err, types.ErrNoUpgradePending)
+	})
+
+	signalAll(t, upgradeKeeper, ctx, 2)
+	_, err := upgradeKeeper.TryUpgrade(goCtx, &types.MsgTryUpgrade{})
+	require.NoError(t, err)
+	require.True(t, upgradeKeeper.IsUpgradePending(ctx))
+
+	t.Run("should return an error if the signer is not the authority", func(t *testing.T) {
+		_, err := upgradeKeeper.CancelUpgrade(goCtx, &types.MsgCancelUpgrade{Authority: testutil.ValAddrs[0].String()})
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+		require.True(t, upgradeKeeper.IsUpgradePending(ctx))
+	})
+
+	t.Run("should cancel the upgrade and reset the tally", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		_, err := upgradeKeeper.CancelUpgrade(sdk.WrapSDKContext(ctx), &types.MsgCancelUpgrade{Authority: govModuleAddress})
+		require.NoError(t, err)
+		require.False(t, upgradeKeeper.IsUpgradePending(ctx))
+
+		res, err := upgradeKeeper.VersionTally(goCtx, &types.QueryVersionTallyRequest{Version: 2})
+		require.NoError(t, err)
+		assert.Zero(t, res.VotingPower)
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		assert.Equal(t, types.EventTypeCancelUpgrade, events[0].Type)
+
+		// validators can signal again once the upgrade was cancelled.
+		_, err = upgradeKeeper.SignalVersion(goCtx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 3})
+		require.NoError(t, err)
+	})
+}
+
+func TestRescheduleUpgrade(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	ctx = ctx.WithBlockHeight(10)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Run("should return an error if no upgrade is pending", func(t *testing.T) {
+		_, err := upgradeKeeper.RescheduleUpgrade(goCtx, &types.MsgRescheduleUpgrade{Authority: govModuleAddress, UpgradeHeight: 100})
+		require.ErrorIs(t, err, types.ErrNoUpgradePending)
+	})
+
+	signalAll(t, upgradeKeeper, ctx, 2)
+	_, err := upgradeKeeper.TryUpgrade(goCtx, &types.MsgTryUpgrade{})
+	require.NoError(t, err)
+
+	t.Run("should return an error if the signer is not the authority", func(t *testing.T) {
+		_, err := upgradeKeeper.RescheduleUpgrade(goCtx, &types.MsgRescheduleUpgrade{Authority: testutil.ValAddrs[0].String(), UpgradeHeight: 100})
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	})
+
+	t.Run("should return an error if the height is not in the future", func(t *testing.T) {
+		_, err := upgradeKeeper.RescheduleUpgrade(goCtx, &types.MsgRescheduleUpgrade{Authority: govModuleAddress, UpgradeHeight: 10})
+		require.ErrorIs(t, err, types.ErrInvalidUpgradeHeight)
+	})
+
+	t.Run("should move the upgrade and reset the tally", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		_, err := upgradeKeeper.RescheduleUpgrade(sdk.WrapSDKContext(ctx), &types.MsgRescheduleUpgrade{Authority: govModuleAddress, UpgradeHeight: 100})
+		require.NoError(t, err)
+
+		got, err := upgradeKeeper.GetUpgrade(goCtx, &types.QueryGetUpgradeRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, &types.Upgrade{AppVersion: 2, UpgradeHeight: 100}, got.Upgrade)
+
+		res, err := upgradeKeeper.VersionTally(goCtx, &types.QueryVersionTallyRequest{Version: 2})
+		require.NoError(t, err)
+		assert.Zero(t, res.VotingPower)
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		assert.Equal(t, types.EventTypeRescheduleUpgrade, events[0].Type)
+
+		shouldUpgrade, _ := upgradeKeeper.ShouldUpgrade(ctx.WithBlockHeight(99))
+		assert.False(t, shouldUpgrade)
+		shouldUpgrade, version := upgradeKeeper.ShouldUpgrade(ctx.WithBlockHeight(100))
+		assert.True(t, shouldUpgrade)
+		assert.EqualValues(t, 2, version)
+	})
+}
+
+// signalAll signals for version with every validator of the setup.
+func signalAll(t *testing.T, upgradeKeeper signal.Keeper, ctx sdk.Context, version uint64) {
+	for _, valAddr := range testutil.ValAddrs[:4] {
+		_, err := upgradeKeeper.SignalVersion(sdk.WrapSDKContext(ctx), &types.MsgSignalVersion{ValidatorAddress: valAddr.String(), Version: version})
+		require.NoError(t, err)
+	}
+}
+
+func TestHooks(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	signalAll(t, upgradeKeeper, ctx, 2)
+	hooks := upgradeKeeper.Hooks()
+
+	// the hooks are no-ops before app version 2.
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(ctx, nil, testutil.ValAddrs[0]))
+	require.NoError(t, hooks.AfterValidatorRemoved(ctx, nil, testutil.ValAddrs[1]))
+	got, err := upgradeKeeper.VersionTally(sdk.WrapSDKContext(ctx), &types.QueryVersionTallyRequest{Version: 2})
+	require.NoError(t, err)
+	assert.EqualValues(t, 120, got.VotingPower)
+
+	ctx = ctx.WithBlockHeader(tmproto.Header{Version: tmversion.Consensus{App: 2}})
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(ctx, nil, testutil.ValAddrs[0]))
+	require.NoError(t, hooks.AfterValidatorRemoved(ctx, nil, testutil.ValAddrs[1]))
+	got, err = upgradeKeeper.VersionTally(sdk.WrapSDKContext(ctx), &types.QueryVersionTallyRequest{Version: 2})
+	require.NoError(t, err)
+	assert.EqualValues(t, 79, got.VotingPower)
+}
+
+func TestValidatorVersions(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	_, err := upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 2})
+	require.NoError(t, err)
+	_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[2].String(), Version: 3})
+	require.NoError(t, err)
+
+	expected := map[string]types.ValidatorVersion{
+		testutil.ValAddrs[0].String(): {ValidatorAddress: testutil.ValAddrs[0].String(), Version: 2, VotingPower: 40},
+		testutil.ValAddrs[1].String(): {ValidatorAddress: testutil.ValAddrs[1].String(), Version: 0, VotingPower: 1},
+		testutil.ValAddrs[2].String(): {ValidatorAddress: testutil.ValAddrs[2].String(), Version: 3, VotingPower: 59},
+		testutil.ValAddrs[3].String(): {ValidatorAddress: testutil.ValAddrs[3].String(), Version: 0, VotingPower: 20},
+	}
+
+	t.Run("should return all the validators without pagination", func(t *testing.T) {
+		got, err := upgradeKeeper.ValidatorVersions(ctx, &types.QueryValidatorVersionsRequest{})
+		require.NoError(t, err)
+		require.Len(t, got.Validators, len(expected))
+		for _, validator := range got.Validators {
+			assert.Equal(t, expected[validator.ValidatorAddress], validator)
+		}
+		assert.Nil(t, got.Pagination.NextKey)
 	})
+
+	t.Run("should paginate the validators by key", func(t *testing.T) {
+		var got []types.ValidatorVersion
+		req := &types.QueryValidatorVersionsRequest{Pagination: &query.PageRequest{Limit: 3, CountTotal: true}}
+		for {
+			resp, err := upgradeKeeper.ValidatorVersions(ctx, req)
+			require.NoError(t, err)
+			got = append(got, resp.Validators...)
+			if resp.Pagination.NextKey == nil {
+				break
+			}
+			assert.Equal(t, uint64(len(expected)), resp.Pagination.Total)
+			req.Pagination = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 3}
+		}
+		require.Len(t, got, len(expected))
+		for _, validator := range got {
+			assert.Equal(t, expected[validator.ValidatorAddress], validator)
+		}
+	})
+
+	t.Run("should paginate the validators by offset", func(t *testing.T) {
+		resp, err := upgradeKeeper.ValidatorVersions(ctx, &types.QueryValidatorVersionsRequest{Pagination: &query.PageRequest{Offset: 3, Limit: 3}})
+		require.NoError(t, err)
+		assert.Len(t, resp.Validators, 1)
+		assert.Nil(t, resp.Pagination.NextKey)
+	})
+
+	t.Run("should return an error if both the key and the offset are set", func(t *testing.T) {
+		_, err := upgradeKeeper.ValidatorVersions(ctx, &types.QueryValidatorVersionsRequest{Pagination: &query.PageRequest{Offset: 1, Key: testutil.ValAddrs[0]}})
+		assert.Error(t, err)
+	})
+}
+
+func TestUpgradeStatus(t *testing.T) {
+	upgradeKeeper, ctx, _ := setup(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	got, err := upgradeKeeper.UpgradeStatus(goCtx, &types.QueryUpgradeStatusRequest{})
+	require.NoError(t, err)
+	assert.Nil(t, got.Upgrade)
+	assert.Zero(t, got.BlocksRemaining)
+	assert.Empty(t, got.Tallies)
+	assert.Equal(t, uint64(100), got.ThresholdPower)
+	assert.Equal(t, uint64(120), got.TotalVotingPower)
+
+	_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[0].String(), Version: 3})
+	require.NoError(t, err)
+	_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[1].String(), Version: 2})
+	require.NoError(t, err)
+	_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[2].String(), Version: 3})
+	require.NoError(t, err)
+
+	got, err = upgradeKeeper.UpgradeStatus(goCtx, &types.QueryUpgradeStatusRequest{})
+	require.NoError(t, err)
+	assert.Nil(t, got.Upgrade)
+	assert.Equal(t, []types.VersionTally{{Version: 2, VotingPower: 1}, {Version: 3, VotingPower: 99}}, got.Tallies)
+
+	_, err = upgradeKeeper.SignalVersion(ctx, &types.MsgSignalVersion{ValidatorAddress: testutil.ValAddrs[3].String(), Version: 3})
+	require.NoError(t, err)
+	_, err = upgradeKeeper.TryUpgrade(goCtx, &types.MsgTryUpgrade{})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(100)
+	got, err = upgradeKeeper.UpgradeStatus(sdk.WrapSDKContext(ctx), &types.QueryUpgradeStatusRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, got.Upgrade)
+	assert.Equal(t, uint64(3), got.Upgrade.AppVersion)
+	assert.Equal(t, types.DefaultUpgradeHeightDelay-100, got.BlocksRemaining)
+	assert.Equal(t, []types.VersionTally{{Version: 2, VotingPower: 1}, {Version: 3, VotingPower: 119}}, got.Tallies)
+
+	ctx = ctx.WithBlockHeight(types.DefaultUpgradeHeightDelay + 1)
+	got, err = upgradeKeeper.UpgradeStatus(sdk.WrapSDKContext(ctx), &types.QueryUpgradeStatusRequest{})
+	require.NoError(t, err)
+	assert.Zero(t, got.BlocksRemaining)
 }
 
 func setup(t *testing.T) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
+	mockStakingKeeper := newMockStakingKeeper(
+		map[string]int64{
+			testutil.ValAddrs[0].String(): 40,
+			testutil.ValAddrs[1].String(): 1,
+			testutil.ValAddrs[2].String(): 59,
+			testutil.ValAddrs[3].String(): 20,
+		},
+	)
+	upgradeKeeper, mockCtx := newKeeper(t, mockStakingKeeper)
+	return upgradeKeeper, mockCtx, mockStakingKeeper
+}
+
+// newKeeper returns a signal keeper backed by an in-memory store and the
+// provided staking keeper.
+func newKeeper(t *testing.T, stakingKeeper signal.StakingKeeper) (signal.Keeper, sdk.Context) {
 	signalStore := sdk.NewKVStoreKey(types.StoreKey)
+	paramsStore := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTStore := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(signalStore, storetypes.StoreTypeIAVL, nil)
+	stateStore.MountStoreWithDB(paramsStore, storetypes.StoreTypeIAVL, nil)
+	stateStore.MountStoreWithDB(paramsTStore, storetypes.StoreTypeTransient, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 	mockCtx := sdk.NewContext(stateStore, tmproto.Header{
 		Version: tmversion.Consensus{
@@ -441,18 +710,10 @@ func setup(t *testing.T) (signal.Keeper, sdk.Context, *mockStakingKeeper) {
 			App:   1,
 		},
 	}, false, log.NewNopLogger())
-	mockStakingKeeper := newMockStakingKeeper(
-		map[string]int64{
-			testutil.ValAddrs[0].String(): 40,
-			testutil.ValAddrs[1].String(): 1,
-			testutil.ValAddrs[2].String(): 59,
-			testutil.ValAddrs[3].String(): 20,
-		},
-	)
 
 	config := encoding.MakeConfig(app.ModuleEncodingRegisters...)
-	upgradeKeeper := signal.NewKeeper(config.Codec, signalStore, mockStakingKeeper)
-	return upgradeKeeper, mockCtx, mockStakingKeeper
+	paramsSubspace := paramstypes.NewSubspace(config.Codec, config.Amino, paramsStore, paramsTStore, types.ModuleName)
+	return signal.NewKeeper(config.Codec, signalStore, paramsSubspace, stakingKeeper, govModuleAddress), mockCtx
 }
 
 var _ signal.StakingKeeper = (*mockStakingKeeper)(nil)
@@ -485,6 +746,25 @@ func (m *mockStakingKeeper) GetLastValidatorPower(_ sdk.Context, addr sdk.ValAdd
 	return 0
 }
 
+func (m *mockStakingKeeper) IterateLastValidatorPowers(_ sdk.Context, handler func(operator sdk.ValAddress, power int64) (stop bool)) {
+	valAddresses := make([]sdk.ValAddress, 0, len(m.validators))
+	for addrStr := range m.validators {
+		valAddress, err := sdk.ValAddressFromBech32(addrStr)
+		if err != nil {
+			panic(err)
+		}
+		valAddresses = append(valAddresses, valAddress)
+	}
+	sort.Slice(valAddresses, func(i, j int) bool {
+		return bytes.Compare(valAddresses[i], valAddresses[j]) < 0
+	})
+	for _, valAddress := range valAddresses {
+		if handler(valAddress, m.validators[valAddress.String()]) {
+			return
+		}
+	}
+}
+
 func (m *mockStakingKeeper) GetValidator(_ sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool) {
 	addrStr := addr.String()
 	if _, ok := m.validators[addrStr]; ok {
@@ -8,4 +8,7 @@ var (
 	ErrInvalidSignalVersion  = errors.Register(ModuleName, 1, "invalid signal version because signal version can not be less than the current version")
 	ErrInvalidUpgradeVersion = errors.Register(ModuleName, 3, "invalid upgrade version")
 	ErrUpgradePending        = errors.Register(ModuleName, 2, "upgrade is already pending")
+	ErrNoUpgradePending      = errors.Register(ModuleName, 4, "no upgrade is pending")
+	ErrInvalidUpgradeHeight  = errors.Register(ModuleName, 5, "invalid upgrade height")
+	ErrInvalidParams         = errors.Register(ModuleName, 6, "invalid params")
 )
@@ -0,0 +1,8 @@
+package types
+
+const (
+	EventTypeCancelUpgrade     = "cancel_upgrade"
+	EventTypeRescheduleUpgrade = "reschedule_upgrade"
+	AttributeKeyAppVersion     = "app_version"
+	AttributeKeyUpgradeHeight  = "upgrade_height"
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default signal genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return errors.Wrap(err, "params")
+	}
+
+	seen := make(map[string]struct{}, len(gs.ValidatorSignals))
+	for _, signal := range gs.ValidatorSignals {
+		valAddr, err := sdk.ValAddressFromBech32(signal.ValidatorAddress)
+		if err != nil {
+			return errors.Wrapf(err, "validator signal %s", signal.ValidatorAddress)
+		}
+		if _, ok := seen[valAddr.String()]; ok {
+			return fmt.Errorf("duplicate validator signal for %s", signal.ValidatorAddress)
+		}
+		seen[valAddr.String()] = struct{}{}
+	}
+
+	if gs.Upgrade != nil {
+		if gs.Upgrade.AppVersion == 0 {
+			return errors.Wrap(ErrInvalidUpgradeVersion, "pending upgrade app version must be positive")
+		}
+		if gs.Upgrade.UpgradeHeight <= 0 {
+			return errors.Wrapf(ErrInvalidUpgradeHeight, "pending upgrade height %d must be positive", gs.Upgrade.UpgradeHeight)
+		}
+	}
+	return nil
+}
@@ -16,6 +16,9 @@ const (
 
 	URLMsgSignalVersion = "/celestia.signal.v1.Msg/SignalVersion"
 	URLMsgTryUpgrade    = "/celestia.signal.v1.Msg/TryUpgrade"
+
+	URLMsgCancelUpgrade     = "/celestia.signal.v1.Msg/CancelUpgrade"
+	URLMsgRescheduleUpgrade = "/celestia.signal.v1.Msg/RescheduleUpgrade"
 )
 
 var (
@@ -23,6 +26,11 @@ var (
 	_ sdk.Msg            = &MsgTryUpgrade{}
 	_ legacytx.LegacyMsg = &MsgSignalVersion{}
 	_ legacytx.LegacyMsg = &MsgTryUpgrade{}
+
+	_ sdk.Msg            = &MsgCancelUpgrade{}
+	_ sdk.Msg            = &MsgRescheduleUpgrade{}
+	_ legacytx.LegacyMsg = &MsgCancelUpgrade{}
+	_ legacytx.LegacyMsg = &MsgRescheduleUpgrade{}
 )
 
 var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
@@ -95,3 +103,77 @@ func (msg *MsgTryUpgrade) Route() string {
 func (msg *MsgTryUpgrade) Type() string {
 	return URLMsgTryUpgrade
 }
+
+func NewMsgCancelUpgrade(authority sdk.AccAddress) *MsgCancelUpgrade {
+	return &MsgCancelUpgrade{
+		Authority: authority.String(),
+	}
+}
+
+func (msg *MsgCancelUpgrade) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgCancelUpgrade) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+// GetSignBytes implements legacytx.LegacyMsg.
+func (msg *MsgCancelUpgrade) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements legacytx.LegacyMsg.
+func (msg *MsgCancelUpgrade) Route() string {
+	return RouterKey
+}
+
+// Type implements legacytx.LegacyMsg.
+func (msg *MsgCancelUpgrade) Type() string {
+	return URLMsgCancelUpgrade
+}
+
+func NewMsgRescheduleUpgrade(authority sdk.AccAddress, upgradeHeight int64) *MsgRescheduleUpgrade {
+	return &MsgRescheduleUpgrade{
+		Authority:     authority.String(),
+		UpgradeHeight: upgradeHeight,
+	}
+}
+
+func (msg *MsgRescheduleUpgrade) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg *MsgRescheduleUpgrade) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	if msg.UpgradeHeight <= 0 {
+		return ErrInvalidUpgradeHeight.Wrapf("upgrade height %d must be positive", msg.UpgradeHeight)
+	}
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg.
+func (msg *MsgRescheduleUpgrade) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements legacytx.LegacyMsg.
+func (msg *MsgRescheduleUpgrade) Route() string {
+	return RouterKey
+}
+
+// Type implements legacytx.LegacyMsg.
+func (msg *MsgRescheduleUpgrade) Type() string {
+	return URLMsgRescheduleUpgrade
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultParamspace defines the default signal module parameter subspace.
+const DefaultParamspace = ModuleName
+
+// DefaultUpgradeHeightDelay is the number of blocks after a quorum has been
+// reached that the chain should upgrade to the new version. Assuming a block
+// interval of 12 seconds, this is 7 days.
+const DefaultUpgradeHeightDelay = int64(7 * 24 * 60 * 60 / 12) // 7 days * 24 hours * 60 minutes * 60 seconds / 12 seconds per block = 50,400 blocks.
+
+var (
+	// ParamsStoreKeyUpgradeHeightDelay is the key used for the
+	// UpgradeHeightDelay param.
+	ParamsStoreKeyUpgradeHeightDelay = []byte("UpgradeHeightDelay")
+	// ParamsStoreKeyThreshold is the key used for the Threshold param.
+	ParamsStoreKeyThreshold = []byte("Threshold")
+
+	// DefaultThreshold is 5/6 or approximately 83.33%. It is the middle point
+	// between 2/3 and 3/3 providing 1/6 fault tolerance to halting the network
+	// during an upgrade period.
+	DefaultThreshold = sdk.NewDec(5).Quo(sdk.NewDec(6))
+
+	// MinThreshold is the exclusive lower bound of the threshold. A version
+	// must be signalled for by more than 2/3 of the voting power so that the
+	// validators that upgrade can keep producing blocks.
+	MinThreshold = sdk.NewDec(2).Quo(sdk.NewDec(3))
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// DefaultParams returns the default signal params.
+func DefaultParams() Params {
+	return Params{
+		UpgradeHeightDelay: DefaultUpgradeHeightDelay,
+		Threshold:          DefaultThreshold,
+	}
+}
+
+// ParamKeyTable returns the param key table for the signal module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value
+// pairs of the signal module's parameters.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamsStoreKeyUpgradeHeightDelay, &p.UpgradeHeightDelay, validateUpgradeHeightDelay),
+		paramtypes.NewParamSetPair(ParamsStoreKeyThreshold, &p.Threshold, validateThreshold),
+	}
+}
+
+// WithDefaults returns a copy of the params where the unset params are
+// replaced by their default values. The params are unset on chains that
+// started app version 2 before they were added.
+func (p Params) WithDefaults() Params {
+	if p.UpgradeHeightDelay == 0 {
+		p.UpgradeHeightDelay = DefaultUpgradeHeightDelay
+	}
+	if p.Threshold.IsNil() || p.Threshold.IsZero() {
+		p.Threshold = DefaultThreshold
+	}
+	return p
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateUpgradeHeightDelay(p.UpgradeHeightDelay); err != nil {
+		return err
+	}
+	return validateThreshold(p.Threshold)
+}
+
+func validateUpgradeHeightDelay(i interface{}) error {
+	val, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val <= 0 {
+		return errors.Wrapf(ErrInvalidParams, "upgrade height delay %d must be positive", val)
+	}
+	return nil
+}
+
+func validateThreshold(i interface{}) error {
+	val, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val.IsNil() {
+		return errors.Wrap(ErrInvalidParams, "threshold must be set")
+	}
+	if val.LTE(MinThreshold) {
+		return errors.Wrapf(ErrInvalidParams, "threshold %v must be greater than 2/3", val)
+	}
+	if val.GT(sdk.OneDec()) {
+		return errors.Wrapf(ErrInvalidParams, "threshold %v must be <= 1", val)
+	}
+	return nil
+}
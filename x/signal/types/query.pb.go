@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -28,6 +30,88 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryParamsRequest is the request type for the Params query.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Params query.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // QueryVersionTallyRequest is the request type for the VersionTally query.
 type QueryVersionTallyRequest struct {
 	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -37,7 +121,7 @@ func (m *QueryVersionTallyRequest) Reset()         { *m = QueryVersionTallyReque
 func (m *QueryVersionTallyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionTallyRequest) ProtoMessage()    {}
 func (*QueryVersionTallyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7af24246367e432c, []int{0}
+	return fileDescriptor_7af24246367e432c, []int{2}
 }
 func (m *QueryVersionTallyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -84,7 +168,7 @@ func (m *QueryVersionTallyResponse) Reset()         { *m = QueryVersionTallyResp
 func (m *QueryVersionTallyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionTallyResponse) ProtoMessage()    {}
 func (*QueryVersionTallyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7af24246367e432c, []int{1}
+	return fileDescriptor_7af24246367e432c, []int{3}
 }
 func (m *QueryVersionTallyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -142,7 +226,7 @@ func (m *QueryGetUpgradeRequest) Reset()         { *m = QueryGetUpgradeRequest{}
 func (m *QueryGetUpgradeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetUpgradeRequest) ProtoMessage()    {}
 func (*QueryGetUpgradeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7af24246367e432c, []int{2}
+	return fileDescriptor_7af24246367e432c, []int{4}
 }
 func (m *QueryGetUpgradeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,7 +264,7 @@ func (m *QueryGetUpgradeResponse) Reset()         { *m = QueryGetUpgradeResponse
 func (m *QueryGetUpgradeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetUpgradeResponse) ProtoMessage()    {}
 func (*QueryGetUpgradeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7af24246367e432c, []int{3}
+	return fileDescriptor_7af24246367e432c, []int{5}
 }
 func (m *QueryGetUpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,43 +300,411 @@ func (m *QueryGetUpgradeResponse) GetUpgrade() *Upgrade {
 	return nil
 }
 
+// QueryValidatorVersionsRequest is the request type for the ValidatorVersions
+// query.
+type QueryValidatorVersionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorVersionsRequest) Reset()         { *m = QueryValidatorVersionsRequest{} }
+func (m *QueryValidatorVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorVersionsRequest) ProtoMessage()    {}
+func (*QueryValidatorVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{6}
+}
+func (m *QueryValidatorVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorVersionsRequest.Merge(m, src)
+}
+func (m *QueryValidatorVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorVersionsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorVersionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorVersionsResponse is the response type for the
+// ValidatorVersions query.
+type QueryValidatorVersionsResponse struct {
+	Validators []ValidatorVersion  `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorVersionsResponse) Reset()         { *m = QueryValidatorVersionsResponse{} }
+func (m *QueryValidatorVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorVersionsResponse) ProtoMessage()    {}
+func (*QueryValidatorVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{7}
+}
+func (m *QueryValidatorVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorVersionsResponse.Merge(m, src)
+}
+func (m *QueryValidatorVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorVersionsResponse) GetValidators() []ValidatorVersion {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *QueryValidatorVersionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ValidatorVersion is the version signalled by a validator.
+type ValidatorVersion struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Version is the signalled version. It is 0 if the validator has not
+	// signalled.
+	Version     uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	VotingPower int64  `protobuf:"varint,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *ValidatorVersion) Reset()         { *m = ValidatorVersion{} }
+func (m *ValidatorVersion) String() string { return proto.CompactTextString(m) }
+func (*ValidatorVersion) ProtoMessage()    {}
+func (*ValidatorVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{8}
+}
+func (m *ValidatorVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorVersion.Merge(m, src)
+}
+func (m *ValidatorVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorVersion proto.InternalMessageInfo
+
+func (m *ValidatorVersion) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ValidatorVersion) GetVotingPower() int64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+// QueryUpgradeStatusRequest is the request type for the UpgradeStatus query.
+type QueryUpgradeStatusRequest struct {
+}
+
+func (m *QueryUpgradeStatusRequest) Reset()         { *m = QueryUpgradeStatusRequest{} }
+func (m *QueryUpgradeStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeStatusRequest) ProtoMessage()    {}
+func (*QueryUpgradeStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{9}
+}
+func (m *QueryUpgradeStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeStatusRequest.Merge(m, src)
+}
+func (m *QueryUpgradeStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeStatusRequest proto.InternalMessageInfo
+
+// QueryUpgradeStatusResponse is the response type for the UpgradeStatus
+// query.
+type QueryUpgradeStatusResponse struct {
+	// Upgrade is the pending upgrade. It is empty if no upgrade is pending.
+	Upgrade *Upgrade `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	// BlocksRemaining is the number of blocks until the upgrade height of the
+	// pending upgrade. It is 0 if no upgrade is pending.
+	BlocksRemaining int64 `protobuf:"varint,2,opt,name=blocks_remaining,json=blocksRemaining,proto3" json:"blocks_remaining,omitempty"`
+	// Tallies contains the voting power signalled for each version, in
+	// increasing version order.
+	Tallies          []VersionTally `protobuf:"bytes,3,rep,name=tallies,proto3" json:"tallies"`
+	ThresholdPower   uint64         `protobuf:"varint,4,opt,name=threshold_power,json=thresholdPower,proto3" json:"threshold_power,omitempty"`
+	TotalVotingPower uint64         `protobuf:"varint,5,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+}
+
+func (m *QueryUpgradeStatusResponse) Reset()         { *m = QueryUpgradeStatusResponse{} }
+func (m *QueryUpgradeStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeStatusResponse) ProtoMessage()    {}
+func (*QueryUpgradeStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{10}
+}
+func (m *QueryUpgradeStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeStatusResponse.Merge(m, src)
+}
+func (m *QueryUpgradeStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeStatusResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeStatusResponse) GetUpgrade() *Upgrade {
+	if m != nil {
+		return m.Upgrade
+	}
+	return nil
+}
+
+func (m *QueryUpgradeStatusResponse) GetBlocksRemaining() int64 {
+	if m != nil {
+		return m.BlocksRemaining
+	}
+	return 0
+}
+
+func (m *QueryUpgradeStatusResponse) GetTallies() []VersionTally {
+	if m != nil {
+		return m.Tallies
+	}
+	return nil
+}
+
+func (m *QueryUpgradeStatusResponse) GetThresholdPower() uint64 {
+	if m != nil {
+		return m.ThresholdPower
+	}
+	return 0
+}
+
+func (m *QueryUpgradeStatusResponse) GetTotalVotingPower() uint64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+// VersionTally is the voting power that has signalled for a version.
+type VersionTally struct {
+	Version     uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	VotingPower uint64 `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *VersionTally) Reset()         { *m = VersionTally{} }
+func (m *VersionTally) String() string { return proto.CompactTextString(m) }
+func (*VersionTally) ProtoMessage()    {}
+func (*VersionTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7af24246367e432c, []int{11}
+}
+func (m *VersionTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersionTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionTally.Merge(m, src)
+}
+func (m *VersionTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionTally proto.InternalMessageInfo
+
+func (m *VersionTally) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *VersionTally) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.signal.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.signal.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryVersionTallyRequest)(nil), "celestia.signal.v1.QueryVersionTallyRequest")
 	proto.RegisterType((*QueryVersionTallyResponse)(nil), "celestia.signal.v1.QueryVersionTallyResponse")
 	proto.RegisterType((*QueryGetUpgradeRequest)(nil), "celestia.signal.v1.QueryGetUpgradeRequest")
 	proto.RegisterType((*QueryGetUpgradeResponse)(nil), "celestia.signal.v1.QueryGetUpgradeResponse")
+	proto.RegisterType((*QueryValidatorVersionsRequest)(nil), "celestia.signal.v1.QueryValidatorVersionsRequest")
+	proto.RegisterType((*QueryValidatorVersionsResponse)(nil), "celestia.signal.v1.QueryValidatorVersionsResponse")
+	proto.RegisterType((*ValidatorVersion)(nil), "celestia.signal.v1.ValidatorVersion")
+	proto.RegisterType((*QueryUpgradeStatusRequest)(nil), "celestia.signal.v1.QueryUpgradeStatusRequest")
+	proto.RegisterType((*QueryUpgradeStatusResponse)(nil), "celestia.signal.v1.QueryUpgradeStatusResponse")
+	proto.RegisterType((*VersionTally)(nil), "celestia.signal.v1.VersionTally")
 }
 
 func init() { proto.RegisterFile("celestia/signal/v1/query.proto", fileDescriptor_7af24246367e432c) }
 
 var fileDescriptor_7af24246367e432c = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x4f, 0xe2, 0x40,
-	0x18, 0xc6, 0x19, 0xf6, 0x0f, 0xc9, 0x40, 0x76, 0x37, 0x93, 0xcd, 0x6e, 0xb7, 0x4b, 0x1a, 0xb6,
-	0x97, 0xdd, 0xac, 0xd0, 0x09, 0xa8, 0x5f, 0xc0, 0x8b, 0x17, 0x0f, 0x48, 0x94, 0x83, 0x17, 0x32,
-	0xc0, 0xa4, 0x34, 0xa9, 0x9d, 0xa1, 0x33, 0xad, 0x12, 0xe3, 0x41, 0xbf, 0x80, 0x26, 0xc6, 0xa3,
-	0xdf, 0xc7, 0x23, 0x89, 0x17, 0x8f, 0x06, 0xfc, 0x20, 0x86, 0x99, 0x16, 0x30, 0x85, 0x84, 0x5b,
-	0xfb, 0xbe, 0xbf, 0xf7, 0x99, 0xe7, 0x7d, 0x66, 0xa0, 0xd5, 0xa3, 0x3e, 0x15, 0xd2, 0x23, 0x58,
-	0x78, 0x6e, 0x40, 0x7c, 0x1c, 0xd7, 0xf1, 0x30, 0xa2, 0xe1, 0xc8, 0xe1, 0x21, 0x93, 0x0c, 0xa1,
-	0xb4, 0xef, 0xe8, 0xbe, 0x13, 0xd7, 0xcd, 0xb2, 0xcb, 0x98, 0xeb, 0x53, 0x4c, 0xb8, 0x87, 0x49,
-	0x10, 0x30, 0x49, 0xa4, 0xc7, 0x02, 0xa1, 0x27, 0xcc, 0xca, 0x0a, 0xc5, 0x88, 0xbb, 0x21, 0xe9,
-	0x53, 0x4d, 0xd8, 0x3b, 0xd0, 0x38, 0x9c, 0x1d, 0xd1, 0xa6, 0xa1, 0xf0, 0x58, 0x70, 0x44, 0x7c,
-	0x7f, 0xd4, 0xa2, 0xc3, 0x88, 0x0a, 0x89, 0x0c, 0x58, 0x88, 0x75, 0xd9, 0x00, 0x15, 0xf0, 0xef,
-	0x63, 0x2b, 0xfd, 0xb5, 0xef, 0x01, 0xfc, 0xb5, 0x62, 0x4c, 0x70, 0x16, 0x08, 0x8a, 0xfe, 0xc0,
-	0x52, 0xcc, 0xa4, 0x17, 0xb8, 0x1d, 0xce, 0xce, 0x68, 0x98, 0x0c, 0x17, 0x75, 0xad, 0x39, 0x2b,
-	0xa1, 0xbf, 0xf0, 0xab, 0x1c, 0x84, 0x54, 0x0c, 0x98, 0xdf, 0x4f, 0xa8, 0xbc, 0xa2, 0xbe, 0xcc,
-	0xcb, 0x1a, 0xac, 0x42, 0x24, 0x99, 0x24, 0x7e, 0xe7, 0x9d, 0xe2, 0x07, 0xc5, 0x7e, 0x53, 0x9d,
-	0xf6, 0x42, 0xd6, 0x36, 0xe0, 0x0f, 0x65, 0x6b, 0x9f, 0xca, 0x63, 0xbd, 0x66, 0xb2, 0x8b, 0xdd,
-	0x84, 0x3f, 0x33, 0x9d, 0xc4, 0xee, 0x2e, 0x2c, 0x24, 0x99, 0x28, 0xa7, 0xc5, 0xc6, 0x6f, 0x27,
-	0x1b, 0xb4, 0x93, 0x4e, 0xa5, 0x6c, 0xe3, 0x21, 0x0f, 0x3f, 0x29, 0x49, 0x74, 0x03, 0x60, 0x69,
-	0x39, 0x08, 0x54, 0x5d, 0x25, 0xb0, 0x2e, 0x66, 0xb3, 0xb6, 0x21, 0xad, 0xed, 0xda, 0xf6, 0xf5,
-	0xd3, 0xeb, 0x5d, 0xbe, 0x8c, 0xcc, 0xa5, 0x3b, 0x95, 0x33, 0x02, 0x5f, 0x24, 0xd7, 0x73, 0x89,
-	0xae, 0x00, 0x84, 0x8b, 0x4d, 0xd1, 0xff, 0xb5, 0x27, 0x64, 0x82, 0x32, 0xb7, 0x36, 0x62, 0x13,
-	0x2f, 0xa6, 0xf2, 0xf2, 0x1d, 0xa1, 0xec, 0xfb, 0xda, 0x3b, 0x78, 0x9c, 0x58, 0x60, 0x3c, 0xb1,
-	0xc0, 0xcb, 0xc4, 0x02, 0xb7, 0x53, 0x2b, 0x37, 0x9e, 0x5a, 0xb9, 0xe7, 0xa9, 0x95, 0x3b, 0x69,
-	0xb8, 0x9e, 0x1c, 0x44, 0x5d, 0xa7, 0xc7, 0x4e, 0x71, 0x7a, 0x18, 0x0b, 0xdd, 0xf9, 0x77, 0x8d,
-	0x70, 0x8e, 0xcf, 0x53, 0x49, 0x39, 0xe2, 0x54, 0x74, 0x3f, 0xab, 0xe7, 0xba, 0xfd, 0x16, 0x00,
-	0x00, 0xff, 0xff, 0x5b, 0x0c, 0x46, 0x0b, 0x24, 0x03, 0x00, 0x00,
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0x13, 0x49,
+	0x10, 0xf6, 0xd8, 0xf9, 0xd1, 0x56, 0xb2, 0x1b, 0xa7, 0x93, 0xdd, 0x38, 0x93, 0x64, 0x92, 0x8c,
+	0x56, 0x49, 0x36, 0x3f, 0x33, 0xb2, 0x77, 0x57, 0xe2, 0x08, 0x39, 0x10, 0x09, 0x90, 0x30, 0x06,
+	0x72, 0xe0, 0x62, 0xb5, 0xed, 0xd6, 0x64, 0xc4, 0x78, 0x7a, 0x32, 0xdd, 0x36, 0x58, 0xc0, 0x01,
+	0x5e, 0x00, 0x10, 0x42, 0x88, 0x07, 0xe1, 0x09, 0xb8, 0xe4, 0x18, 0x89, 0x0b, 0x27, 0x84, 0x12,
+	0x1e, 0x04, 0xb9, 0xbb, 0xc7, 0x19, 0x67, 0x66, 0x94, 0x44, 0xdc, 0xc6, 0x55, 0x5f, 0x55, 0x7d,
+	0x5d, 0xf5, 0x55, 0x19, 0x8c, 0x26, 0xf1, 0x08, 0xe3, 0x2e, 0xb6, 0x99, 0xeb, 0xf8, 0xd8, 0xb3,
+	0xbb, 0x65, 0xfb, 0xb0, 0x43, 0xc2, 0x9e, 0x15, 0x84, 0x94, 0x53, 0x84, 0x22, 0xbf, 0x25, 0xfd,
+	0x56, 0xb7, 0xac, 0x2f, 0x3a, 0x94, 0x3a, 0x1e, 0xb1, 0x71, 0xe0, 0xda, 0xd8, 0xf7, 0x29, 0xc7,
+	0xdc, 0xa5, 0x3e, 0x93, 0x11, 0xfa, 0xac, 0x43, 0x1d, 0x2a, 0x3e, 0xed, 0xfe, 0x97, 0xb2, 0x6e,
+	0x36, 0x29, 0x6b, 0x53, 0x66, 0x37, 0x30, 0x23, 0xb2, 0x80, 0xdd, 0x2d, 0x37, 0x08, 0xc7, 0x65,
+	0x3b, 0xc0, 0x8e, 0xeb, 0x8b, 0x14, 0x0a, 0xbb, 0x9c, 0xc2, 0x29, 0xc0, 0x21, 0x6e, 0x47, 0x25,
+	0x56, 0x52, 0x00, 0x9d, 0xc0, 0x09, 0x71, 0x8b, 0x48, 0x84, 0x39, 0x0b, 0xe8, 0x5e, 0xbf, 0x48,
+	0x55, 0x84, 0xd5, 0xc8, 0x61, 0x87, 0x30, 0x6e, 0xde, 0x85, 0x99, 0x21, 0x2b, 0x0b, 0xa8, 0xcf,
+	0x08, 0xba, 0x06, 0x63, 0x32, 0x7d, 0x49, 0x5b, 0xd1, 0x36, 0x26, 0x2a, 0xba, 0x95, 0x7c, 0xb4,
+	0x25, 0x63, 0x76, 0x47, 0x8e, 0xbe, 0x2d, 0xe7, 0x6a, 0x0a, 0x6f, 0xfe, 0x07, 0x25, 0x91, 0x70,
+	0x9f, 0x84, 0xcc, 0xa5, 0xfe, 0x03, 0xec, 0x79, 0x3d, 0x55, 0x0c, 0x95, 0x60, 0xbc, 0x2b, 0xcd,
+	0x22, 0xed, 0x48, 0x2d, 0xfa, 0x69, 0xbe, 0xd7, 0x60, 0x3e, 0x25, 0x4c, 0xb1, 0x59, 0x85, 0xc9,
+	0x2e, 0xe5, 0xae, 0xef, 0xd4, 0x03, 0xfa, 0x84, 0x84, 0x2a, 0x78, 0x42, 0xda, 0xaa, 0x7d, 0x13,
+	0x5a, 0x87, 0x29, 0x7e, 0x10, 0x12, 0x76, 0x40, 0xbd, 0x96, 0x42, 0xe5, 0x05, 0xea, 0x8f, 0x81,
+	0x59, 0x02, 0xb7, 0x01, 0x71, 0xca, 0xb1, 0x57, 0x1f, 0xca, 0x58, 0x10, 0xd8, 0xa2, 0xf0, 0xec,
+	0x9f, 0xa5, 0x35, 0x4b, 0xf0, 0x97, 0xa0, 0xb5, 0x47, 0xf8, 0x43, 0xd9, 0xcd, 0xa8, 0x71, 0x55,
+	0x98, 0x4b, 0x78, 0x14, 0xdd, 0xff, 0x61, 0x5c, 0xb5, 0x5e, 0x75, 0x6f, 0x21, 0xad, 0x7b, 0x51,
+	0x54, 0x84, 0x35, 0x1d, 0x58, 0x92, 0x2d, 0xc0, 0x9e, 0xdb, 0xc2, 0x9c, 0x86, 0xaa, 0x17, 0xd1,
+	0xac, 0xd0, 0x4d, 0x80, 0x33, 0x61, 0xa8, 0xd4, 0x6b, 0x96, 0x54, 0x91, 0xd5, 0x57, 0x91, 0x25,
+	0x65, 0xaa, 0x54, 0x64, 0x55, 0xb1, 0x13, 0xd1, 0xad, 0xc5, 0x22, 0xcd, 0x4f, 0x1a, 0x18, 0x59,
+	0x95, 0xd4, 0x13, 0x6e, 0x01, 0x74, 0x23, 0x67, 0x5f, 0x03, 0x85, 0x8d, 0x89, 0xca, 0xdf, 0x69,
+	0xaf, 0x38, 0x9f, 0x42, 0xa9, 0x21, 0x16, 0x8d, 0xf6, 0x86, 0x68, 0xe7, 0x05, 0xed, 0xf5, 0x0b,
+	0x69, 0x4b, 0x22, 0x43, 0xbc, 0x9f, 0x43, 0xf1, 0x7c, 0x39, 0xb4, 0x05, 0xd3, 0x83, 0x52, 0x75,
+	0xdc, 0x6a, 0x85, 0x84, 0x49, 0xcd, 0xfe, 0x56, 0x2b, 0x0e, 0x1c, 0x37, 0xa4, 0x3d, 0xae, 0xbf,
+	0xfc, 0x90, 0xfe, 0x12, 0x0a, 0xeb, 0xeb, 0xa1, 0x30, 0xa4, 0x30, 0x73, 0x41, 0x29, 0x54, 0xcd,
+	0xed, 0x3e, 0xc7, 0xbc, 0x33, 0x58, 0xa3, 0x0f, 0x79, 0xd0, 0xd3, 0xbc, 0xbf, 0xa4, 0x08, 0xf4,
+	0x0f, 0x14, 0x1b, 0x1e, 0x6d, 0x3e, 0x66, 0xf5, 0x90, 0xb4, 0xb1, 0xeb, 0xbb, 0xbe, 0x23, 0x88,
+	0x17, 0x6a, 0x53, 0xd2, 0x5e, 0x8b, 0xcc, 0xe8, 0x3a, 0x8c, 0x73, 0xec, 0x79, 0x2e, 0x61, 0xa5,
+	0x82, 0x98, 0xd6, 0x4a, 0xea, 0xb4, 0x62, 0xdb, 0xa5, 0x26, 0x15, 0x85, 0xa5, 0x6d, 0xd0, 0xc8,
+	0x15, 0x36, 0x68, 0x34, 0x63, 0x83, 0x6e, 0xc3, 0x64, 0xbc, 0x6a, 0xf6, 0x0d, 0x48, 0xcc, 0x20,
+	0x9f, 0xd8, 0xf2, 0xca, 0xe7, 0x51, 0x18, 0x15, 0x6d, 0x46, 0x1c, 0xc6, 0xe4, 0xf9, 0x41, 0x6b,
+	0x69, 0x0f, 0x4d, 0x5e, 0x3a, 0x7d, 0xfd, 0x42, 0x9c, 0x1c, 0x96, 0x39, 0xff, 0xea, 0xcb, 0x8f,
+	0x77, 0xf9, 0x19, 0x34, 0x9d, 0xb8, 0xb5, 0xe8, 0xb5, 0x76, 0xee, 0x35, 0xdb, 0x99, 0x49, 0x53,
+	0xee, 0x9f, 0xbe, 0x73, 0x49, 0xb4, 0x22, 0x62, 0x0a, 0x22, 0x8b, 0x48, 0x8f, 0x11, 0xe9, 0x4f,
+	0xab, 0x67, 0x3f, 0x53, 0x3d, 0x7b, 0x81, 0x5e, 0x6a, 0x00, 0x67, 0x27, 0x08, 0x6d, 0x66, 0x56,
+	0x48, 0x5c, 0x30, 0x7d, 0xeb, 0x52, 0x58, 0xc5, 0x45, 0x17, 0x5c, 0x66, 0x11, 0x4a, 0xfe, 0xbf,
+	0xa0, 0x8f, 0x1a, 0x4c, 0x27, 0x4e, 0x09, 0x2a, 0x67, 0x3f, 0x36, 0xe3, 0xc0, 0xe9, 0x95, 0xab,
+	0x84, 0x28, 0x62, 0x4b, 0x82, 0xd8, 0x1c, 0xfa, 0x33, 0x46, 0x2c, 0x76, 0x7c, 0xde, 0x6a, 0xf0,
+	0xfb, 0xd0, 0x4e, 0xa2, 0xec, 0x21, 0xa4, 0x6d, 0xb6, 0x6e, 0x5d, 0x16, 0xae, 0xf8, 0xac, 0x0a,
+	0x3e, 0x0b, 0x68, 0x3e, 0xd9, 0x28, 0x9b, 0x09, 0xe8, 0xee, 0x9d, 0xa3, 0x13, 0x43, 0x3b, 0x3e,
+	0x31, 0xb4, 0xef, 0x27, 0x86, 0xf6, 0xe6, 0xd4, 0xc8, 0x1d, 0x9f, 0x1a, 0xb9, 0xaf, 0xa7, 0x46,
+	0xee, 0x51, 0xc5, 0x71, 0xf9, 0x41, 0xa7, 0x61, 0x35, 0x69, 0xdb, 0x8e, 0xca, 0xd2, 0xd0, 0x19,
+	0x7c, 0xef, 0xe0, 0x20, 0xb0, 0x9f, 0x46, 0x99, 0x79, 0x2f, 0x20, 0xac, 0x31, 0x26, 0xfe, 0xde,
+	0xff, 0xfd, 0x39, 0x00, 0x38, 0x5c, 0x9e, 0xe7, 0xb7, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -267,12 +719,22 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params returns the parameters of the signal module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// VersionTally enables a client to query for the tally of voting power that
 	// has signalled for a particular version.
 	VersionTally(ctx context.Context, in *QueryVersionTallyRequest, opts ...grpc.CallOption) (*QueryVersionTallyResponse, error)
 	// GetUpgrade enables a client to query for upgrade information if an upgrade is pending.
 	// The response will be empty if no upgrade is pending.
 	GetUpgrade(ctx context.Context, in *QueryGetUpgradeRequest, opts ...grpc.CallOption) (*QueryGetUpgradeResponse, error)
+	// ValidatorVersions enables a client to query for the version signalled by
+	// each validator in the active set along with its voting power. Validators
+	// that have not signalled are included with a version of 0.
+	ValidatorVersions(ctx context.Context, in *QueryValidatorVersionsRequest, opts ...grpc.CallOption) (*QueryValidatorVersionsResponse, error)
+	// UpgradeStatus enables a client to query for the progress of an upgrade:
+	// the pending upgrade, if any, with the number of blocks remaining until its
+	// upgrade height, and the tally of voting power for every signalled version.
+	UpgradeStatus(ctx context.Context, in *QueryUpgradeStatusRequest, opts ...grpc.CallOption) (*QueryUpgradeStatusResponse, error)
 }
 
 type queryClient struct {
@@ -283,16 +745,25 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 	return &queryClient{cc}
 }
 
-func (c *queryClient) VersionTally(ctx context.Context, in *QueryVersionTallyRequest, opts ...grpc.CallOption) (*QueryVersionTallyResponse, error) {
-	out := new(QueryVersionTallyResponse)
-	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/VersionTally", in, out, opts...)
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) GetUpgrade(ctx context.Context, in *QueryGetUpgradeRequest, opts ...grpc.CallOption) (*QueryGetUpgradeResponse, error) {
+func (c *queryClient) VersionTally(ctx context.Context, in *QueryVersionTallyRequest, opts ...grpc.CallOption) (*QueryVersionTallyResponse, error) {
+	out := new(QueryVersionTallyResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/VersionTally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetUpgrade(ctx context.Context, in *QueryGetUpgradeRequest, opts ...grpc.CallOption) (*QueryGetUpgradeResponse, error) {
 	out := new(QueryGetUpgradeResponse)
 	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/GetUpgrade", in, out, opts...)
 	if err != nil {
@@ -301,31 +772,86 @@ func (c *queryClient) GetUpgrade(ctx context.Context, in *QueryGetUpgradeRequest
 	return out, nil
 }
 
+func (c *queryClient) ValidatorVersions(ctx context.Context, in *QueryValidatorVersionsRequest, opts ...grpc.CallOption) (*QueryValidatorVersionsResponse, error) {
+	out := new(QueryValidatorVersionsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/ValidatorVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpgradeStatus(ctx context.Context, in *QueryUpgradeStatusRequest, opts ...grpc.CallOption) (*QueryUpgradeStatusResponse, error) {
+	out := new(QueryUpgradeStatusResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Query/UpgradeStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	// Params returns the parameters of the signal module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// VersionTally enables a client to query for the tally of voting power that
 	// has signalled for a particular version.
 	VersionTally(context.Context, *QueryVersionTallyRequest) (*QueryVersionTallyResponse, error)
 	// GetUpgrade enables a client to query for upgrade information if an upgrade is pending.
 	// The response will be empty if no upgrade is pending.
 	GetUpgrade(context.Context, *QueryGetUpgradeRequest) (*QueryGetUpgradeResponse, error)
+	// ValidatorVersions enables a client to query for the version signalled by
+	// each validator in the active set along with its voting power. Validators
+	// that have not signalled are included with a version of 0.
+	ValidatorVersions(context.Context, *QueryValidatorVersionsRequest) (*QueryValidatorVersionsResponse, error)
+	// UpgradeStatus enables a client to query for the progress of an upgrade:
+	// the pending upgrade, if any, with the number of blocks remaining until its
+	// upgrade height, and the tally of voting power for every signalled version.
+	UpgradeStatus(context.Context, *QueryUpgradeStatusRequest) (*QueryUpgradeStatusResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServer struct {
 }
 
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (*UnimplementedQueryServer) VersionTally(ctx context.Context, req *QueryVersionTallyRequest) (*QueryVersionTallyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VersionTally not implemented")
 }
 func (*UnimplementedQueryServer) GetUpgrade(ctx context.Context, req *QueryGetUpgradeRequest) (*QueryGetUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUpgrade not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorVersions(ctx context.Context, req *QueryValidatorVersionsRequest) (*QueryValidatorVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorVersions not implemented")
+}
+func (*UnimplementedQueryServer) UpgradeStatus(ctx context.Context, req *QueryUpgradeStatusRequest) (*QueryUpgradeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeStatus not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_VersionTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryVersionTallyRequest)
 	if err := dec(in); err != nil {
@@ -362,10 +888,50 @@ func _Query_GetUpgrade_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/ValidatorVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorVersions(ctx, req.(*QueryValidatorVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpgradeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Query/UpgradeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeStatus(ctx, req.(*QueryUpgradeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.signal.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "VersionTally",
 			Handler:    _Query_VersionTally_Handler,
@@ -374,11 +940,75 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetUpgrade",
 			Handler:    _Query_GetUpgrade_Handler,
 		},
+		{
+			MethodName: "ValidatorVersions",
+			Handler:    _Query_ValidatorVersions_Handler,
+		},
+		{
+			MethodName: "UpgradeStatus",
+			Handler:    _Query_UpgradeStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/signal/v1/query.proto",
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryVersionTallyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -503,76 +1133,443 @@ func (m *QueryGetUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryValidatorVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryVersionTallyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryValidatorVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Version != 0 {
-		n += 1 + sovQuery(uint64(m.Version))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVersionTallyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
-	}
-	if m.ThresholdPower != 0 {
-		n += 1 + sovQuery(uint64(m.ThresholdPower))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.TotalVotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.TotalVotingPower))
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetUpgradeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetUpgradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUpgradeStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Upgrade != nil {
-		l = m.Upgrade.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryUpgradeStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozQuery(x uint64) (n int) {
+
+func (m *QueryUpgradeStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ThresholdPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ThresholdPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Tallies) > 0 {
+		for iNdEx := len(m.Tallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BlocksRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksRemaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Upgrade != nil {
+		{
+			size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVersionTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryVersionTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	if m.ThresholdPower != 0 {
+		n += 1 + sovQuery(uint64(m.ThresholdPower))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalVotingPower))
+	}
+	return n
+}
+
+func (m *QueryGetUpgradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *QueryUpgradeStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUpgradeStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Upgrade != nil {
+		l = m.Upgrade.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlocksRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksRemaining))
+	}
+	if len(m.Tallies) > 0 {
+		for _, e := range m.Tallies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ThresholdPower != 0 {
+		n += 1 + sovQuery(uint64(m.ThresholdPower))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalVotingPower))
+	}
+	return n
+}
+
+func (m *VersionTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryVersionTallyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -582,30 +1579,595 @@ func (m *QueryVersionTallyRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVersionTallyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVersionTallyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVersionTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVersionTallyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVersionTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPower", wireType)
+			}
+			m.ThresholdPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUpgradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUpgradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upgrade == nil {
+				m.Upgrade = &Upgrade{}
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Validators = append(m.Validators, ValidatorVersion{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVersionTallyRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVersionTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -615,11 +2177,28 @@ func (m *QueryVersionTallyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -641,7 +2220,7 @@ func (m *QueryVersionTallyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -664,17 +2243,17 @@ func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVersionTallyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVersionTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.VotingPower = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -684,16 +2263,29 @@ func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPower |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.ThresholdPower = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -703,16 +2295,16 @@ func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ThresholdPower |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			m.TotalVotingPower = 0
+			m.VotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -722,7 +2314,7 @@ func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				m.VotingPower |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -748,7 +2340,7 @@ func (m *QueryVersionTallyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUpgradeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradeStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -771,10 +2363,10 @@ func (m *QueryGetUpgradeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUpgradeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradeStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradeStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -798,7 +2390,7 @@ func (m *QueryGetUpgradeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradeStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -821,10 +2413,10 @@ func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUpgradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradeStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradeStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -863,6 +2455,185 @@ func (m *QueryGetUpgradeResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksRemaining", wireType)
+			}
+			m.BlocksRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksRemaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tallies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tallies = append(m.Tallies, VersionTally{})
+			if err := m.Tallies[len(m.Tallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPower", wireType)
+			}
+			m.ThresholdPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VersionTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
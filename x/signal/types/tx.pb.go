@@ -200,39 +200,229 @@ func (m *MsgTryUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgTryUpgradeResponse proto.InternalMessageInfo
 
+// MsgCancelUpgrade cancels the pending upgrade and resets the tally.
+type MsgCancelUpgrade struct {
+	// authority is the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgCancelUpgrade) Reset()         { *m = MsgCancelUpgrade{} }
+func (m *MsgCancelUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUpgrade) ProtoMessage()    {}
+func (*MsgCancelUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{4}
+}
+func (m *MsgCancelUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUpgrade.Merge(m, src)
+}
+func (m *MsgCancelUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUpgrade proto.InternalMessageInfo
+
+func (m *MsgCancelUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgCancelUpgradeResponse is the response type for the CancelUpgrade method.
+type MsgCancelUpgradeResponse struct {
+}
+
+func (m *MsgCancelUpgradeResponse) Reset()         { *m = MsgCancelUpgradeResponse{} }
+func (m *MsgCancelUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUpgradeResponse) ProtoMessage()    {}
+func (*MsgCancelUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{5}
+}
+func (m *MsgCancelUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUpgradeResponse.Merge(m, src)
+}
+func (m *MsgCancelUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
+
+// MsgRescheduleUpgrade moves the pending upgrade to a new height and resets
+// the tally.
+type MsgRescheduleUpgrade struct {
+	// authority is the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// upgrade_height is the new height at which the chain will upgrade. It must
+	// be greater than the current height.
+	UpgradeHeight int64 `protobuf:"varint,2,opt,name=upgrade_height,json=upgradeHeight,proto3" json:"upgrade_height,omitempty"`
+}
+
+func (m *MsgRescheduleUpgrade) Reset()         { *m = MsgRescheduleUpgrade{} }
+func (m *MsgRescheduleUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgRescheduleUpgrade) ProtoMessage()    {}
+func (*MsgRescheduleUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{6}
+}
+func (m *MsgRescheduleUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRescheduleUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRescheduleUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRescheduleUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRescheduleUpgrade.Merge(m, src)
+}
+func (m *MsgRescheduleUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRescheduleUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRescheduleUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRescheduleUpgrade proto.InternalMessageInfo
+
+func (m *MsgRescheduleUpgrade) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRescheduleUpgrade) GetUpgradeHeight() int64 {
+	if m != nil {
+		return m.UpgradeHeight
+	}
+	return 0
+}
+
+// MsgRescheduleUpgradeResponse is the response type for the RescheduleUpgrade
+// method.
+type MsgRescheduleUpgradeResponse struct {
+}
+
+func (m *MsgRescheduleUpgradeResponse) Reset()         { *m = MsgRescheduleUpgradeResponse{} }
+func (m *MsgRescheduleUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRescheduleUpgradeResponse) ProtoMessage()    {}
+func (*MsgRescheduleUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_815f2cc162e6e27e, []int{7}
+}
+func (m *MsgRescheduleUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRescheduleUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRescheduleUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRescheduleUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRescheduleUpgradeResponse.Merge(m, src)
+}
+func (m *MsgRescheduleUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRescheduleUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRescheduleUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRescheduleUpgradeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSignalVersion)(nil), "celestia.signal.v1.MsgSignalVersion")
 	proto.RegisterType((*MsgSignalVersionResponse)(nil), "celestia.signal.v1.MsgSignalVersionResponse")
 	proto.RegisterType((*MsgTryUpgrade)(nil), "celestia.signal.v1.MsgTryUpgrade")
 	proto.RegisterType((*MsgTryUpgradeResponse)(nil), "celestia.signal.v1.MsgTryUpgradeResponse")
+	proto.RegisterType((*MsgCancelUpgrade)(nil), "celestia.signal.v1.MsgCancelUpgrade")
+	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "celestia.signal.v1.MsgCancelUpgradeResponse")
+	proto.RegisterType((*MsgRescheduleUpgrade)(nil), "celestia.signal.v1.MsgRescheduleUpgrade")
+	proto.RegisterType((*MsgRescheduleUpgradeResponse)(nil), "celestia.signal.v1.MsgRescheduleUpgradeResponse")
 }
 
 func init() { proto.RegisterFile("celestia/signal/v1/tx.proto", fileDescriptor_815f2cc162e6e27e) }
 
 var fileDescriptor_815f2cc162e6e27e = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0x4a, 0x3b, 0x31,
-	0x14, 0xc5, 0x9b, 0xfe, 0xff, 0x54, 0x0c, 0x14, 0xda, 0xf8, 0x35, 0x8e, 0x32, 0xd4, 0x41, 0xb0,
-	0xa2, 0x4e, 0x68, 0x7d, 0x02, 0x5d, 0xdb, 0x4d, 0xfd, 0x00, 0xdd, 0x48, 0xda, 0x09, 0x31, 0x30,
-	0x4e, 0x42, 0x92, 0x0e, 0xed, 0xc6, 0x85, 0x4f, 0x20, 0xf8, 0x52, 0x2e, 0x0b, 0x6e, 0x5c, 0x4a,
-	0xeb, 0x6b, 0x08, 0x62, 0xd3, 0x19, 0xa7, 0x15, 0xd1, 0xdd, 0xbd, 0x73, 0x7f, 0xf7, 0x9c, 0x33,
-	0x97, 0xc0, 0x8d, 0x2e, 0x8d, 0xa8, 0x36, 0x9c, 0x60, 0xcd, 0x59, 0x4c, 0x22, 0x9c, 0x34, 0xb0,
-	0xe9, 0x07, 0x52, 0x09, 0x23, 0x10, 0x4a, 0x87, 0x81, 0x1d, 0x06, 0x49, 0xc3, 0xdd, 0x64, 0x42,
-	0xb0, 0x88, 0x62, 0x22, 0x39, 0x26, 0x71, 0x2c, 0x0c, 0x31, 0x5c, 0xc4, 0xda, 0x6e, 0xf8, 0x97,
-	0xb0, 0xd2, 0xd2, 0xec, 0x74, 0x42, 0x5f, 0x50, 0xa5, 0xb9, 0x88, 0xd1, 0x1e, 0xac, 0x26, 0x24,
-	0xe2, 0x21, 0x31, 0x42, 0x5d, 0x93, 0x30, 0x54, 0x54, 0x6b, 0x07, 0xd4, 0x40, 0x7d, 0xb1, 0x5d,
-	0xc9, 0x06, 0x47, 0xf6, 0x3b, 0x72, 0xe0, 0x42, 0x62, 0xf7, 0x9c, 0x62, 0x0d, 0xd4, 0xff, 0xb7,
-	0xd3, 0xd6, 0x77, 0xa1, 0x33, 0x2f, 0xdd, 0xa6, 0x5a, 0x8a, 0x58, 0x53, 0x7f, 0x07, 0x96, 0x5b,
-	0x9a, 0x9d, 0xa9, 0xc1, 0xb9, 0x64, 0x8a, 0x84, 0x14, 0xad, 0xc2, 0xd2, 0x67, 0x64, 0xaa, 0xa6,
-	0x46, 0xd3, 0xce, 0x5f, 0x83, 0x2b, 0x33, 0x60, 0xaa, 0xd0, 0x7c, 0x07, 0xf0, 0x5f, 0x4b, 0x33,
-	0x74, 0x07, 0xcb, 0xb3, 0xe9, 0xb7, 0x83, 0xef, 0x47, 0x08, 0xe6, 0x83, 0xb8, 0xfb, 0x7f, 0xa1,
-	0xb2, 0xb8, 0xeb, 0xf7, 0xcf, 0x6f, 0x8f, 0xc5, 0x25, 0xbf, 0x9a, 0x3b, 0xba, 0xad, 0x50, 0x02,
-	0x61, 0xee, 0x37, 0xb6, 0x7e, 0x90, 0xfd, 0x42, 0xdc, 0xdd, 0x5f, 0x91, 0xcc, 0xd6, 0x9d, 0xd8,
-	0x2e, 0xfb, 0x28, 0x67, 0xdb, 0xb3, 0xcc, 0xf1, 0xc9, 0xd3, 0xc8, 0x03, 0xc3, 0x91, 0x07, 0x5e,
-	0x47, 0x1e, 0x78, 0x18, 0x7b, 0x85, 0xe1, 0xd8, 0x2b, 0xbc, 0x8c, 0xbd, 0xc2, 0x55, 0x93, 0x71,
-	0x73, 0xd3, 0xeb, 0x04, 0x5d, 0x71, 0x8b, 0x53, 0x2b, 0xa1, 0x58, 0x56, 0x1f, 0x10, 0x29, 0x71,
-	0x3f, 0x95, 0x34, 0x03, 0x49, 0x75, 0xa7, 0x34, 0x79, 0x0d, 0x87, 0x1f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xfd, 0x76, 0xc1, 0x4b, 0x5e, 0x02, 0x00, 0x00,
+	// 474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x6f, 0xb6, 0x69, 0x68, 0x4f, 0x2a, 0x5a, 0xcd, 0x80, 0x2e, 0x2b, 0x61, 0xb3, 0x98, 0x28,
+	0x62, 0x24, 0xdb, 0xf8, 0x04, 0xc0, 0x85, 0x03, 0xbd, 0x84, 0x3f, 0x12, 0x70, 0x98, 0xbc, 0xc4,
+	0x72, 0x2c, 0x85, 0x38, 0xb2, 0xdd, 0x68, 0xbd, 0x70, 0xe0, 0xc0, 0x19, 0x09, 0x89, 0x2b, 0x5f,
+	0x87, 0xe3, 0x24, 0x2e, 0x1c, 0x51, 0xcb, 0x07, 0x41, 0x38, 0x89, 0xd7, 0xac, 0xab, 0xc8, 0x2d,
+	0x7e, 0xef, 0xf7, 0xcf, 0xef, 0x39, 0xb0, 0x13, 0xd1, 0x94, 0x2a, 0xcd, 0x49, 0xa0, 0x38, 0xcb,
+	0x48, 0x1a, 0x14, 0x47, 0x81, 0x3e, 0xf3, 0x73, 0x29, 0xb4, 0x40, 0xa8, 0x6e, 0xfa, 0x65, 0xd3,
+	0x2f, 0x8e, 0xdc, 0x01, 0x13, 0x82, 0xa5, 0x34, 0x20, 0x39, 0x0f, 0x48, 0x96, 0x09, 0x4d, 0x34,
+	0x17, 0x99, 0x2a, 0x19, 0xf8, 0x2d, 0x6c, 0x8e, 0x14, 0x7b, 0x69, 0xd0, 0x6f, 0xa8, 0x54, 0x5c,
+	0x64, 0xe8, 0x21, 0xf4, 0x0a, 0x92, 0xf2, 0x98, 0x68, 0x21, 0x4f, 0x48, 0x1c, 0x4b, 0xaa, 0x54,
+	0xdf, 0xd9, 0x75, 0x86, 0x1b, 0xe1, 0xa6, 0x6d, 0x3c, 0x29, 0xeb, 0xa8, 0x0f, 0xd7, 0x8a, 0x92,
+	0xd7, 0x5f, 0xd9, 0x75, 0x86, 0x6b, 0x61, 0x7d, 0xc4, 0x2e, 0xf4, 0x2f, 0x4b, 0x87, 0x54, 0xe5,
+	0x22, 0x53, 0x14, 0xdf, 0x87, 0xee, 0x48, 0xb1, 0x57, 0x72, 0xf2, 0x3a, 0x67, 0x92, 0xc4, 0x14,
+	0xdd, 0x82, 0xf5, 0x7f, 0x91, 0xa9, 0xac, 0x8c, 0xaa, 0x13, 0xbe, 0x0d, 0x37, 0x1b, 0x40, 0xab,
+	0x70, 0x68, 0x82, 0x3f, 0x23, 0x59, 0x44, 0xd3, 0x5a, 0x64, 0x00, 0x1b, 0x64, 0xac, 0x13, 0x21,
+	0xb9, 0x9e, 0x54, 0x3a, 0x17, 0x85, 0x2a, 0x4f, 0x83, 0x61, 0xd5, 0xde, 0xc3, 0xd6, 0x48, 0xb1,
+	0x90, 0xaa, 0x28, 0xa1, 0xf1, 0x38, 0xa5, 0xad, 0x14, 0xd1, 0x3e, 0x5c, 0x1f, 0x97, 0xc0, 0x93,
+	0x84, 0x72, 0x96, 0x68, 0x33, 0x82, 0xd5, 0xb0, 0x5b, 0x55, 0x9f, 0x9b, 0x22, 0xf6, 0x60, 0x70,
+	0x95, 0x78, 0x6d, 0x7e, 0xfc, 0x7d, 0x0d, 0x56, 0x47, 0x8a, 0xa1, 0x8f, 0xd0, 0x6d, 0x2e, 0xe2,
+	0x9e, 0xbf, 0xb8, 0x4f, 0xff, 0xf2, 0x4c, 0xdd, 0x83, 0x36, 0x28, 0x7b, 0xd3, 0xed, 0x4f, 0x3f,
+	0xff, 0x7c, 0x5d, 0xb9, 0x81, 0x7b, 0x73, 0xef, 0xa7, 0xfc, 0x42, 0x05, 0xc0, 0xdc, 0x46, 0xf6,
+	0x96, 0xc8, 0x5e, 0x40, 0xdc, 0x07, 0xff, 0x85, 0x58, 0x5b, 0xd7, 0xd8, 0x6e, 0x61, 0x34, 0x67,
+	0x5b, 0x4d, 0x09, 0x7d, 0x76, 0xa0, 0xdb, 0x5c, 0xe4, 0xb2, 0x8b, 0x37, 0x50, 0xee, 0x41, 0x1b,
+	0x94, 0x4d, 0xb0, 0x67, 0x12, 0xec, 0xe0, 0xed, 0xc5, 0x04, 0x41, 0x64, 0x18, 0xe8, 0x9b, 0x03,
+	0xbd, 0xc5, 0x37, 0x30, 0x5c, 0x62, 0xb3, 0x80, 0x74, 0x0f, 0xdb, 0x22, 0x6d, 0xa8, 0x7d, 0x13,
+	0xea, 0x2e, 0xbe, 0x73, 0x45, 0x28, 0x69, 0x59, 0x4f, 0x5f, 0xfc, 0x98, 0x7a, 0xce, 0xf9, 0xd4,
+	0x73, 0x7e, 0x4f, 0x3d, 0xe7, 0xcb, 0xcc, 0xeb, 0x9c, 0xcf, 0xbc, 0xce, 0xaf, 0x99, 0xd7, 0x79,
+	0x77, 0xcc, 0xb8, 0x4e, 0xc6, 0xa7, 0x7e, 0x24, 0x3e, 0x04, 0xb5, 0xb9, 0x90, 0xcc, 0x7e, 0x3f,
+	0x22, 0x79, 0x1e, 0x9c, 0xd5, 0xea, 0x7a, 0x92, 0x53, 0x75, 0xba, 0x6e, 0x7e, 0xfd, 0xc7, 0x7f,
+	0x07, 0x00, 0x94, 0x49, 0x2f, 0xb2, 0x4b, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -252,6 +442,11 @@ type MsgClient interface {
 	// TryUpgrade tallies all the votes for all the versions to determine if a
 	// quorum has been reached for a version.
 	TryUpgrade(ctx context.Context, in *MsgTryUpgrade, opts ...grpc.CallOption) (*MsgTryUpgradeResponse, error)
+	// CancelUpgrade allows governance to cancel the pending upgrade.
+	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// RescheduleUpgrade allows governance to move the pending upgrade to a
+	// different height.
+	RescheduleUpgrade(ctx context.Context, in *MsgRescheduleUpgrade, opts ...grpc.CallOption) (*MsgRescheduleUpgradeResponse, error)
 }
 
 type msgClient struct {
@@ -280,6 +475,24 @@ func (c *msgClient) TryUpgrade(ctx context.Context, in *MsgTryUpgrade, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error) {
+	out := new(MsgCancelUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Msg/CancelUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RescheduleUpgrade(ctx context.Context, in *MsgRescheduleUpgrade, opts ...grpc.CallOption) (*MsgRescheduleUpgradeResponse, error) {
+	out := new(MsgRescheduleUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.signal.v1.Msg/RescheduleUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SignalVersion allows a validator to signal for a version.
@@ -287,6 +500,11 @@ type MsgServer interface {
 	// TryUpgrade tallies all the votes for all the versions to determine if a
 	// quorum has been reached for a version.
 	TryUpgrade(context.Context, *MsgTryUpgrade) (*MsgTryUpgradeResponse, error)
+	// CancelUpgrade allows governance to cancel the pending upgrade.
+	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// RescheduleUpgrade allows governance to move the pending upgrade to a
+	// different height.
+	RescheduleUpgrade(context.Context, *MsgRescheduleUpgrade) (*MsgRescheduleUpgradeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -299,6 +517,12 @@ func (*UnimplementedMsgServer) SignalVersion(ctx context.Context, req *MsgSignal
 func (*UnimplementedMsgServer) TryUpgrade(ctx context.Context, req *MsgTryUpgrade) (*MsgTryUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TryUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) RescheduleUpgrade(ctx context.Context, req *MsgRescheduleUpgrade) (*MsgRescheduleUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleUpgrade not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -340,6 +564,42 @@ func _Msg_TryUpgrade_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Msg/CancelUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUpgrade(ctx, req.(*MsgCancelUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RescheduleUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRescheduleUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RescheduleUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.signal.v1.Msg/RescheduleUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RescheduleUpgrade(ctx, req.(*MsgRescheduleUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.signal.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -352,6 +612,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TryUpgrade",
 			Handler:    _Msg_TryUpgrade_Handler,
 		},
+		{
+			MethodName: "CancelUpgrade",
+			Handler:    _Msg_CancelUpgrade_Handler,
+		},
+		{
+			MethodName: "RescheduleUpgrade",
+			Handler:    _Msg_RescheduleUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/signal/v1/tx.proto",
@@ -468,6 +736,117 @@ func (m *MsgTryUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRescheduleUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRescheduleUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRescheduleUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpgradeHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpgradeHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRescheduleUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRescheduleUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRescheduleUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -526,16 +905,63 @@ func (m *MsgTryUpgradeResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgCancelUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgCancelUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgSignalVersion) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *MsgRescheduleUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UpgradeHeight != 0 {
+		n += 1 + sovTx(uint64(m.UpgradeHeight))
+	}
+	return n
+}
+
+func (m *MsgRescheduleUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSignalVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -815,6 +1241,289 @@ func (m *MsgTryUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRescheduleUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRescheduleUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRescheduleUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeHeight", wireType)
+			}
+			m.UpgradeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRescheduleUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRescheduleUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRescheduleUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0